@@ -0,0 +1,56 @@
+package app
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// EVMAddress returns the 0x EVM representation of a bech32 account address.
+// With coin type 60 both encodings share the same 20 address bytes.
+func (app *App) EVMAddress(bech32Addr string) (common.Address, error) {
+	bz, err := app.AuthKeeper.AddressCodec().StringToBytes(bech32Addr)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("invalid bech32 address %q: %w", bech32Addr, err)
+	}
+
+	if len(bz) != common.AddressLength {
+		return common.Address{}, fmt.Errorf("address %s is %d bytes long and has no EVM representation", bech32Addr, len(bz))
+	}
+
+	return common.BytesToAddress(bz), nil
+}
+
+// Bech32Address returns the bech32 account address of a 0x EVM address.
+func (app *App) Bech32Address(hexAddr string) (string, error) {
+	if !common.IsHexAddress(hexAddr) {
+		return "", fmt.Errorf("invalid hex address %q", hexAddr)
+	}
+
+	return app.AuthKeeper.AddressCodec().BytesToString(common.HexToAddress(hexAddr).Bytes())
+}
+
+// Bech32Config holds the bech32 prefixes of every address and public key type.
+type Bech32Config struct {
+	AccountAddr   string `json:"account_addr"`
+	AccountPub    string `json:"account_pub"`
+	ValidatorAddr string `json:"validator_addr"`
+	ValidatorPub  string `json:"validator_pub"`
+	ConsensusAddr string `json:"consensus_addr"`
+	ConsensusPub  string `json:"consensus_pub"`
+}
+
+// Bech32Prefixes returns the bech32 prefixes of the SDK config the app runs
+// with.
+func (app *App) Bech32Prefixes() Bech32Config {
+	config := sdk.GetConfig()
+	return Bech32Config{
+		AccountAddr:   config.GetBech32AccountAddrPrefix(),
+		AccountPub:    config.GetBech32AccountPubPrefix(),
+		ValidatorAddr: config.GetBech32ValidatorAddrPrefix(),
+		ValidatorPub:  config.GetBech32ValidatorPubPrefix(),
+		ConsensusAddr: config.GetBech32ConsensusAddrPrefix(),
+		ConsensusPub:  config.GetBech32ConsensusPubPrefix(),
+	}
+}
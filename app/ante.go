@@ -82,3 +82,12 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		return cosmosAnteHandler(ctx, tx, simulate)
 	}, nil
 }
+
+// AnteDecoratorOrder returns the names of the decorators of the Cosmos and
+// EVM ante chains built by NewAnteHandler, in the order they run. Optional
+// decorators are listed at their position whether or not they are enabled;
+// AnteConfig.EnabledDecorators tells which are. The names come from the
+// decorator lists the chains are built from.
+func AnteDecoratorOrder() (cosmos []string, evm []string) {
+	return antehandlers.DecoratorOrder()
+}
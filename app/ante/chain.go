@@ -0,0 +1,47 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// namedDecorator is a decorator of an ante chain, named so that the order of
+// the chain can be listed without building it.
+type namedDecorator struct {
+	name string
+	// enabled tells whether the decorator runs with the handler options.
+	enabled bool
+	// build creates the decorator, only once it is known to be enabled.
+	build func() sdk.AnteDecorator
+}
+
+// always returns a decorator that runs whatever the handler options.
+func always(name string, build func() sdk.AnteDecorator) namedDecorator {
+	return namedDecorator{name: name, enabled: true, build: build}
+}
+
+// chainDecorators chains the enabled decorators in order.
+func chainDecorators(decorators []namedDecorator) sdk.AnteHandler {
+	var enabled []sdk.AnteDecorator
+	for _, decorator := range decorators {
+		if decorator.enabled {
+			enabled = append(enabled, decorator.build())
+		}
+	}
+	return sdk.ChainAnteDecorators(enabled...)
+}
+
+// decoratorNames returns the names of decorators, enabled or not.
+func decoratorNames(decorators []namedDecorator) []string {
+	names := make([]string, 0, len(decorators))
+	for _, decorator := range decorators {
+		names = append(names, decorator.name)
+	}
+	return names
+}
+
+// DecoratorOrder returns the names of the decorators of the Cosmos and EVM
+// ante chains, in the order they run. Optional decorators are listed at
+// their position whether or not they are enabled.
+func DecoratorOrder() (cosmos []string, evm []string) {
+	return decoratorNames(cosmosDecorators(HandlerOptions{})), decoratorNames(evmDecorators(HandlerOptions{}))
+}
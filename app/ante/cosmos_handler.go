@@ -14,36 +14,147 @@ import (
 
 // NewCosmosAnteHandler creates the ante chain for non-EVM transactions, enriched with WASM decorators.
 func NewCosmosAnteHandler(options HandlerOptions) sdk.AnteHandler {
-	decorators := []sdk.AnteDecorator{
-		cosmosante.NewRejectMessagesDecorator(),
-		cosmosante.NewAuthzLimiterDecorator(
-			sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}),
-			sdk.MsgTypeURL(&sdkvesting.MsgCreateVestingAccount{}),
-		),
-		ante.NewSetUpContextDecorator(),
+	return chainDecorators(cosmosDecorators(options))
+}
+
+// cosmosDecorators lists the decorators of the Cosmos ante chain in order.
+func cosmosDecorators(options HandlerOptions) []namedDecorator {
+	decorators := []namedDecorator{
+		always("reject-messages", func() sdk.AnteDecorator {
+			return cosmosante.NewRejectMessagesDecorator()
+		}),
+		always("authz-limiter", func() sdk.AnteDecorator {
+			return cosmosante.NewAuthzLimiterDecorator(
+				sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}),
+				sdk.MsgTypeURL(&sdkvesting.MsgCreateVestingAccount{}),
+			)
+		}),
+		always("set-up-context", func() sdk.AnteDecorator {
+			return ante.NewSetUpContextDecorator()
+		}),
+		// Refuse new txs while the node is in reject-all mode.
+		{
+			name:    "reject-all",
+			enabled: options.TxGate != nil,
+			build: func() sdk.AnteDecorator {
+				return NewRejectAllDecorator(options.TxGate)
+			},
+		},
+		// Drop expired txs with a clear error before any other work.
+		{
+			name:    "deadline",
+			enabled: options.DeadlineKeeper != nil,
+			build: func() sdk.AnteDecorator {
+				return NewDeadlineDecorator(options.DeadlineKeeper)
+			},
+		},
+		// Bound signature verification cost before doing any heavier work.
+		{
+			name:    "max-signers",
+			enabled: options.MaxTxSigners > 0 || options.MaxSignersKeeper != nil,
+			build: func() sdk.AnteDecorator {
+				return NewMaxSignersDecorator(options.MaxSignersKeeper, options.MaxTxSigners)
+			},
+		},
+		// Drop txs from accounts that have never been funded before any further work.
+		{
+			name:    "reject-unfunded-accounts",
+			enabled: options.RejectUnfundedAccounts,
+			build: func() sdk.AnteDecorator {
+				return NewUnfundedAccountDecorator(options.AccountKeeper, options.BankKeeper)
+			},
+		},
+		// Drop no-op bank sends to their own sender.
+		{
+			name:    "reject-self-transfers",
+			enabled: options.RejectSelfTransfers,
+			build: func() sdk.AnteDecorator {
+				return NewSelfTransferDecorator()
+			},
+		},
 	}
 
+	// Fail fast on missing or expired fee grants instead of deep in fee deduction.
+	feegrantKeeper, ok := options.FeegrantKeeper.(FeegrantAllowanceKeeper)
+	decorators = append(decorators, namedDecorator{
+		name:    "fee-grant-check",
+		enabled: ok,
+		build: func() sdk.AnteDecorator {
+			return NewFeeGrantDecorator(feegrantKeeper)
+		},
+	})
+
 	// WASM-specific decorators first so simulation limits and gas bookkeeping run early.
 	decorators = append(decorators, wasmDecorators(options)...)
 
 	// Core ante flow.
-	decorators = append(decorators,
-		circuitante.NewCircuitBreakerDecorator(options.CircuitKeeper),
-		ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
-		ante.NewValidateBasicDecorator(),
-		ante.NewTxTimeoutHeightDecorator(),
-		ante.NewValidateMemoDecorator(options.AccountKeeper),
-		cosmosante.NewMinGasPriceDecorator(options.FeeMarketKeeper, options.EvmKeeper),
-		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
-		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
-		ante.NewSetPubKeyDecorator(options.AccountKeeper),
-		ante.NewValidateSigCountDecorator(options.AccountKeeper),
-		ante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SignatureGasConsumer),
-		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
-		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
-		ibcante.NewRedundantRelayDecorator(options.IBCKeeper),
-		evmante.NewGasWantedDecorator(options.EvmKeeper, options.FeeMarketKeeper),
+	return append(decorators,
+		always("circuit-breaker", func() sdk.AnteDecorator {
+			return circuitante.NewCircuitBreakerDecorator(options.CircuitKeeper)
+		}),
+		always("extension-options", func() sdk.AnteDecorator {
+			return ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker)
+		}),
+		always("validate-basic", func() sdk.AnteDecorator {
+			return ante.NewValidateBasicDecorator()
+		}),
+		always("tx-timeout-height", func() sdk.AnteDecorator {
+			return ante.NewTxTimeoutHeightDecorator()
+		}),
+		always("validate-memo", func() sdk.AnteDecorator {
+			return ante.NewValidateMemoDecorator(options.AccountKeeper)
+		}),
+		always("min-gas-price", func() sdk.AnteDecorator {
+			return minGasPriceDecorator(options)
+		}),
+		always("consume-gas-for-tx-size", func() sdk.AnteDecorator {
+			return ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper)
+		}),
+		always("deduct-fee", func() sdk.AnteDecorator {
+			return ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker)
+		}),
+		// Charge the fixed fees of listed message types once the gas fees are paid.
+		namedDecorator{
+			name:    "msg-fees",
+			enabled: options.MsgFeeKeeper != nil,
+			build: func() sdk.AnteDecorator {
+				return NewMsgFeeDecorator(options.DistrKeeper, options.MsgFeeKeeper)
+			},
+		},
+		always("set-pub-key", func() sdk.AnteDecorator {
+			return ante.NewSetPubKeyDecorator(options.AccountKeeper)
+		}),
+		always("validate-sig-count", func() sdk.AnteDecorator {
+			return ante.NewValidateSigCountDecorator(options.AccountKeeper)
+		}),
+		always("sig-gas-consume", func() sdk.AnteDecorator {
+			return ante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SignatureGasConsumer)
+		}),
+		always("sig-verification", func() sdk.AnteDecorator {
+			return ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler)
+		}),
+		always("increment-sequence", func() sdk.AnteDecorator {
+			return ante.NewIncrementSequenceDecorator(options.AccountKeeper)
+		}),
+		always("redundant-relay", func() sdk.AnteDecorator {
+			return ibcante.NewRedundantRelayDecorator(options.IBCKeeper)
+		}),
+		always("gas-wanted", func() sdk.AnteDecorator {
+			return evmante.NewGasWantedDecorator(options.EvmKeeper, options.FeeMarketKeeper)
+		}),
+		// Last, so only txs passing the ante handler count in the block gas wanted.
+		always("gas-usage", func() sdk.AnteDecorator {
+			return NewGasUsageDecorator(options.TransientStoreService)
+		}),
 	)
+}
 
-	return sdk.ChainAnteDecorators(decorators...)
+// minGasPriceDecorator returns the min gas price check, skipped within the
+// bootstrap window when a params keeper holds one.
+func minGasPriceDecorator(options HandlerOptions) sdk.AnteDecorator {
+	minGasPrice := cosmosante.NewMinGasPriceDecorator(options.FeeMarketKeeper, options.EvmKeeper)
+	if options.BootstrapBlocksKeeper == nil {
+		return minGasPrice
+	}
+	return NewBootstrapMinGasPriceDecorator(minGasPrice, options.BootstrapBlocksKeeper)
 }
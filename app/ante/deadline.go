@@ -0,0 +1,38 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+// DeadlineKeeper defines the params keeper telling whether expired
+// transactions are rejected up front.
+type DeadlineKeeper interface {
+	// RejectExpiredTxs reports whether the deadline check is enabled.
+	RejectExpiredTxs(ctx sdk.Context) bool
+}
+
+// DeadlineDecorator rejects Cosmos and EVM transactions whose timeout height
+// or timeout timestamp has passed, before any other work is done on them. It
+// runs the SDK timeout check on the SDK timeout fields, which the EVM ante
+// chain doesn't otherwise do, while the params enable it.
+type DeadlineDecorator struct {
+	keeper  DeadlineKeeper
+	timeout ante.TxTimeoutHeightDecorator
+}
+
+// NewDeadlineDecorator creates a DeadlineDecorator enabled by the keeper params.
+func NewDeadlineDecorator(keeper DeadlineKeeper) DeadlineDecorator {
+	return DeadlineDecorator{
+		keeper:  keeper,
+		timeout: ante.NewTxTimeoutHeightDecorator(),
+	}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (d DeadlineDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !d.keeper.RejectExpiredTxs(ctx) {
+		return next(ctx, tx, simulate)
+	}
+	return d.timeout.AnteHandle(ctx, tx, simulate, next)
+}
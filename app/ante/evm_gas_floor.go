@@ -0,0 +1,52 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GasPriceFloorKeeper defines the lookup of the minimum gas price of EVM senders.
+type GasPriceFloorKeeper interface {
+	GetEVMGasPriceFloor(ctx sdk.Context, sender common.Address) (math.Int, bool)
+}
+
+// EVMGasPriceFloorDecorator rejects EVM transactions whose gas fee cap is
+// below the minimum gas price set for their sender, if any.
+type EVMGasPriceFloorDecorator struct {
+	floors GasPriceFloorKeeper
+}
+
+// NewEVMGasPriceFloorDecorator creates a new EVMGasPriceFloorDecorator.
+func NewEVMGasPriceFloorDecorator(floors GasPriceFloorKeeper) EVMGasPriceFloorDecorator {
+	return EVMGasPriceFloorDecorator{floors: floors}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (d EVMGasPriceFloorDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for _, msg := range tx.GetMsgs() {
+		ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			continue
+		}
+
+		sender := common.BytesToAddress(ethMsg.From)
+		floor, found := d.floors.GetEVMGasPriceFloor(ctx, sender)
+		if !found {
+			continue
+		}
+
+		if gasPrice := ethMsg.AsTransaction().GasFeeCap(); gasPrice.Cmp(floor.BigInt()) < 0 {
+			return ctx, errorsmod.Wrapf(
+				errortypes.ErrInsufficientFee,
+				"gas price %s is below the minimum of %s required from %s", gasPrice, floor, sender,
+			)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,98 @@
+package ante
+
+import (
+	"context"
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// GasRefundBankKeeper defines the bank keeper methods needed to burn gas
+// refunds.
+type GasRefundBankKeeper interface {
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+}
+
+// BaseFeeKeeper defines the feemarket keeper methods needed to price EVM
+// gas.
+type BaseFeeKeeper interface {
+	GetBaseFee(ctx sdk.Context) math.LegacyDec
+}
+
+// GasRefundParamsKeeper defines the params keeper methods telling whether
+// gas refunds are burnt.
+type GasRefundParamsKeeper interface {
+	BurnEVMGasRefunds(ctx sdk.Context) bool
+}
+
+// EVMGasRefundBurnDecorator burns the fees the EVM refunds to the sender of a
+// transaction for the gas it did not use, so that senders pay for their whole
+// gas limit, while the on-chain params ask for it. The EVM refunds during
+// execution, so the refund is taken back from the sender afterwards.
+type EVMGasRefundBurnDecorator struct {
+	bankKeeper    GasRefundBankKeeper
+	baseFeeKeeper BaseFeeKeeper
+	paramsKeeper  GasRefundParamsKeeper
+}
+
+// NewEVMGasRefundBurnDecorator creates a new EVMGasRefundBurnDecorator.
+func NewEVMGasRefundBurnDecorator(
+	bankKeeper GasRefundBankKeeper,
+	baseFeeKeeper BaseFeeKeeper,
+	paramsKeeper GasRefundParamsKeeper,
+) EVMGasRefundBurnDecorator {
+	return EVMGasRefundBurnDecorator{
+		bankKeeper:    bankKeeper,
+		baseFeeKeeper: baseFeeKeeper,
+		paramsKeeper:  paramsKeeper,
+	}
+}
+
+// PostHandle implements sdk.PostDecorator.
+func (d EVMGasRefundBurnDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	if simulate || ctx.IsCheckTx() || !success || !d.paramsKeeper.BurnEVMGasRefunds(ctx) {
+		return next(ctx, tx, simulate, success)
+	}
+
+	msgs := tx.GetMsgs()
+	if len(msgs) != 1 {
+		return next(ctx, tx, simulate, success)
+	}
+	msg, ok := msgs[0].(*evmtypes.MsgEthereumTx)
+	if !ok {
+		return next(ctx, tx, simulate, success)
+	}
+
+	ethTx := msg.AsTransaction()
+	gasUsed := ctx.GasMeter().GasConsumed()
+	if gasUsed >= ethTx.Gas() {
+		return next(ctx, tx, simulate, success)
+	}
+
+	// the EVM refunds the unused gas at the effective gas price
+	price := ethTx.GasPrice()
+	if baseFee := d.baseFeeKeeper.GetBaseFee(ctx); !baseFee.IsNil() && baseFee.IsPositive() {
+		tipped := new(big.Int).Add(baseFee.TruncateInt().BigInt(), ethTx.GasTipCap())
+		if tipped.Cmp(ethTx.GasFeeCap()) < 0 {
+			price = tipped
+		} else {
+			price = ethTx.GasFeeCap()
+		}
+	}
+
+	refund := math.NewIntFromBigInt(new(big.Int).Mul(price, new(big.Int).SetUint64(ethTx.Gas()-gasUsed)))
+	if refund.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), refund))
+		if err := d.bankKeeper.SendCoinsFromAccountToModule(ctx, sdk.AccAddress(msg.From), evmtypes.ModuleName, coins); err != nil {
+			return ctx, err
+		}
+		if err := d.bankKeeper.BurnCoins(ctx, evmtypes.ModuleName, coins); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate, success)
+}
@@ -3,21 +3,101 @@ package ante
 import (
 	baseevmante "github.com/cosmos/evm/ante"
 	evmante "github.com/cosmos/evm/ante/evm"
+	"github.com/ethereum/go-ethereum/common"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NewMonoEVMAnteHandler creates the sdk.AnteHandler implementation for EVM transactions.
 func NewMonoEVMAnteHandler(options HandlerOptions) sdk.AnteHandler {
-	decorators := []sdk.AnteDecorator{
-		evmante.NewEVMMonoDecorator(
-			options.AccountKeeper,
-			options.FeeMarketKeeper,
-			options.EvmKeeper,
-			options.MaxTxGasWanted,
-		),
-		baseevmante.NewTxListenerDecorator(options.PendingTxListener),
+	return chainDecorators(evmDecorators(options))
+}
+
+// evmDecorators lists the decorators of the EVM ante chain in order.
+func evmDecorators(options HandlerOptions) []namedDecorator {
+	// Track pending txs through the listener to price replacements against them.
+	pendingTxListener := options.PendingTxListener
+	var tracker *PendingTxTracker
+	if options.EVMReplacementPriceBump > 0 {
+		tracker = NewPendingTxTracker()
+		pendingTxListener = func(hash common.Hash) {
+			tracker.OnPendingTx(hash)
+			if options.PendingTxListener != nil {
+				options.PendingTxListener(hash)
+			}
+		}
 	}
 
-	return sdk.ChainAnteDecorators(decorators...)
+	return []namedDecorator{
+		// Refuse new txs while the node is in reject-all mode.
+		{
+			name:    "reject-all",
+			enabled: options.TxGate != nil,
+			build: func() sdk.AnteDecorator {
+				return NewRejectAllDecorator(options.TxGate)
+			},
+		},
+		// Drop expired txs with a clear error before any other work.
+		{
+			name:    "deadline",
+			enabled: options.DeadlineKeeper != nil,
+			build: func() sdk.AnteDecorator {
+				return NewDeadlineDecorator(options.DeadlineKeeper)
+			},
+		},
+		// Query nodes refuse EVM txs before doing any work on them.
+		{
+			name:    "evm-read-only",
+			enabled: options.EVMReadOnly,
+			build: func() sdk.AnteDecorator {
+				return NewEVMReadOnlyDecorator()
+			},
+		},
+		// No-op txs are refused before their fees are deducted.
+		{
+			name:    "reject-noop-evm-txs",
+			enabled: options.RejectNoOpEVMTxs,
+			build: func() sdk.AnteDecorator {
+				return NewEVMNoOpTxDecorator()
+			},
+		},
+		// Dust value transfers are refused before their fees are deducted.
+		{
+			name:    "min-evm-value-transfer",
+			enabled: !options.MinEVMValueTransfer.IsNil() && options.MinEVMValueTransfer.IsPositive(),
+			build: func() sdk.AnteDecorator {
+				return NewEVMMinValueTransferDecorator(options.MinEVMValueTransfer)
+			},
+		},
+		// Senders with a gas price floor are held to it before paying fees.
+		{
+			name:    "evm-gas-price-floors",
+			enabled: options.EVMGasPriceFloors != nil,
+			build: func() sdk.AnteDecorator {
+				return NewEVMGasPriceFloorDecorator(options.EVMGasPriceFloors)
+			},
+		},
+		always("evm-mono", func() sdk.AnteDecorator {
+			return evmante.NewEVMMonoDecorator(
+				options.AccountKeeper,
+				options.FeeMarketKeeper,
+				options.EvmKeeper,
+				options.MaxTxGasWanted,
+			)
+		}),
+		{
+			name:    "evm-replacement-price-bump",
+			enabled: tracker != nil,
+			build: func() sdk.AnteDecorator {
+				return NewReplacementPriceBumpDecorator(tracker, options.EVMReplacementPriceBump)
+			},
+		},
+		always("tx-listener", func() sdk.AnteDecorator {
+			return baseevmante.NewTxListenerDecorator(pendingTxListener)
+		}),
+		// Last, so only txs passing the ante handler count in the block gas wanted.
+		always("gas-usage", func() sdk.AnteDecorator {
+			return NewGasUsageDecorator(options.TransientStoreService)
+		}),
+	}
 }
@@ -0,0 +1,52 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// EVMMinValueTransferDecorator rejects simple EVM value transfers, calls
+// without data, moving less than a minimum value, to deter dust spam.
+// Contract creations, calls with data and transfers without value are let
+// through. The check is a mempool policy local to the node, so it only
+// applies in CheckTx and block execution stays deterministic.
+type EVMMinValueTransferDecorator struct {
+	minValue math.Int
+}
+
+// NewEVMMinValueTransferDecorator creates an EVMMinValueTransferDecorator
+// requiring minValue, in the EVM denom base unit.
+func NewEVMMinValueTransferDecorator(minValue math.Int) EVMMinValueTransferDecorator {
+	return EVMMinValueTransferDecorator{minValue: minValue}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (d EVMMinValueTransferDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !ctx.IsCheckTx() {
+		return next(ctx, tx, simulate)
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			continue
+		}
+
+		ethTx := ethMsg.AsTransaction()
+		if ethTx.To() == nil || len(ethTx.Data()) > 0 || ethTx.Value().Sign() == 0 {
+			continue
+		}
+		if value := math.NewIntFromBigInt(ethTx.Value()); value.LT(d.minValue) {
+			return ctx, errorsmod.Wrapf(
+				errortypes.ErrInvalidRequest,
+				"EVM tx %s transfers %s, below the minimum of %s", ethTx.Hash(), value, d.minValue,
+			)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,43 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// EVMNoOpTxDecorator rejects EVM transactions that do nothing but bump the
+// sender nonce: calls without data and without value. Contract creations
+// are always let through. The check is a mempool policy local to the node, so it
+// only applies in CheckTx and block execution stays deterministic.
+type EVMNoOpTxDecorator struct{}
+
+// NewEVMNoOpTxDecorator creates an EVMNoOpTxDecorator.
+func NewEVMNoOpTxDecorator() EVMNoOpTxDecorator {
+	return EVMNoOpTxDecorator{}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (d EVMNoOpTxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !ctx.IsCheckTx() {
+		return next(ctx, tx, simulate)
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			continue
+		}
+
+		ethTx := ethMsg.AsTransaction()
+		if ethTx.To() != nil && len(ethTx.Data()) == 0 && ethTx.Value().Sign() == 0 {
+			return ctx, errorsmod.Wrapf(
+				errortypes.ErrInvalidRequest, "EVM tx %s has neither data nor value", ethTx.Hash(),
+			)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
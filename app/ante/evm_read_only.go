@@ -0,0 +1,29 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// EVMReadOnlyDecorator rejects EVM transactions submitted to the node's
+// mempool, as they all change state (at least the sender nonce). The mode is
+// local to the node, so block execution is never affected and stays
+// deterministic; mempool rechecks and simulations are let through, while
+// eth_call and the other EVM queries don't go through the ante handler at all.
+type EVMReadOnlyDecorator struct{}
+
+// NewEVMReadOnlyDecorator creates an EVMReadOnlyDecorator.
+func NewEVMReadOnlyDecorator() EVMReadOnlyDecorator {
+	return EVMReadOnlyDecorator{}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (d EVMReadOnlyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if ctx.IsCheckTx() && !ctx.IsReCheckTx() && !simulate {
+		return ctx, errorsmod.Wrap(errortypes.ErrInvalidRequest, "EVM is in read-only mode, transactions are not accepted")
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,57 @@
+package ante
+
+import (
+	"bytes"
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// FeegrantAllowanceKeeper defines the feegrant keeper methods needed to look up allowances.
+type FeegrantAllowanceKeeper interface {
+	GetAllowance(ctx context.Context, granter, grantee sdk.AccAddress) (feegrant.FeeAllowanceI, error)
+}
+
+// FeeGrantDecorator rejects transactions whose fee granter has no valid
+// allowance for the fee payer, before the fees are deducted.
+type FeeGrantDecorator struct {
+	feegrantKeeper FeegrantAllowanceKeeper
+}
+
+// NewFeeGrantDecorator creates a new FeeGrantDecorator.
+func NewFeeGrantDecorator(feegrantKeeper FeegrantAllowanceKeeper) FeeGrantDecorator {
+	return FeeGrantDecorator{feegrantKeeper: feegrantKeeper}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (d FeeGrantDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(errortypes.ErrTxDecode, "transaction must be a FeeTx")
+	}
+
+	granter := sdk.AccAddress(feeTx.FeeGranter())
+	payer := sdk.AccAddress(feeTx.FeePayer())
+	if granter.Empty() || bytes.Equal(granter, payer) {
+		return next(ctx, tx, simulate)
+	}
+
+	allowance, err := d.feegrantKeeper.GetAllowance(ctx, granter, payer)
+	if err != nil || allowance == nil {
+		return ctx, errorsmod.Wrapf(feegrant.ErrNoAllowance, "%s has no fee grant from %s", payer, granter)
+	}
+
+	expiration, err := allowance.ExpiresAt()
+	if err != nil {
+		return ctx, err
+	}
+	if expiration != nil && !ctx.BlockTime().Before(*expiration) {
+		return ctx, errorsmod.Wrapf(feegrant.ErrFeeLimitExpired, "fee grant from %s to %s expired at %s", granter, payer, expiration)
+	}
+
+	return next(ctx, tx, simulate)
+}
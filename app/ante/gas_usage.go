@@ -0,0 +1,107 @@
+package ante
+
+import (
+	corestoretypes "cosmossdk.io/core/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// Transient store keys of the gas consumed by the EVM and Cosmos
+// transactions of the current block, and of the gas they asked for.
+var (
+	evmGasUsedKey    = []byte("evm_gas_used")
+	cosmosGasUsedKey = []byte("cosmos_gas_used")
+	gasWantedKey     = []byte("gas_wanted")
+)
+
+// GasUsageDecorator counts the gas of the delivered transactions of the
+// block in a transient store, so the totals reset with every block.
+//
+// As an ante decorator, it adds the gas limit of each transaction passing the
+// ante handler to the block gas wanted. The ante handler writes are kept even
+// when the messages of the transaction then fail.
+//
+// As a post decorator, it adds the gas consumed by each successful
+// transaction to the block totals of its path, EVM or Cosmos. The writes of
+// the post handler are dropped along with the messages of failed
+// transactions, whose gas is only known to the block gas meter.
+type GasUsageDecorator struct {
+	storeService corestoretypes.TransientStoreService
+}
+
+// NewGasUsageDecorator creates a GasUsageDecorator.
+func NewGasUsageDecorator(storeService corestoretypes.TransientStoreService) GasUsageDecorator {
+	return GasUsageDecorator{storeService: storeService}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (d GasUsageDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if simulate || ctx.IsCheckTx() || !ok {
+		return next(ctx, tx, simulate)
+	}
+
+	if err := d.add(ctx, gasWantedKey, feeTx.GetGas()); err != nil {
+		return ctx, err
+	}
+	return next(ctx, tx, simulate)
+}
+
+// PostHandle implements sdk.PostDecorator.
+func (d GasUsageDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	if simulate || ctx.IsCheckTx() || !success {
+		return next(ctx, tx, simulate, success)
+	}
+
+	key := cosmosGasUsedKey
+	for _, msg := range tx.GetMsgs() {
+		if _, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+			key = evmGasUsedKey
+			break
+		}
+	}
+
+	if err := d.add(ctx, key, ctx.GasMeter().GasConsumed()); err != nil {
+		return ctx, err
+	}
+	return next(ctx, tx, simulate, success)
+}
+
+// add adds amount to the total stored under key.
+func (d GasUsageDecorator) add(ctx sdk.Context, key []byte, amount uint64) error {
+	store := d.storeService.OpenTransientStore(ctx)
+	current, err := getUint64(store, key)
+	if err != nil {
+		return err
+	}
+	return store.Set(key, sdk.Uint64ToBigEndian(current+amount))
+}
+
+// BlockGasUsage returns the gas consumed so far in the current block by
+// successful EVM and Cosmos transactions, as counted by GasUsageDecorator.
+func BlockGasUsage(ctx sdk.Context, storeService corestoretypes.TransientStoreService) (evmGas, cosmosGas uint64, err error) {
+	store := storeService.OpenTransientStore(ctx)
+	if evmGas, err = getUint64(store, evmGasUsedKey); err != nil {
+		return 0, 0, err
+	}
+	if cosmosGas, err = getUint64(store, cosmosGasUsedKey); err != nil {
+		return 0, 0, err
+	}
+	return evmGas, cosmosGas, nil
+}
+
+// BlockGasWanted returns the sum of the gas limits of the transactions
+// delivered so far in the current block that passed the ante handler, as
+// counted by GasUsageDecorator.
+func BlockGasWanted(ctx sdk.Context, storeService corestoretypes.TransientStoreService) (uint64, error) {
+	return getUint64(storeService.OpenTransientStore(ctx), gasWantedKey)
+}
+
+// getUint64 returns the big endian integer stored under key, zero if unset.
+func getUint64(store corestoretypes.KVStore, key []byte) (uint64, error) {
+	bz, err := store.Get(key)
+	if err != nil || bz == nil {
+		return 0, err
+	}
+	return sdk.BigEndianToUint64(bz), nil
+}
@@ -2,6 +2,7 @@ package ante
 
 import (
 	corestoretypes "cosmossdk.io/core/store"
+	"cosmossdk.io/math"
 	circuitkeeper "cosmossdk.io/x/circuit/keeper"
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmTypes "github.com/CosmWasm/wasmd/x/wasm/types"
@@ -26,6 +27,24 @@ type HandlerOptions struct {
 	SignatureGasConsumer   authante.SignatureVerificationGasConsumer
 	TxFeeChecker           authante.TxFeeChecker
 	ExtensionOptionChecker authante.ExtensionOptionChecker
+	// MaxTxSigners caps the number of distinct signers of a Cosmos transaction in the node's mempool (0 disables the cap).
+	MaxTxSigners uint64
+	// MaxSignersKeeper holds the cap on the number of distinct signers of a Cosmos transaction enforced in block execution too (nil disables it).
+	MaxSignersKeeper MaxSignersKeeper
+	// BootstrapBlocksKeeper holds the height up to which the min gas price check of Cosmos transactions is skipped (nil disables it).
+	BootstrapBlocksKeeper BootstrapBlocksKeeper
+	// RejectUnfundedAccounts rejects Cosmos transactions signed by accounts that have never been funded.
+	RejectUnfundedAccounts bool
+	// RejectSelfTransfers rejects bank sends whose recipient is their sender.
+	RejectSelfTransfers bool
+	// DistrKeeper receives the message fees into the community pool.
+	DistrKeeper CommunityPoolKeeper
+	// DeadlineKeeper tells whether expired Cosmos and EVM transactions are rejected up front (nil disables it).
+	DeadlineKeeper DeadlineKeeper
+	// MsgFeeKeeper holds the fixed fees charged per message (nil disables them).
+	MsgFeeKeeper MsgFeeKeeper
+	// TxGate can put the node into a mode rejecting all new transactions (nil disables it).
+	TxGate *TxGate
 
 	// EVM-specific options
 	Cdc               codec.BinaryCodec
@@ -34,10 +53,27 @@ type HandlerOptions struct {
 	MaxTxGasWanted    uint64
 	PendingTxListener baseevmante.PendingTxListener
 	IBCKeeper         *ibckeeper.Keeper
+	// EVMReplacementPriceBump is the gas price increase, in percent, required to
+	// replace a pending EVM tx (0 disables the check).
+	EVMReplacementPriceBump uint64
+	// EVMReadOnly rejects all EVM transactions, for query-only nodes.
+	EVMReadOnly bool
+	// RejectNoOpEVMTxs rejects EVM calls carrying neither data nor value.
+	RejectNoOpEVMTxs bool
+	// MinEVMValueTransfer is the lowest value of EVM calls without data (nil or zero disables the check).
+	MinEVMValueTransfer math.Int
+	// EVMGasPriceFloors holds the minimum gas prices of specific EVM senders (nil disables the check).
+	EVMGasPriceFloors GasPriceFloorKeeper
 
 	// WASM-specific options
 	NodeConfig            *wasmTypes.NodeConfig
 	WasmKeeper            *wasmkeeper.Keeper
 	TXCounterStoreService corestoretypes.KVStoreService
 	CircuitKeeper         *circuitkeeper.Keeper
+	// TransientStoreService backs the per-block counters of the Kudora decorators.
+	TransientStoreService corestoretypes.TransientStoreService
+	// WasmInstantiationLimitKeeper holds the cap on contract instantiations accepted per block (nil disables it).
+	WasmInstantiationLimitKeeper WasmInstantiationLimitKeeper
+	// MinWasmGasLimit is the minimum gas limit of txs executing or instantiating contracts (0 disables it).
+	MinWasmGasLimit uint64
 }
@@ -0,0 +1,41 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BootstrapBlocksKeeper defines the params keeper holding the bootstrap
+// window of the min gas price check.
+type BootstrapBlocksKeeper interface {
+	// MinGasPriceBootstrapBlocks returns the height up to which the min gas
+	// price check is skipped, zero if it never is.
+	MinGasPriceBootstrapBlocks(ctx sdk.Context) uint64
+}
+
+// BootstrapMinGasPriceDecorator skips the wrapped min gas price decorator
+// while the chain is within its bootstrap window, so zero-fee transactions
+// are accepted until the fee market has warmed up. The window is an on-chain
+// param, as the min gas price check also runs in block execution.
+type BootstrapMinGasPriceDecorator struct {
+	minGasPrice sdk.AnteDecorator
+	keeper      BootstrapBlocksKeeper
+}
+
+// NewBootstrapMinGasPriceDecorator wraps minGasPrice, bypassing it up to and
+// including the block height set in the params.
+func NewBootstrapMinGasPriceDecorator(minGasPrice sdk.AnteDecorator, keeper BootstrapBlocksKeeper) BootstrapMinGasPriceDecorator {
+	return BootstrapMinGasPriceDecorator{
+		minGasPrice: minGasPrice,
+		keeper:      keeper,
+	}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (d BootstrapMinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	bootstrapBlocks := d.keeper.MinGasPriceBootstrapBlocks(ctx)
+	if bootstrapBlocks > 0 && ctx.BlockHeight() >= 0 && uint64(ctx.BlockHeight()) <= bootstrapBlocks {
+		return next(ctx, tx, simulate)
+	}
+
+	return d.minGasPrice.AnteHandle(ctx, tx, simulate, next)
+}
@@ -0,0 +1,84 @@
+package ante
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// CommunityPoolKeeper defines the distribution keeper methods needed to fund the community pool.
+type CommunityPoolKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// MsgFeeKeeper defines the params keeper methods returning the message fees.
+type MsgFeeKeeper interface {
+	// GetMsgFees returns the fixed fees charged per message, keyed by msg
+	// type URL.
+	GetMsgFees(ctx sdk.Context) map[string]sdk.Coins
+}
+
+// MsgFeeDecorator charges the fee payer a fixed fee, on top of the gas fees,
+// for every message of a type listed in the on-chain params, including
+// messages wrapped in an authz MsgExec. The extra fees go to the community
+// pool.
+type MsgFeeDecorator struct {
+	distrKeeper  CommunityPoolKeeper
+	msgFeeKeeper MsgFeeKeeper
+}
+
+// NewMsgFeeDecorator creates a MsgFeeDecorator charging the fees of msgFeeKeeper.
+func NewMsgFeeDecorator(distrKeeper CommunityPoolKeeper, msgFeeKeeper MsgFeeKeeper) MsgFeeDecorator {
+	return MsgFeeDecorator{distrKeeper: distrKeeper, msgFeeKeeper: msgFeeKeeper}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (d MsgFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(errortypes.ErrTxDecode, "transaction must be a FeeTx")
+	}
+
+	fees := d.msgFeeKeeper.GetMsgFees(ctx)
+	if len(fees) == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	fee, err := msgFees(fees, tx.GetMsgs())
+	if err != nil {
+		return ctx, err
+	}
+
+	if !fee.IsZero() {
+		if err := d.distrKeeper.FundCommunityPool(ctx, fee, feeTx.FeePayer()); err != nil {
+			return ctx, errorsmod.Wrapf(errortypes.ErrInsufficientFee, "failed to pay message fee %s: %s", fee, err)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// msgFees returns the sum of the fees of msgs.
+func msgFees(fees map[string]sdk.Coins, msgs []sdk.Msg) (sdk.Coins, error) {
+	total := sdk.NewCoins()
+	for _, msg := range msgs {
+		total = total.Add(fees[sdk.MsgTypeURL(msg)]...)
+
+		if exec, ok := msg.(*authz.MsgExec); ok {
+			inner, err := exec.GetMessages()
+			if err != nil {
+				return nil, err
+			}
+			innerFees, err := msgFees(fees, inner)
+			if err != nil {
+				return nil, err
+			}
+			total = total.Add(innerFees...)
+		}
+	}
+	return total, nil
+}
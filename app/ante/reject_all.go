@@ -0,0 +1,54 @@
+package ante
+
+import (
+	"sync/atomic"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// TxGate switches the ante handler into a mode rejecting all new
+// transactions, driven by a signal external to the chain such as the node
+// losing most of its peers.
+type TxGate struct {
+	rejectAll atomic.Bool
+}
+
+// NewTxGate creates a TxGate accepting transactions.
+func NewTxGate() *TxGate {
+	return &TxGate{}
+}
+
+// SetRejectAll turns the reject-all mode on or off.
+func (g *TxGate) SetRejectAll(rejectAll bool) {
+	g.rejectAll.Store(rejectAll)
+}
+
+// RejectAll reports whether the reject-all mode is on.
+func (g *TxGate) RejectAll() bool {
+	return g.rejectAll.Load()
+}
+
+// RejectAllDecorator rejects new transactions submitted to the mempool while
+// its TxGate is in reject-all mode. The gate is local to the node, so block
+// execution is never affected and stays deterministic; mempool rechecks and
+// simulations are not affected either.
+type RejectAllDecorator struct {
+	gate *TxGate
+}
+
+// NewRejectAllDecorator creates a RejectAllDecorator following gate.
+func NewRejectAllDecorator(gate *TxGate) RejectAllDecorator {
+	return RejectAllDecorator{gate: gate}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (d RejectAllDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if ctx.IsCheckTx() && !ctx.IsReCheckTx() && !simulate && d.gate.RejectAll() {
+		return ctx, errorsmod.Wrap(errortypes.ErrInvalidRequest, "node is not accepting new transactions")
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,134 @@
+package ante
+
+import (
+	"math/big"
+	"sync"
+
+	errorsmod "cosmossdk.io/errors"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// maxTrackedTxs bounds the memory used by a PendingTxTracker. Past it the
+// tracker starts over, which only relaxes replacement checks for a while.
+const maxTrackedTxs = 10_000
+
+type senderNonce struct {
+	sender common.Address
+	nonce  uint64
+}
+
+type trackedTx struct {
+	hash     common.Hash
+	key      senderNonce
+	gasPrice *big.Int
+}
+
+// PendingTxTracker keeps the gas price of the EVM transactions pending in the
+// mempool, by sender and nonce. Transactions seen by the
+// ReplacementPriceBumpDecorator become pending once reported to OnPendingTx.
+type PendingTxTracker struct {
+	mu      sync.Mutex
+	checked map[common.Hash]trackedTx
+	pending map[senderNonce]trackedTx
+}
+
+// NewPendingTxTracker creates an empty PendingTxTracker.
+func NewPendingTxTracker() *PendingTxTracker {
+	return &PendingTxTracker{
+		checked: make(map[common.Hash]trackedTx),
+		pending: make(map[senderNonce]trackedTx),
+	}
+}
+
+// OnPendingTx is a pending tx listener marking a checked transaction as pending.
+func (t *PendingTxTracker) OnPendingTx(hash common.Hash) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	tx, ok := t.checked[hash]
+	if !ok {
+		return
+	}
+	delete(t.checked, hash)
+
+	if len(t.pending) >= maxTrackedTxs {
+		t.pending = make(map[senderNonce]trackedTx)
+	}
+	t.pending[tx.key] = tx
+}
+
+func (t *PendingTxTracker) checkedTx(tx trackedTx) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if len(t.checked) >= maxTrackedTxs {
+		t.checked = make(map[common.Hash]trackedTx)
+	}
+	t.checked[tx.hash] = tx
+}
+
+func (t *PendingTxTracker) pendingTx(key senderNonce) (trackedTx, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	tx, ok := t.pending[key]
+	return tx, ok
+}
+
+// ReplacementPriceBumpDecorator rejects EVM transactions replacing a pending
+// transaction with the same sender and nonce unless they raise the gas price
+// by at least the configured percentage.
+type ReplacementPriceBumpDecorator struct {
+	tracker     *PendingTxTracker
+	bumpPercent uint64
+}
+
+// NewReplacementPriceBumpDecorator creates a new ReplacementPriceBumpDecorator.
+func NewReplacementPriceBumpDecorator(tracker *PendingTxTracker, bumpPercent uint64) ReplacementPriceBumpDecorator {
+	return ReplacementPriceBumpDecorator{
+		tracker:     tracker,
+		bumpPercent: bumpPercent,
+	}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (d ReplacementPriceBumpDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	// replacements only happen in the mempool
+	if !ctx.IsCheckTx() || simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			continue
+		}
+
+		ethTx := ethMsg.AsTransaction()
+		checked := trackedTx{
+			hash:     ethTx.Hash(),
+			key:      senderNonce{sender: common.BytesToAddress(ethMsg.From), nonce: ethTx.Nonce()},
+			gasPrice: ethTx.GasFeeCap(),
+		}
+
+		if pending, found := d.tracker.pendingTx(checked.key); found && pending.hash != checked.hash {
+			minPrice := new(big.Int).Mul(pending.gasPrice, big.NewInt(int64(100+d.bumpPercent)))
+			minPrice.Quo(minPrice, big.NewInt(100))
+			if checked.gasPrice.Cmp(minPrice) < 0 {
+				return ctx, errorsmod.Wrapf(
+					errortypes.ErrInsufficientFee,
+					"replacement transaction underpriced: gas price %s, minimum %s (%d%% bump)",
+					checked.gasPrice, minPrice, d.bumpPercent,
+				)
+			}
+		}
+
+		d.tracker.checkedTx(checked)
+	}
+
+	return next(ctx, tx, simulate)
+}
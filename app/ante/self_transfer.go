@@ -0,0 +1,71 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// SelfTransferDecorator rejects bank sends to their own sender, including
+// those wrapped in an authz MsgExec. Such sends change no balance but still
+// take up block space and state writes. The check is a mempool policy local
+// to the node, so it only applies in CheckTx and block execution stays
+// deterministic.
+type SelfTransferDecorator struct{}
+
+// NewSelfTransferDecorator creates a SelfTransferDecorator.
+func NewSelfTransferDecorator() SelfTransferDecorator {
+	return SelfTransferDecorator{}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (d SelfTransferDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !ctx.IsCheckTx() {
+		return next(ctx, tx, simulate)
+	}
+
+	if err := rejectSelfTransfers(tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// rejectSelfTransfers fails on the first bank send among msgs whose recipient
+// is also its sender.
+func rejectSelfTransfers(msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *banktypes.MsgSend:
+			if msg.FromAddress == msg.ToAddress {
+				return errSelfTransfer(msg.FromAddress)
+			}
+		case *banktypes.MsgMultiSend:
+			senders := make(map[string]struct{}, len(msg.Inputs))
+			for _, input := range msg.Inputs {
+				senders[input.Address] = struct{}{}
+			}
+			for _, output := range msg.Outputs {
+				if _, ok := senders[output.Address]; ok {
+					return errSelfTransfer(output.Address)
+				}
+			}
+		case *authz.MsgExec:
+			inner, err := msg.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err := rejectSelfTransfers(inner); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func errSelfTransfer(address string) error {
+	return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "%s can't send tokens to itself", address)
+}
@@ -0,0 +1,74 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// MaxSignersKeeper defines the params keeper holding the cap on the distinct
+// signers of a transaction.
+type MaxSignersKeeper interface {
+	// MaxTxSigners returns the cap on the distinct signers of a transaction,
+	// zero if there is none.
+	MaxTxSigners(ctx sdk.Context) uint64
+}
+
+// MaxSignersDecorator rejects transactions carrying more distinct signers than
+// allowed, before any signature is processed. The cap of the params applies
+// in block execution as well. The node may set a lower one, a mempool policy
+// applied only in CheckTx so that block execution stays deterministic.
+type MaxSignersDecorator struct {
+	keeper     MaxSignersKeeper
+	maxSigners uint64
+}
+
+// NewMaxSignersDecorator creates a MaxSignersDecorator allowing at most the
+// distinct signers of the keeper params, if keeper is not nil, and at most
+// maxSigners in CheckTx, if positive.
+func NewMaxSignersDecorator(keeper MaxSignersKeeper, maxSigners uint64) MaxSignersDecorator {
+	return MaxSignersDecorator{
+		keeper:     keeper,
+		maxSigners: maxSigners,
+	}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (d MaxSignersDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	var maxSigners uint64
+	if d.keeper != nil {
+		maxSigners = d.keeper.MaxTxSigners(ctx)
+	}
+	if ctx.IsCheckTx() && d.maxSigners > 0 && (maxSigners == 0 || d.maxSigners < maxSigners) {
+		maxSigners = d.maxSigners
+	}
+	if maxSigners == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(errortypes.ErrTxDecode, "invalid transaction type")
+	}
+
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return ctx, err
+	}
+
+	distinct := make(map[string]struct{}, len(signers))
+	for _, signer := range signers {
+		distinct[string(signer)] = struct{}{}
+	}
+
+	if uint64(len(distinct)) > maxSigners {
+		return ctx, errorsmod.Wrapf(
+			errortypes.ErrTooManySignatures,
+			"transaction has %d distinct signers, maximum allowed is %d", len(distinct), maxSigners,
+		)
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,74 @@
+package ante
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// SignerAccountKeeper defines the account keeper methods needed to look up signer accounts.
+type SignerAccountKeeper interface {
+	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+}
+
+// SignerBalanceKeeper defines the bank keeper methods needed to look up signer balances.
+type SignerBalanceKeeper interface {
+	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+}
+
+// UnfundedAccountDecorator rejects transactions signed by accounts that have
+// never been funded: accounts that don't exist, or that have never signed a
+// transaction (zero sequence, no public key) and hold no balance.
+//
+// Accounts that have signed before are let through even when empty, and so
+// are fee-granted transactions since the granter pays for them. The check is
+// a mempool policy local to the node, so it only applies in CheckTx and block
+// execution stays deterministic.
+type UnfundedAccountDecorator struct {
+	accountKeeper SignerAccountKeeper
+	bankKeeper    SignerBalanceKeeper
+}
+
+// NewUnfundedAccountDecorator creates a new UnfundedAccountDecorator.
+func NewUnfundedAccountDecorator(accountKeeper SignerAccountKeeper, bankKeeper SignerBalanceKeeper) UnfundedAccountDecorator {
+	return UnfundedAccountDecorator{accountKeeper: accountKeeper, bankKeeper: bankKeeper}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (d UnfundedAccountDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !ctx.IsCheckTx() {
+		return next(ctx, tx, simulate)
+	}
+
+	if feeTx, ok := tx.(sdk.FeeTx); ok && len(feeTx.FeeGranter()) > 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(errortypes.ErrTxDecode, "invalid transaction type")
+	}
+
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return ctx, err
+	}
+
+	for _, signer := range signers {
+		addr := sdk.AccAddress(signer)
+		account := d.accountKeeper.GetAccount(ctx, addr)
+		if account != nil && (account.GetSequence() > 0 || account.GetPubKey() != nil) {
+			continue
+		}
+
+		if d.bankKeeper.GetAllBalances(ctx, addr).IsZero() {
+			return ctx, errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "account %s has never been funded", addr)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
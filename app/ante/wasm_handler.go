@@ -5,12 +5,34 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// wasmDecorators builds the WASM-specific ante decorators used in the Cosmos chain.
-func wasmDecorators(options HandlerOptions) []sdk.AnteDecorator {
-	return []sdk.AnteDecorator{
-		wasmkeeper.NewLimitSimulationGasDecorator(options.NodeConfig.SimulationGasLimit),
-		wasmkeeper.NewCountTXDecorator(options.TXCounterStoreService),
-		wasmkeeper.NewGasRegisterDecorator(options.WasmKeeper.GetGasRegister()),
-		wasmkeeper.NewTxContractsDecorator(),
+// wasmDecorators lists the WASM-specific ante decorators used in the Cosmos chain.
+func wasmDecorators(options HandlerOptions) []namedDecorator {
+	return []namedDecorator{
+		always("wasm-limit-simulation-gas", func() sdk.AnteDecorator {
+			return wasmkeeper.NewLimitSimulationGasDecorator(options.NodeConfig.SimulationGasLimit)
+		}),
+		always("wasm-count-tx", func() sdk.AnteDecorator {
+			return wasmkeeper.NewCountTXDecorator(options.TXCounterStoreService)
+		}),
+		always("wasm-gas-register", func() sdk.AnteDecorator {
+			return wasmkeeper.NewGasRegisterDecorator(options.WasmKeeper.GetGasRegister())
+		}),
+		always("wasm-tx-contracts", func() sdk.AnteDecorator {
+			return wasmkeeper.NewTxContractsDecorator()
+		}),
+		{
+			name:    "wasm-instantiation-limit",
+			enabled: options.WasmInstantiationLimitKeeper != nil,
+			build: func() sdk.AnteDecorator {
+				return NewWasmInstantiationLimitDecorator(options.TransientStoreService, options.WasmInstantiationLimitKeeper)
+			},
+		},
+		{
+			name:    "wasm-min-gas-limit",
+			enabled: options.MinWasmGasLimit > 0,
+			build: func() sdk.AnteDecorator {
+				return NewWasmMinGasLimitDecorator(options.MinWasmGasLimit)
+			},
+		},
 	}
 }
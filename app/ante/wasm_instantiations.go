@@ -0,0 +1,99 @@
+package ante
+
+import (
+	corestoretypes "cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// wasmInstantiationsKey is the transient store key of the number of contract
+// instantiations accepted in the current block.
+var wasmInstantiationsKey = []byte("wasm_instantiations")
+
+// WasmInstantiationLimitKeeper defines the params keeper holding the cap on
+// contract instantiations per block.
+type WasmInstantiationLimitKeeper interface {
+	// MaxWasmInstantiationsPerBlock returns the contract instantiations
+	// accepted per block, zero if they aren't capped.
+	MaxWasmInstantiationsPerBlock(ctx sdk.Context) uint64
+}
+
+// WasmInstantiationLimitDecorator caps the number of contract instantiation
+// messages, including those wrapped in an authz MsgExec, accepted per block.
+// The count is kept in a transient store so it resets with every block, and
+// the cap is an on-chain param since it also applies in block execution.
+type WasmInstantiationLimitDecorator struct {
+	storeService corestoretypes.TransientStoreService
+	keeper       WasmInstantiationLimitKeeper
+}
+
+// NewWasmInstantiationLimitDecorator creates a WasmInstantiationLimitDecorator
+// allowing the instantiations per block set in the params.
+func NewWasmInstantiationLimitDecorator(storeService corestoretypes.TransientStoreService, keeper WasmInstantiationLimitKeeper) WasmInstantiationLimitDecorator {
+	return WasmInstantiationLimitDecorator{storeService: storeService, keeper: keeper}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (d WasmInstantiationLimitDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	maxPerBlock := d.keeper.MaxWasmInstantiationsPerBlock(ctx)
+	if maxPerBlock == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	count, err := countInstantiations(tx.GetMsgs())
+	if err != nil {
+		return ctx, err
+	}
+	if count == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	store := d.storeService.OpenTransientStore(ctx)
+	bz, err := store.Get(wasmInstantiationsKey)
+	if err != nil {
+		return ctx, err
+	}
+
+	var current uint64
+	if bz != nil {
+		current = sdk.BigEndianToUint64(bz)
+	}
+	if current+count > maxPerBlock {
+		return ctx, errorsmod.Wrapf(
+			errortypes.ErrInvalidRequest,
+			"block already has %d contract instantiations, maximum allowed is %d", current, maxPerBlock,
+		)
+	}
+
+	if err := store.Set(wasmInstantiationsKey, sdk.Uint64ToBigEndian(current+count)); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// countInstantiations returns the number of contract instantiations among msgs.
+func countInstantiations(msgs []sdk.Msg) (uint64, error) {
+	var count uint64
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *wasmtypes.MsgInstantiateContract, *wasmtypes.MsgInstantiateContract2, *wasmtypes.MsgStoreAndInstantiateContract:
+			count++
+		case *authz.MsgExec:
+			inner, err := msg.GetMessages()
+			if err != nil {
+				return 0, err
+			}
+			innerCount, err := countInstantiations(inner)
+			if err != nil {
+				return 0, err
+			}
+			count += innerCount
+		}
+	}
+	return count, nil
+}
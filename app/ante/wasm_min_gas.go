@@ -0,0 +1,75 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// WasmMinGasLimitDecorator rejects transactions executing or instantiating
+// contracts, including through an authz MsgExec, whose gas limit is below a
+// minimum. Such transactions predictably run out of gas while still taking
+// up block space. The check is a mempool policy local to the node, so it only
+// applies in CheckTx and block execution stays deterministic.
+type WasmMinGasLimitDecorator struct {
+	minGasLimit uint64
+}
+
+// NewWasmMinGasLimitDecorator creates a WasmMinGasLimitDecorator requiring at least minGasLimit gas.
+func NewWasmMinGasLimitDecorator(minGasLimit uint64) WasmMinGasLimitDecorator {
+	return WasmMinGasLimitDecorator{minGasLimit: minGasLimit}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (d WasmMinGasLimitDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	// simulations are how clients find the gas limit to use
+	if !ctx.IsCheckTx() || simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(errortypes.ErrTxDecode, "tx must be a FeeTx")
+	}
+	if feeTx.GetGas() >= d.minGasLimit {
+		return next(ctx, tx, simulate)
+	}
+
+	runsContracts, err := runsContracts(tx.GetMsgs())
+	if err != nil {
+		return ctx, err
+	}
+	if runsContracts {
+		return ctx, errorsmod.Wrapf(
+			errortypes.ErrOutOfGas,
+			"gas limit %d is below the minimum of %d for contract executions", feeTx.GetGas(), d.minGasLimit,
+		)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// runsContracts reports whether msgs execute or instantiate a contract.
+func runsContracts(msgs []sdk.Msg) (bool, error) {
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *wasmtypes.MsgExecuteContract,
+			*wasmtypes.MsgInstantiateContract,
+			*wasmtypes.MsgInstantiateContract2,
+			*wasmtypes.MsgStoreAndInstantiateContract:
+			return true, nil
+		case *authz.MsgExec:
+			inner, err := msg.GetMessages()
+			if err != nil {
+				return false, err
+			}
+			if ok, err := runsContracts(inner); err != nil || ok {
+				return ok, err
+			}
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,83 @@
+package app
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	antehandlers "kudora/app/ante"
+)
+
+// AnteConfig summarizes the effective ante handler configuration.
+type AnteConfig struct {
+	// MaxTxGasWanted caps the gas wanted of EVM transactions (0 means no cap).
+	MaxTxGasWanted uint64
+	// MinGasPrices are the node's minimum gas prices for CheckTx.
+	MinGasPrices sdk.DecCoins
+	// MaxTxSigners caps the distinct signers of Cosmos transactions (0 means no cap).
+	MaxTxSigners uint64
+	// EnabledDecorators lists the optional Kudora decorators that the node
+	// configuration enables. The decorators driven by on-chain state, such
+	// as msg-fees or evm-gas-price-floors, always run and are not listed.
+	EnabledDecorators []string
+	// BlockedAddresses lists the module accounts and precompiles that can't receive funds.
+	BlockedAddresses []string
+}
+
+// AnteConfigSnapshot returns the configuration the ante handler was built with.
+func (app *App) AnteConfigSnapshot() AnteConfig {
+	return newAnteConfig(app.anteOptions, app.minGasPrices)
+}
+
+// SetRejectAllTxs turns on or off the mode in which the node rejects all new
+// transactions submitted to its mempool, for instance while it has lost most
+// of its peers. Blocks are executed as usual.
+func (app *App) SetRejectAllTxs(rejectAll bool) {
+	app.anteOptions.TxGate.SetRejectAll(rejectAll)
+}
+
+// newAnteConfig builds an AnteConfig from the ante handler options.
+func newAnteConfig(options HandlerOptions, minGasPrices sdk.DecCoins) AnteConfig {
+	var decorators []string
+	if options.TxGate != nil && options.TxGate.RejectAll() {
+		decorators = append(decorators, "reject-all")
+	}
+	if options.MaxTxSigners > 0 {
+		decorators = append(decorators, "max-signers")
+	}
+	if _, ok := options.FeegrantKeeper.(antehandlers.FeegrantAllowanceKeeper); ok {
+		decorators = append(decorators, "fee-grant-check")
+	}
+	if options.EVMReplacementPriceBump > 0 {
+		decorators = append(decorators, "evm-replacement-price-bump")
+	}
+	if options.EVMReadOnly {
+		decorators = append(decorators, "evm-read-only")
+	}
+	if options.RejectNoOpEVMTxs {
+		decorators = append(decorators, "reject-noop-evm-txs")
+	}
+	if !options.MinEVMValueTransfer.IsNil() && options.MinEVMValueTransfer.IsPositive() {
+		decorators = append(decorators, "min-evm-value-transfer")
+	}
+	if options.MinWasmGasLimit > 0 {
+		decorators = append(decorators, "wasm-min-gas-limit")
+	}
+	if options.RejectUnfundedAccounts {
+		decorators = append(decorators, "reject-unfunded-accounts")
+	}
+	if options.RejectSelfTransfers {
+		decorators = append(decorators, "reject-self-transfers")
+	}
+
+	blocked := getBlockAccAddrs()
+	sort.Strings(blocked)
+
+	return AnteConfig{
+		MaxTxGasWanted:    options.MaxTxGasWanted,
+		MinGasPrices:      minGasPrices,
+		MaxTxSigners:      options.MaxTxSigners,
+		EnabledDecorators: decorators,
+		BlockedAddresses:  blocked,
+	}
+}
@@ -0,0 +1,752 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/feegrant"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	tokenfactorytypes "github.com/cosmos/tokenfactory/x/tokenfactory/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	antehandlers "kudora/app/ante"
+	kudoratypes "kudora/x/kudora/types"
+)
+
+// buildTestTx builds an unsigned transaction carrying the given messages.
+func buildTestTx(t *testing.T, app *App, msgs ...sdk.Msg) sdk.Tx {
+	t.Helper()
+
+	builder := app.TxConfig().NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(msgs...))
+	return builder.GetTx()
+}
+
+// nextAnteHandler terminates a decorator under test.
+func nextAnteHandler(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+	return ctx, nil
+}
+
+// sendMsgsFromSigners returns one bank send per signer so the tx has that many distinct signers.
+func sendMsgsFromSigners(count int) []sdk.Msg {
+	recipient := sdk.AccAddress([]byte("recipient___________"))
+	amount := sdk.NewCoins(sdk.NewCoin(BaseDenom, math.NewInt(1)))
+
+	msgs := make([]sdk.Msg, 0, count)
+	for i := 0; i < count; i++ {
+		signer := sdk.AccAddress(fmt.Sprintf("signer%014d", i))
+		msgs = append(msgs, banktypes.NewMsgSend(signer, recipient, amount))
+	}
+	return msgs
+}
+
+func TestMaxSignersDecorator(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).WithIsCheckTx(true).CacheContext()
+	decorator := antehandlers.NewMaxSignersDecorator(app.KudoraParamsKeeper, 3)
+
+	// exactly at the cap
+	tx := buildTestTx(t, app, sendMsgsFromSigners(3)...)
+	_, err := decorator.AnteHandle(ctx, tx, false, nextAnteHandler)
+	require.NoError(t, err)
+
+	// repeated signers count once
+	msgs := sendMsgsFromSigners(3)
+	msgs = append(msgs, msgs[0])
+	tx = buildTestTx(t, app, msgs...)
+	_, err = decorator.AnteHandle(ctx, tx, false, nextAnteHandler)
+	require.NoError(t, err)
+
+	// above the cap
+	tx = buildTestTx(t, app, sendMsgsFromSigners(4)...)
+	_, err = decorator.AnteHandle(ctx, tx, false, nextAnteHandler)
+	require.ErrorIs(t, err, errortypes.ErrTooManySignatures)
+
+	// block execution doesn't depend on the node's cap
+	_, err = decorator.AnteHandle(ctx.WithIsCheckTx(false), tx, false, nextAnteHandler)
+	require.NoError(t, err)
+
+	// but enforces the cap of the params, as CheckTx does
+	params := app.KudoraParamsKeeper.GetParams(ctx)
+	params.MaxTxSigners = 2
+	require.NoError(t, app.KudoraParamsKeeper.SetParams(ctx, params))
+
+	tx = buildTestTx(t, app, sendMsgsFromSigners(3)...)
+	_, err = decorator.AnteHandle(ctx.WithIsCheckTx(false), tx, false, nextAnteHandler)
+	require.ErrorIs(t, err, errortypes.ErrTooManySignatures)
+	_, err = decorator.AnteHandle(ctx, tx, false, nextAnteHandler)
+	require.ErrorIs(t, err, errortypes.ErrTooManySignatures)
+	_, err = decorator.AnteHandle(ctx.WithIsCheckTx(false), buildTestTx(t, app, sendMsgsFromSigners(2)...), false, nextAnteHandler)
+	require.NoError(t, err)
+}
+
+// errInsufficientGasPrice is returned by rejectingDecorator.
+var errInsufficientGasPrice = errors.New("gas price below minimum")
+
+// rejectingDecorator stands in for a min gas price check rejecting every tx.
+type rejectingDecorator struct{}
+
+func (rejectingDecorator) AnteHandle(ctx sdk.Context, _ sdk.Tx, _ bool, _ sdk.AnteHandler) (sdk.Context, error) {
+	return ctx, errInsufficientGasPrice
+}
+
+func TestBootstrapMinGasPriceDecorator(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).CacheContext()
+	decorator := antehandlers.NewBootstrapMinGasPriceDecorator(rejectingDecorator{}, app.KudoraParamsKeeper)
+
+	params := kudoratypes.DefaultParams()
+	params.MinGasPriceBootstrapBlocks = 10
+	require.NoError(t, app.KudoraParamsKeeper.SetParams(ctx, params))
+
+	// within the bootstrap window the check is bypassed
+	for _, height := range []int64{0, 1, 10} {
+		_, err := decorator.AnteHandle(ctx.WithBlockHeight(height), nil, false, nextAnteHandler)
+		require.NoError(t, err, "height %d", height)
+	}
+
+	// after the window the check applies again
+	_, err := decorator.AnteHandle(ctx.WithBlockHeight(11), nil, false, nextAnteHandler)
+	require.ErrorIs(t, err, errInsufficientGasPrice)
+
+	// a zero window never bypasses the check
+	params.MinGasPriceBootstrapBlocks = 0
+	require.NoError(t, app.KudoraParamsKeeper.SetParams(ctx, params))
+	_, err = decorator.AnteHandle(ctx.WithBlockHeight(1), nil, false, nextAnteHandler)
+	require.ErrorIs(t, err, errInsufficientGasPrice)
+}
+
+func TestAnteConfigSnapshot(t *testing.T) {
+	app := setupTestApp(t)
+
+	// the test app is built without a gas wanted cap or other optional decorators
+	snapshot := app.AnteConfigSnapshot()
+	require.Zero(t, snapshot.MaxTxGasWanted)
+	require.Equal(t, []string{"fee-grant-check"}, snapshot.EnabledDecorators)
+	require.Contains(t, snapshot.BlockedAddresses, authtypes.FeeCollectorName)
+
+	options := app.anteOptions
+	options.MaxTxGasWanted = 25_000_000
+	options.MaxTxSigners = 5
+	minGasPrices := sdk.NewDecCoins(sdk.NewDecCoin(BaseDenom, math.NewInt(10)))
+
+	snapshot = newAnteConfig(options, minGasPrices)
+	require.Equal(t, uint64(25_000_000), snapshot.MaxTxGasWanted)
+	require.Equal(t, uint64(5), snapshot.MaxTxSigners)
+	require.Equal(t, minGasPrices, snapshot.MinGasPrices)
+	require.Equal(t, []string{"max-signers", "fee-grant-check"}, snapshot.EnabledDecorators)
+}
+
+func TestFeeGrantDecorator(t *testing.T) {
+	app := setupTestApp(t)
+	ctx := newTestContext(app)
+	decorator := antehandlers.NewFeeGrantDecorator(app.FeeGrantKeeper)
+
+	msgs := sendMsgsFromSigners(1)
+	grantee := sdk.AccAddress(fmt.Sprintf("signer%014d", 0))
+	granter := sdk.AccAddress([]byte("feegranter__________"))
+
+	builder := app.TxConfig().NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(msgs...))
+	builder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(BaseDenom, math.NewInt(1000))))
+	builder.SetFeeGranter(granter)
+	tx := builder.GetTx()
+
+	// no grant yet
+	_, err := decorator.AnteHandle(ctx, tx, false, nextAnteHandler)
+	require.ErrorIs(t, err, feegrant.ErrNoAllowance)
+
+	// granted
+	require.NoError(t, app.FeeGrantKeeper.GrantAllowance(ctx, granter, grantee, &feegrant.BasicAllowance{}))
+	_, err = decorator.AnteHandle(ctx, tx, false, nextAnteHandler)
+	require.NoError(t, err)
+}
+
+// newTestEthereumTx returns an unsigned legacy EVM transfer from the given sender.
+func newTestEthereumTx(from common.Address, nonce uint64, gasPrice int64) *evmtypes.MsgEthereumTx {
+	to := common.HexToAddress("0x7cb61d4117ae31a12e393a1cfa3bac666481d02e")
+	msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		ChainID:  big.NewInt(12000),
+		Nonce:    nonce,
+		To:       &to,
+		Amount:   big.NewInt(1),
+		GasLimit: 21_000,
+		GasPrice: big.NewInt(gasPrice),
+	})
+	msg.From = from.Bytes()
+	return msg
+}
+
+func TestReplacementPriceBumpDecorator(t *testing.T) {
+	app := setupTestApp(t)
+	ctx := newTestContext(app).WithIsCheckTx(true)
+
+	tracker := antehandlers.NewPendingTxTracker()
+	decorator := antehandlers.NewReplacementPriceBumpDecorator(tracker, 10)
+	sender := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+
+	// the original tx enters the mempool
+	original := newTestEthereumTx(sender, 0, 1_000)
+	_, err := decorator.AnteHandle(ctx, buildTestTx(t, app, original), false, nextAnteHandler)
+	require.NoError(t, err)
+	tracker.OnPendingTx(original.AsTransaction().Hash())
+
+	// a 5% bump is not enough to replace it
+	underpriced := newTestEthereumTx(sender, 0, 1_050)
+	_, err = decorator.AnteHandle(ctx, buildTestTx(t, app, underpriced), false, nextAnteHandler)
+	require.ErrorIs(t, err, errortypes.ErrInsufficientFee)
+
+	// other nonces are unaffected
+	_, err = decorator.AnteHandle(ctx, buildTestTx(t, app, newTestEthereumTx(sender, 1, 500)), false, nextAnteHandler)
+	require.NoError(t, err)
+
+	// a 10% bump replaces it
+	replacement := newTestEthereumTx(sender, 0, 1_100)
+	_, err = decorator.AnteHandle(ctx, buildTestTx(t, app, replacement), false, nextAnteHandler)
+	require.NoError(t, err)
+}
+
+func TestEVMReadOnlyDecorator(t *testing.T) {
+	app := setupTestApp(t)
+	ctx := newTestContext(app)
+	decorator := antehandlers.NewEVMReadOnlyDecorator()
+
+	tx := buildTestTx(t, app, newTestEthereumTx(common.HexToAddress("0x00000000000000000000000000000000000000bb"), 0, 1_000))
+
+	// state changing txs are rejected from the mempool
+	_, err := decorator.AnteHandle(ctx.WithIsCheckTx(true), tx, false, nextAnteHandler)
+	require.ErrorIs(t, err, errortypes.ErrInvalidRequest)
+
+	// simulations and rechecks still go through
+	_, err = decorator.AnteHandle(ctx.WithIsCheckTx(true), tx, true, nextAnteHandler)
+	require.NoError(t, err)
+	_, err = decorator.AnteHandle(ctx.WithIsReCheckTx(true), tx, false, nextAnteHandler)
+	require.NoError(t, err)
+
+	// block execution is never affected
+	_, err = decorator.AnteHandle(ctx, tx, false, nextAnteHandler)
+	require.NoError(t, err)
+}
+
+func TestWasmInstantiationLimitDecorator(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).CacheContext()
+	decorator := antehandlers.NewWasmInstantiationLimitDecorator(app.anteOptions.TransientStoreService, app.KudoraParamsKeeper)
+
+	instantiate := func(label string) sdk.Msg {
+		return &wasmtypes.MsgInstantiateContract{
+			Sender: sdk.AccAddress([]byte("instantiator________")).String(),
+			CodeID: 1,
+			Label:  label,
+			Msg:    []byte(`{}`),
+		}
+	}
+
+	// without a cap in the params every instantiation goes through
+	for _, label := range []string{"one", "two", "three"} {
+		_, err := decorator.AnteHandle(ctx, buildTestTx(t, app, instantiate(label)), false, nextAnteHandler)
+		require.NoError(t, err)
+	}
+
+	params := kudoratypes.DefaultParams()
+	params.WasmMaxInstantiationsPerBlock = 2
+	require.NoError(t, app.KudoraParamsKeeper.SetParams(ctx, params))
+
+	// up to the cap within the block
+	_, err := decorator.AnteHandle(ctx, buildTestTx(t, app, instantiate("one")), false, nextAnteHandler)
+	require.NoError(t, err)
+	_, err = decorator.AnteHandle(ctx, buildTestTx(t, app, instantiate("two")), false, nextAnteHandler)
+	require.NoError(t, err)
+
+	// beyond the cap
+	_, err = decorator.AnteHandle(ctx, buildTestTx(t, app, instantiate("three")), false, nextAnteHandler)
+	require.ErrorIs(t, err, errortypes.ErrInvalidRequest)
+
+	// other messages are unaffected
+	_, err = decorator.AnteHandle(ctx, buildTestTx(t, app, sendMsgsFromSigners(1)...), false, nextAnteHandler)
+	require.NoError(t, err)
+}
+
+func TestUnfundedAccountDecorator(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).WithIsCheckTx(true).CacheContext()
+	decorator := antehandlers.NewUnfundedAccountDecorator(app.AuthKeeper, app.BankKeeper)
+
+	signer := sdk.AccAddress(fmt.Sprintf("signer%014d", 0))
+	tx := buildTestTx(t, app, sendMsgsFromSigners(1)...)
+
+	// an account that doesn't exist yet
+	_, err := decorator.AnteHandle(ctx, tx, false, nextAnteHandler)
+	require.ErrorIs(t, err, errortypes.ErrInsufficientFunds)
+
+	// block execution doesn't depend on the node's policy
+	_, err = decorator.AnteHandle(ctx.WithIsCheckTx(false), tx, false, nextAnteHandler)
+	require.NoError(t, err)
+
+	// a brand new, empty account
+	app.AuthKeeper.SetAccount(ctx, app.AuthKeeper.NewAccountWithAddress(ctx, signer))
+	_, err = decorator.AnteHandle(ctx, tx, false, nextAnteHandler)
+	require.ErrorIs(t, err, errortypes.ErrInsufficientFunds)
+
+	// once funded the account may transact
+	fundTestAccount(t, app, ctx, signer, sdk.NewCoins(sdk.NewCoin(BaseDenom, math.NewInt(1))))
+	_, err = decorator.AnteHandle(ctx, tx, false, nextAnteHandler)
+	require.NoError(t, err)
+}
+
+func TestMsgFeeDecorator(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).CacheContext()
+	require.NoError(t, app.DistrKeeper.FeePool.Set(ctx, distrtypes.InitialFeePool()))
+
+	fee := sdk.NewCoins(sdk.NewCoin(BaseDenom, math.NewInt(1_000)))
+	params := kudoratypes.DefaultParams()
+	params.MsgFees = []kudoratypes.MsgFee{{
+		MsgTypeUrl: sdk.MsgTypeURL(&tokenfactorytypes.MsgCreateDenom{}),
+		Fee:        fee,
+	}}
+	require.NoError(t, app.KudoraParamsKeeper.SetParams(ctx, params))
+	decorator := antehandlers.NewMsgFeeDecorator(app.DistrKeeper, app.KudoraParamsKeeper)
+
+	payer := sdk.AccAddress(fmt.Sprintf("signer%014d", 0))
+	fundTestAccount(t, app, ctx, payer, sdk.NewCoins(sdk.NewCoin(BaseDenom, math.NewInt(10_000))))
+	communityPool := func() math.LegacyDec {
+		feePool, err := app.DistrKeeper.FeePool.Get(ctx)
+		require.NoError(t, err)
+		return feePool.CommunityPool.AmountOf(BaseDenom)
+	}
+
+	// a listed message type pays the extra fee to the community pool
+	tx := buildTestTx(t, app, tokenfactorytypes.NewMsgCreateDenom(payer.String(), "feetoken"))
+	_, err := decorator.AnteHandle(ctx, tx, false, nextAnteHandler)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(9_000), app.BankKeeper.GetBalance(ctx, payer, BaseDenom).Amount)
+	require.Equal(t, math.LegacyNewDec(1_000), communityPool())
+
+	// other messages don't
+	tx = buildTestTx(t, app, sendMsgsFromSigners(1)...)
+	_, err = decorator.AnteHandle(ctx, tx, false, nextAnteHandler)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(9_000), app.BankKeeper.GetBalance(ctx, payer, BaseDenom).Amount)
+	require.Equal(t, math.LegacyNewDec(1_000), communityPool())
+}
+
+func TestEVMGasRefundBurnDecorator(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).CacheContext()
+	decorator := antehandlers.NewEVMGasRefundBurnDecorator(app.BankKeeper, app.FeeMarketKeeper, app.KudoraParamsKeeper)
+
+	nextPostHandler := func(ctx sdk.Context, _ sdk.Tx, _, _ bool) (sdk.Context, error) {
+		return ctx, nil
+	}
+
+	// the sender was refunded the fees of the gas it didn't use
+	sender := common.HexToAddress("0x00000000000000000000000000000000000000dd")
+	fundTestAccount(t, app, ctx, sender.Bytes(), sdk.NewCoins(sdk.NewCoin(BaseDenom, math.NewInt(10_000_000))))
+	supply := app.BankKeeper.GetSupply(ctx, BaseDenom).Amount
+
+	// 15000 of the 21000 gas limit used at a price of 1000
+	txCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	txCtx.GasMeter().ConsumeGas(15_000, "test")
+	tx := buildTestTx(t, app, newTestEthereumTx(sender, 0, 1_000))
+
+	// refunds go to the payer by default
+	_, err := decorator.PostHandle(txCtx, tx, false, true, nextPostHandler)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(10_000_000), app.BankKeeper.GetBalance(ctx, sender.Bytes(), BaseDenom).Amount)
+	require.Equal(t, supply, app.BankKeeper.GetSupply(ctx, BaseDenom).Amount)
+
+	// and are burnt once the params ask for it
+	params := app.KudoraParamsKeeper.GetParams(ctx)
+	params.BurnEvmGasRefunds = true
+	require.NoError(t, app.KudoraParamsKeeper.SetParams(ctx, params))
+	_, err = decorator.PostHandle(txCtx, tx, false, true, nextPostHandler)
+	require.NoError(t, err)
+
+	burned := math.NewInt(6_000 * 1_000)
+	require.Equal(t, math.NewInt(10_000_000).Sub(burned), app.BankKeeper.GetBalance(ctx, sender.Bytes(), BaseDenom).Amount)
+	require.Equal(t, supply.Sub(burned), app.BankKeeper.GetSupply(ctx, BaseDenom).Amount)
+
+	// checks and Cosmos txs are left alone
+	_, err = decorator.PostHandle(txCtx.WithIsCheckTx(true), tx, false, true, nextPostHandler)
+	require.NoError(t, err)
+	_, err = decorator.PostHandle(txCtx, buildTestTx(t, app, sendMsgsFromSigners(1)...), false, true, nextPostHandler)
+	require.NoError(t, err)
+	require.Equal(t, supply.Sub(burned), app.BankKeeper.GetSupply(ctx, BaseDenom).Amount)
+}
+
+func TestGasUsageDecorator(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).CacheContext()
+	ctx = ctx.WithBlockGasMeter(storetypes.NewInfiniteGasMeter())
+	decorator := antehandlers.NewGasUsageDecorator(app.anteOptions.TransientStoreService)
+
+	nextPostHandler := func(ctx sdk.Context, _ sdk.Tx, _, _ bool) (sdk.Context, error) {
+		return ctx, nil
+	}
+	// deliver mimics baseapp: the ante writes of a failing tx are dropped,
+	// the post handler only runs for successful txs and the block gas meter
+	// counts every tx.
+	deliver := func(msgs []sdk.Msg, gasWanted, gasUsed uint64, anteOK, msgsOK bool) {
+		builder := app.TxConfig().NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(msgs...))
+		builder.SetGasLimit(gasWanted)
+		tx := builder.GetTx()
+
+		txCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+		txCtx.GasMeter().ConsumeGas(gasUsed, "test")
+		ctx.BlockGasMeter().ConsumeGas(gasUsed, "test")
+		if !anteOK {
+			return
+		}
+		_, err := decorator.AnteHandle(txCtx, tx, false, nextAnteHandler)
+		require.NoError(t, err)
+		if msgsOK {
+			_, err = decorator.PostHandle(txCtx, tx, false, true, nextPostHandler)
+			require.NoError(t, err)
+		}
+	}
+
+	deliver([]sdk.Msg{newTestEthereumTx(common.HexToAddress("0x00000000000000000000000000000000000000cc"), 0, 1_000)}, 21_000, 21_000, true, true)
+	deliver(sendMsgsFromSigners(1), 80_000, 50_000, true, true)
+	// failing messages still count their gas limit, failing ante handlers do not
+	deliver(sendMsgsFromSigners(1), 60_000, 40_000, true, false)
+	deliver(sendMsgsFromSigners(1), 90_000, 10_000, false, false)
+
+	// checks and simulations are not counted
+	builder := app.TxConfig().NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(sendMsgsFromSigners(1)...))
+	builder.SetGasLimit(70_000)
+	checkTx := builder.GetTx()
+	_, err := decorator.AnteHandle(ctx.WithIsCheckTx(true), checkTx, false, nextAnteHandler)
+	require.NoError(t, err)
+	_, err = decorator.PostHandle(ctx.WithIsCheckTx(true), checkTx, false, true, nextPostHandler)
+	require.NoError(t, err)
+	_, err = decorator.AnteHandle(ctx, checkTx, true, nextAnteHandler)
+	require.NoError(t, err)
+
+	require.NoError(t, kudoraModule{app: app}.EndBlock(ctx))
+	expected := kudoratypes.BlockGasUsage{Height: ctx.BlockHeight(), EvmGas: 21_000, CosmosGas: 50_000, GasWanted: 161_000, FailedGas: 50_000}
+	usage := app.LatestBlockGasUsage(ctx)
+	require.Equal(t, expected, usage)
+	require.Equal(t, uint64(121_000), usage.GasUsed())
+
+	res, err := newTestQueryClient(app, ctx).LatestBlockGasUsage(ctx, &kudoratypes.QueryLatestBlockGasUsageRequest{})
+	require.NoError(t, err)
+	require.Equal(t, expected, res.Usage)
+}
+
+func TestEVMNoOpTxDecorator(t *testing.T) {
+	app := setupTestApp(t)
+	ctx := newTestContext(app).WithIsCheckTx(true)
+	decorator := antehandlers.NewEVMNoOpTxDecorator()
+
+	newEthereumTx := func(to *common.Address, amount int64, data []byte) sdk.Tx {
+		msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+			ChainID:  big.NewInt(12000),
+			To:       to,
+			Amount:   big.NewInt(amount),
+			GasLimit: 53_000,
+			GasPrice: big.NewInt(1_000),
+			Input:    data,
+		})
+		msg.From = common.HexToAddress("0x00000000000000000000000000000000000000dd").Bytes()
+		return buildTestTx(t, app, msg)
+	}
+	to := common.HexToAddress("0x7cb61d4117ae31a12e393a1cfa3bac666481d02e")
+
+	// neither data nor value
+	_, err := decorator.AnteHandle(ctx, newEthereumTx(&to, 0, nil), false, nextAnteHandler)
+	require.ErrorIs(t, err, errortypes.ErrInvalidRequest)
+
+	// block execution doesn't depend on the node's policy
+	_, err = decorator.AnteHandle(ctx.WithIsCheckTx(false), newEthereumTx(&to, 0, nil), false, nextAnteHandler)
+	require.NoError(t, err)
+
+	// value transfers, contract calls and creations go through
+	_, err = decorator.AnteHandle(ctx, newEthereumTx(&to, 1, nil), false, nextAnteHandler)
+	require.NoError(t, err)
+	_, err = decorator.AnteHandle(ctx, newEthereumTx(&to, 0, []byte{0xa9, 0x05, 0x9c, 0xbb}), false, nextAnteHandler)
+	require.NoError(t, err)
+	_, err = decorator.AnteHandle(ctx, newEthereumTx(nil, 0, []byte{0x60, 0x00}), false, nextAnteHandler)
+	require.NoError(t, err)
+}
+
+func TestEVMMinValueTransferDecorator(t *testing.T) {
+	app := setupTestApp(t)
+	ctx := newTestContext(app).WithIsCheckTx(true)
+	decorator := antehandlers.NewEVMMinValueTransferDecorator(math.NewInt(1_000))
+
+	newEthereumTx := func(to *common.Address, amount int64, data []byte) sdk.Tx {
+		msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+			ChainID:  big.NewInt(12000),
+			To:       to,
+			Amount:   big.NewInt(amount),
+			GasLimit: 53_000,
+			GasPrice: big.NewInt(1_000),
+			Input:    data,
+		})
+		msg.From = common.HexToAddress("0x00000000000000000000000000000000000000dd").Bytes()
+		return buildTestTx(t, app, msg)
+	}
+	to := common.HexToAddress("0x7cb61d4117ae31a12e393a1cfa3bac666481d02e")
+
+	// a dust value transfer
+	_, err := decorator.AnteHandle(ctx, newEthereumTx(&to, 999, nil), false, nextAnteHandler)
+	require.ErrorIs(t, err, errortypes.ErrInvalidRequest)
+
+	// block execution doesn't depend on the node's policy
+	_, err = decorator.AnteHandle(ctx.WithIsCheckTx(false), newEthereumTx(&to, 999, nil), false, nextAnteHandler)
+	require.NoError(t, err)
+
+	// transfers of the minimum, contract calls and creations go through
+	_, err = decorator.AnteHandle(ctx, newEthereumTx(&to, 1_000, nil), false, nextAnteHandler)
+	require.NoError(t, err)
+	_, err = decorator.AnteHandle(ctx, newEthereumTx(&to, 1, []byte{0xa9, 0x05, 0x9c, 0xbb}), false, nextAnteHandler)
+	require.NoError(t, err)
+	_, err = decorator.AnteHandle(ctx, newEthereumTx(nil, 1, []byte{0x60, 0x00}), false, nextAnteHandler)
+	require.NoError(t, err)
+}
+
+func TestWasmMinGasLimitDecorator(t *testing.T) {
+	app := setupTestApp(t)
+	ctx := newTestContext(app).WithIsCheckTx(true)
+	decorator := antehandlers.NewWasmMinGasLimitDecorator(200_000)
+
+	newTx := func(gasLimit uint64, msgs ...sdk.Msg) sdk.Tx {
+		builder := app.TxConfig().NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(msgs...))
+		builder.SetGasLimit(gasLimit)
+		return builder.GetTx()
+	}
+	execute := &wasmtypes.MsgExecuteContract{
+		Sender:   sdk.AccAddress([]byte("executor____________")).String(),
+		Contract: sdk.AccAddress([]byte("contract_address________________")).String(),
+		Msg:      []byte(`{}`),
+	}
+
+	// too low a gas limit for a contract execution
+	_, err := decorator.AnteHandle(ctx, newTx(50_000, execute), false, nextAnteHandler)
+	require.ErrorIs(t, err, errortypes.ErrOutOfGas)
+
+	// block execution doesn't depend on the node's policy
+	_, err = decorator.AnteHandle(ctx.WithIsCheckTx(false), newTx(50_000, execute), false, nextAnteHandler)
+	require.NoError(t, err)
+
+	// enough gas, simulations and other messages go through
+	_, err = decorator.AnteHandle(ctx, newTx(200_000, execute), false, nextAnteHandler)
+	require.NoError(t, err)
+	_, err = decorator.AnteHandle(ctx, newTx(0, execute), true, nextAnteHandler)
+	require.NoError(t, err)
+	_, err = decorator.AnteHandle(ctx, newTx(50_000, sendMsgsFromSigners(1)...), false, nextAnteHandler)
+	require.NoError(t, err)
+}
+
+func TestEVMGasPriceFloorDecorator(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).CacheContext()
+	decorator := antehandlers.NewEVMGasPriceFloorDecorator(app.EVMGasPriceFloorKeeper)
+
+	listed := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	other := common.HexToAddress("0x00000000000000000000000000000000000000bb")
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	// only the authority may set floors
+	setFloor := &kudoratypes.MsgSetEVMGasPriceFloor{Authority: other.Hex(), Sender: listed.Hex(), MinGasPrice: math.NewInt(5_000)}
+	handler := app.MsgServiceRouter().Handler(setFloor)
+	require.NotNil(t, handler)
+	_, err := handler(ctx, setFloor)
+	require.ErrorIs(t, err, errortypes.ErrUnauthorized)
+	setFloor.Authority = authority
+	_, err = handler(ctx, setFloor)
+	require.NoError(t, err)
+
+	newEthereumTx := func(from common.Address, gasPrice int64) sdk.Tx {
+		to := common.HexToAddress("0x7cb61d4117ae31a12e393a1cfa3bac666481d02e")
+		msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+			ChainID:  big.NewInt(12000),
+			To:       &to,
+			Amount:   big.NewInt(1),
+			GasLimit: 21_000,
+			GasPrice: big.NewInt(gasPrice),
+		})
+		msg.From = from.Bytes()
+		return buildTestTx(t, app, msg)
+	}
+
+	// the listed sender must pay at least its floor
+	_, err = decorator.AnteHandle(ctx, newEthereumTx(listed, 1_000), false, nextAnteHandler)
+	require.ErrorIs(t, err, errortypes.ErrInsufficientFee)
+	_, err = decorator.AnteHandle(ctx, newEthereumTx(listed, 5_000), false, nextAnteHandler)
+	require.NoError(t, err)
+
+	// other senders are unaffected
+	_, err = decorator.AnteHandle(ctx, newEthereumTx(other, 1_000), false, nextAnteHandler)
+	require.NoError(t, err)
+
+	// a zero floor removes it
+	setFloor.MinGasPrice = math.ZeroInt()
+	_, err = handler(ctx, setFloor)
+	require.NoError(t, err)
+	_, err = decorator.AnteHandle(ctx, newEthereumTx(listed, 1_000), false, nextAnteHandler)
+	require.NoError(t, err)
+}
+
+func TestSelfTransferDecorator(t *testing.T) {
+	app := setupTestApp(t)
+	ctx := newTestContext(app).WithIsCheckTx(true)
+	decorator := antehandlers.NewSelfTransferDecorator()
+
+	sender := sdk.AccAddress([]byte("self_sender_________"))
+	recipient := sdk.AccAddress([]byte("recipient___________"))
+	amount := sdk.NewCoins(sdk.NewCoin(BaseDenom, math.NewInt(1)))
+
+	// sends to oneself, directly, in a multi send or through authz
+	_, err := decorator.AnteHandle(ctx, buildTestTx(t, app, banktypes.NewMsgSend(sender, sender, amount)), false, nextAnteHandler)
+	require.ErrorIs(t, err, errortypes.ErrInvalidRequest)
+
+	multiSend := banktypes.NewMsgMultiSend(
+		banktypes.NewInput(sender, amount.Add(amount...)),
+		[]banktypes.Output{banktypes.NewOutput(recipient, amount), banktypes.NewOutput(sender, amount)},
+	)
+	_, err = decorator.AnteHandle(ctx, buildTestTx(t, app, multiSend), false, nextAnteHandler)
+	require.ErrorIs(t, err, errortypes.ErrInvalidRequest)
+
+	exec := authz.NewMsgExec(recipient, []sdk.Msg{banktypes.NewMsgSend(sender, sender, amount)})
+	_, err = decorator.AnteHandle(ctx, buildTestTx(t, app, &exec), false, nextAnteHandler)
+	require.ErrorIs(t, err, errortypes.ErrInvalidRequest)
+
+	// block execution doesn't depend on the node's policy
+	_, err = decorator.AnteHandle(ctx.WithIsCheckTx(false), buildTestTx(t, app, &exec), false, nextAnteHandler)
+	require.NoError(t, err)
+
+	// sends to others go through
+	_, err = decorator.AnteHandle(ctx, buildTestTx(t, app, banktypes.NewMsgSend(sender, recipient, amount)), false, nextAnteHandler)
+	require.NoError(t, err)
+}
+
+func TestRejectAllDecorator(t *testing.T) {
+	app := setupTestApp(t)
+	ctx := newTestContext(app).WithIsCheckTx(true)
+	decorator := antehandlers.NewRejectAllDecorator(app.anteOptions.TxGate)
+	tx := buildTestTx(t, app, sendMsgsFromSigners(1)...)
+
+	// new txs are rejected while the mode is on
+	app.SetRejectAllTxs(true)
+	_, err := decorator.AnteHandle(ctx, tx, false, nextAnteHandler)
+	require.ErrorIs(t, err, errortypes.ErrInvalidRequest)
+	require.Contains(t, app.AnteConfigSnapshot().EnabledDecorators, "reject-all")
+
+	// block execution and simulations are unaffected
+	_, err = decorator.AnteHandle(ctx.WithIsCheckTx(false), tx, false, nextAnteHandler)
+	require.NoError(t, err)
+	_, err = decorator.AnteHandle(ctx, tx, true, nextAnteHandler)
+	require.NoError(t, err)
+
+	// and accepted again once it is off
+	app.SetRejectAllTxs(false)
+	_, err = decorator.AnteHandle(ctx, tx, false, nextAnteHandler)
+	require.NoError(t, err)
+	require.NotContains(t, app.AnteConfigSnapshot().EnabledDecorators, "reject-all")
+}
+
+// peerCount reports a fixed number of peers.
+type peerCount int
+
+func (p peerCount) NetInfo(context.Context) (*coretypes.ResultNetInfo, error) {
+	return &coretypes.ResultNetInfo{NPeers: int(p)}, nil
+}
+
+func TestCheckPeerCount(t *testing.T) {
+	app := setupTestApp(t)
+	app.minPeers = 3
+	ctx := newTestContext(app).WithIsCheckTx(true)
+	decorator := antehandlers.NewRejectAllDecorator(app.anteOptions.TxGate)
+	tx := buildTestTx(t, app, sendMsgsFromSigners(1)...)
+
+	// new txs are rejected once the node has too few peers
+	app.checkPeerCount(peerCount(2))
+	_, err := decorator.AnteHandle(ctx, tx, false, nextAnteHandler)
+	require.ErrorIs(t, err, errortypes.ErrInvalidRequest)
+
+	// block execution is unaffected
+	_, err = decorator.AnteHandle(ctx.WithIsCheckTx(false), tx, false, nextAnteHandler)
+	require.NoError(t, err)
+
+	// and they are accepted again once it has enough
+	app.checkPeerCount(peerCount(3))
+	_, err = decorator.AnteHandle(ctx, tx, false, nextAnteHandler)
+	require.NoError(t, err)
+}
+
+func TestDeadlineDecorator(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).WithBlockHeight(100).CacheContext()
+	decorator := antehandlers.NewDeadlineDecorator(app.KudoraParamsKeeper)
+
+	newTx := func(timeoutHeight uint64, timeout time.Time, msgs ...sdk.Msg) sdk.Tx {
+		builder := app.TxConfig().NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(msgs...))
+		builder.SetTimeoutHeight(timeoutHeight)
+		builder.SetTimeoutTimestamp(timeout)
+		return builder.GetTx()
+	}
+	cosmosMsgs := sendMsgsFromSigners(1)
+	evmMsg := newTestEthereumTx(common.HexToAddress("0x00000000000000000000000000000000000000aa"), 0, 1_000)
+
+	// disabled by default
+	_, err := decorator.AnteHandle(ctx, newTx(99, time.Time{}, evmMsg), false, nextAnteHandler)
+	require.NoError(t, err)
+
+	params := app.KudoraParamsKeeper.GetParams(ctx)
+	params.RejectExpiredTxs = true
+	require.NoError(t, app.KudoraParamsKeeper.SetParams(ctx, params))
+
+	for name, msgs := range map[string][]sdk.Msg{"cosmos": cosmosMsgs, "evm": {evmMsg}} {
+		t.Run(name, func(t *testing.T) {
+			// without deadline or with a deadline ahead the tx goes through
+			_, err := decorator.AnteHandle(ctx, newTx(0, time.Time{}, msgs...), false, nextAnteHandler)
+			require.NoError(t, err)
+			_, err = decorator.AnteHandle(ctx, newTx(100, ctx.BlockTime(), msgs...), false, nextAnteHandler)
+			require.NoError(t, err)
+
+			// past either deadline it is rejected
+			_, err = decorator.AnteHandle(ctx, newTx(99, time.Time{}, msgs...), false, nextAnteHandler)
+			require.ErrorIs(t, err, errortypes.ErrTxTimeoutHeight)
+			_, err = decorator.AnteHandle(ctx, newTx(0, ctx.BlockTime().Add(-time.Second), msgs...), false, nextAnteHandler)
+			require.ErrorIs(t, err, errortypes.ErrTxTimeout)
+		})
+	}
+}
+
+func TestAnteDecoratorOrder(t *testing.T) {
+	cosmos, evm := AnteDecoratorOrder()
+	require.Equal(t, []string{"reject-messages", "authz-limiter"}, cosmos[:2])
+	require.Equal(t, []string{"reject-all", "deadline"}, evm[:2])
+	require.Equal(t, "gas-usage", cosmos[len(cosmos)-1])
+	require.Equal(t, "gas-usage", evm[len(evm)-1])
+
+	// optional decorators are listed even when disabled
+	for _, name := range []string{"reject-all", "max-signers", "fee-grant-check", "msg-fees", "wasm-min-gas-limit"} {
+		require.Contains(t, cosmos, name)
+	}
+	for _, name := range []string{"evm-read-only", "evm-gas-price-floors", "evm-replacement-price-bump"} {
+		require.Contains(t, evm, name)
+	}
+}
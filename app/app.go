@@ -1,6 +1,7 @@
 package app
 
 import (
+	"fmt"
 	"io"
 	"math/big"
 
@@ -40,6 +41,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	mintkeeper "github.com/cosmos/cosmos-sdk/x/mint/keeper"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -54,6 +56,8 @@ import (
 	icacontrollerkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/keeper"
 	icahostkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/keeper"
 	ibckeeper "github.com/cosmos/ibc-go/v10/modules/core/keeper"
+	"github.com/ethereum/go-ethereum/common"
+	gethvm "github.com/ethereum/go-ethereum/core/vm"
 	_ "github.com/ethereum/go-ethereum/eth/tracers/js"
 	_ "github.com/ethereum/go-ethereum/eth/tracers/native"
 
@@ -67,6 +71,7 @@ import (
 	ratelimitkeeper "github.com/cosmos/ibc-apps/modules/rate-limiting/v10/keeper"
 
 
+	"kudora/app/middleware"
 	"kudora/docs"
 )
 
@@ -120,18 +125,37 @@ type App struct {
 	TransferKeeper      ibctransferkeeper.Keeper
 	PacketForwardKeeper *packetforwardkeeper.Keeper
 	RateLimitKeeper     *ratelimitkeeper.Keeper
+	IBCMiddlewareKeeper middleware.Keeper
 
-	// token factory keeper
-	TokenFactoryKeeper tokenfactorykeeper.Keeper
+	// token factory keepers
+	TokenFactoryKeeper      tokenfactorykeeper.Keeper
+	BeforeSendHooksKeeper   BeforeSendHooksKeeper
+	DenomMetadataLockKeeper DenomMetadataLockKeeper
+	DenomMintPauseKeeper    DenomMintPauseKeeper
+	DenomAdminHistoryKeeper DenomAdminHistoryKeeper
+	DenomSendEnabledKeeper  DenomSendEnabledKeeper
+	DenomBurnKeeper         DenomBurnKeeper
 
 	// simulation manager
 	sm                 *module.SimulationManager
 	clientCtx          client.Context
 	pendingTxListeners []evmante.PendingTxListener
+	anteOptions        HandlerOptions
+	minGasPrices       sdk.DecCoins
+
+	checkIBCEscrowInvariant bool
+	simulationGasAdjustment float64
+	feeHistory              feeHistory
+	staticPrecompiles       map[common.Address]gethvm.PrecompiledContract
+	feeMarketPriorityTip    math.LegacyDec
+	minPeers                int
 	FeeGrantKeeper     feegrantkeeper.Keeper
 	FeeMarketKeeper    feemarketkeeper.Keeper
 	EVMKeeper          *evmkeeper.Keeper
 	Erc20Keeper        erc20keeper.Keeper
+	EVMGasPriceFloorKeeper EVMGasPriceFloorKeeper
+	KudoraParamsKeeper     KudoraParamsKeeper
+	AutoCompoundKeeper     AutoCompoundKeeper
 	EVMMempool         *evmmempool.ExperimentalEVMMempool
 	WasmKeeper         wasmkeeper.Keeper
 
@@ -154,6 +178,8 @@ func init() {
 func AppConfig() depinject.Config {
 	return depinject.Configs(
 		appConfig,
+		govModuleConfig(),
+		stakingModuleConfig(),
 		depinject.Supply(
 			// supply custom module basics
 			map[string]module.AppModuleBasic{
@@ -182,12 +208,15 @@ func New(
 			depinject.Supply(
 				appOpts, // supply app options
 				logger,  // supply logger
+				app,     // supply the app to the providers of its wrapped keepers
 				// here alternative options can be supplied to the DI container.
 				// those options can be used f.e to override the default behavior of some modules.
 				// for instance supplying a custom address codec for not using bech32 addresses.
 				// read the depinject documentation and depinject module wiring for more information
 				// on available options and how to use them.
 			), depinject.Provide(ProvideMsgEthereumTxCustomGetSigner),
+			stakingBankKeeperConfig(),
+			kudoraParamsConfig(),
 		)
 	)
 
@@ -222,6 +251,18 @@ func New(
 
 	// build app
 	app.App = appBuilder.Build(db, traceStore, baseAppOptions...)
+
+	// Kudora's own store, backing its block hooks and on-chain lists
+	if err := app.RegisterStores(storetypes.NewKVStoreKey(KudoraStoreKey)); err != nil {
+		panic(err)
+	}
+	app.KudoraParamsKeeper = NewKudoraParamsKeeper(
+		app.appCodec,
+		app.GetKey(KudoraStoreKey),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	app.AutoCompoundKeeper = NewAutoCompoundKeeper(app.GetKey(KudoraStoreKey))
+
 	if err := app.registerEVMModules(appOpts); err != nil {
 		panic(err)
 	}
@@ -240,6 +281,16 @@ func New(
 		panic(err)
 	}
 
+	// Kudora options used by the block, genesis and simulation hooks
+	if err := app.loadKudoraOptions(appOpts); err != nil {
+		panic(err)
+	}
+
+	// register the app-local module running Kudora's block hooks
+	if err := app.RegisterModules(kudoraModule{app: app}); err != nil {
+		panic(err)
+	}
+
 	/****  Module Options ****/
 
 	// create the simulation manager and define the order of the modules for deterministic simulations
@@ -263,6 +314,10 @@ func New(
 
 	app.setEVMMempool()
 
+	if err := app.setUpgradeHandlers(); err != nil {
+		panic(err)
+	}
+
 	if err := app.Load(loadLatest); err != nil {
 		panic(err)
 	}
@@ -338,6 +393,43 @@ func GetMaccPerms() map[string][]string {
 	return dup
 }
 
+// ModuleAccountAddress returns the account address of the given module.
+// It fails if the module has no registered module account.
+func (app *App) ModuleAccountAddress(name string) (sdk.AccAddress, error) {
+	if _, ok := GetMaccPerms()[name]; !ok {
+		return nil, fmt.Errorf("no module account registered for module %s", name)
+	}
+
+	addr := authtypes.NewModuleAddress(name)
+	if _, err := app.AuthKeeper.AddressCodec().BytesToString(addr); err != nil {
+		return nil, fmt.Errorf("failed to encode module account address of %s: %w", name, err)
+	}
+
+	return addr, nil
+}
+
+// ModuleAccountBalances returns the balances of all registered module
+// accounts, keyed by module name.
+func (app *App) ModuleAccountBalances(ctx sdk.Context) map[string]sdk.Coins {
+	balances := make(map[string]sdk.Coins)
+	for name := range GetMaccPerms() {
+		balances[name] = app.BankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(name))
+	}
+
+	return balances
+}
+
+// ModuleVersionMap returns the consensus versions of all modules as stored by
+// x/upgrade, or those of the running binary if none are stored yet, i.e.
+// before genesis.
+func (app *App) ModuleVersionMap(ctx sdk.Context) module.VersionMap {
+	versions, err := app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	if err != nil || len(versions) == 0 {
+		return app.ModuleManager.GetVersionMap()
+	}
+	return versions
+}
+
 // BlockedAddresses returns all the app's blocked account addresses.
 func BlockedAddresses() map[string]bool {
 	result := make(map[string]bool)
@@ -15,13 +15,11 @@ import (
 	evidencemodulev1 "cosmossdk.io/api/cosmos/evidence/module/v1"
 	feegrantmodulev1 "cosmossdk.io/api/cosmos/feegrant/module/v1"
 	genutilmodulev1 "cosmossdk.io/api/cosmos/genutil/module/v1"
-	govmodulev1 "cosmossdk.io/api/cosmos/gov/module/v1"
 	groupmodulev1 "cosmossdk.io/api/cosmos/group/module/v1"
 	mintmodulev1 "cosmossdk.io/api/cosmos/mint/module/v1"
 	nftmodulev1 "cosmossdk.io/api/cosmos/nft/module/v1"
 	paramsmodulev1 "cosmossdk.io/api/cosmos/params/module/v1"
 	slashingmodulev1 "cosmossdk.io/api/cosmos/slashing/module/v1"
-	stakingmodulev1 "cosmossdk.io/api/cosmos/staking/module/v1"
 	txconfigv1 "cosmossdk.io/api/cosmos/tx/config/v1"
 	upgrademodulev1 "cosmossdk.io/api/cosmos/upgrade/module/v1"
 	vestingmodulev1 "cosmossdk.io/api/cosmos/vesting/module/v1"
@@ -142,6 +140,9 @@ var (
 						evmtypes.ModuleName,
 						tokenfactorytypes.ModuleName,
 						packetforwardtypes.ModuleName,
+						// must run after slashing and evidence, and before the rate
+						// limit module resets the flows
+						KudoraModuleName,
     					ratelimittypes.ModuleName,
 						wasmtypes.ModuleName,
 						// this line is used by starport scaffolding # stargate/app/beginBlockers
@@ -160,6 +161,7 @@ var (
 						packetforwardtypes.ModuleName,
     					ratelimittypes.ModuleName,
 						wasmtypes.ModuleName,
+						KudoraModuleName,
 						// this line is used by starport scaffolding # stargate/app/endBlockers
 					},
 					// The following is mostly only needed when ModuleName != StoreKey name.
@@ -203,6 +205,7 @@ var (
 						packetforwardtypes.ModuleName,
     					ratelimittypes.ModuleName,
 						wasmtypes.ModuleName,
+						KudoraModuleName,
 						genutiltypes.ModuleName,
 						// this line is used by starport scaffolding # stargate/app/initGenesis
 					},
@@ -229,10 +232,7 @@ var (
 					BlockedModuleAccountsOverride: getBlockAccAddrs(),
 				}),
 			},
-			{
-				Name:   stakingtypes.ModuleName,
-				Config: appconfig.WrapAny(&stakingmodulev1.Module{}),
-			},
+			// staking is wired by stakingModuleConfig, which wraps its msg server
 			{
 				Name:   slashingtypes.ModuleName,
 				Config: appconfig.WrapAny(&slashingmodulev1.Module{}),
@@ -280,10 +280,7 @@ var (
 				Name:   feegrant.ModuleName,
 				Config: appconfig.WrapAny(&feegrantmodulev1.Module{}),
 			},
-			{
-				Name:   govtypes.ModuleName,
-				Config: appconfig.WrapAny(&govmodulev1.Module{}),
-			},
+			// gov is wired by govModuleConfig, which wraps its msg server
 			{
 				Name:   consensustypes.ModuleName,
 				Config: appconfig.WrapAny(&consensusmodulev1.Module{}),
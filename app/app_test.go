@@ -0,0 +1,992 @@
+package app
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	circuitkeeper "cosmossdk.io/x/circuit/keeper"
+	circuittypes "cosmossdk.io/x/circuit/types"
+	"cosmossdk.io/x/feegrant"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/evm/x/vm/statedb"
+	packetforwardtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v10/packetforward/types"
+	ratelimittypes "github.com/cosmos/ibc-apps/modules/rate-limiting/v10/types"
+	tokenfactorytypes "github.com/cosmos/tokenfactory/x/tokenfactory/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	gethvm "github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	gethparams "github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+
+	kudoratypes "kudora/x/kudora/types"
+)
+
+func TestModuleAccountAddress(t *testing.T) {
+	app := setupTestApp(t)
+
+	addr, err := app.ModuleAccountAddress(distrtypes.ModuleName)
+	require.NoError(t, err)
+	require.Equal(t, authtypes.NewModuleAddress(distrtypes.ModuleName), addr)
+	require.Equal(t, app.AuthKeeper.GetModuleAddress(distrtypes.ModuleName), addr)
+
+	_, err = app.ModuleAccountAddress("unknown")
+	require.Error(t, err)
+}
+
+func TestModuleAccountBalances(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).CacheContext()
+
+	before := app.ModuleAccountBalances(ctx)
+	require.Len(t, before, len(GetMaccPerms()))
+
+	// collect fees for the block
+	fees := sdk.NewCoins(sdk.NewCoin(BaseDenom, math.NewInt(1_000_000)))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, fees))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, authtypes.FeeCollectorName, fees))
+
+	after := app.ModuleAccountBalances(ctx)
+	require.Equal(t, before[authtypes.FeeCollectorName].Add(fees...), after[authtypes.FeeCollectorName])
+}
+
+func TestAddressConversionRoundTrip(t *testing.T) {
+	app := setupTestApp(t)
+	ctx := newTestContext(app)
+	queryClient := newTestQueryClient(app, ctx)
+
+	const (
+		bech32Addr = "kudo10jmp6sgh4cc6zt3e8gw05wavvejgr5pwks6dl6"
+		hexAddr    = "0x7cb61d4117ae31a12e393a1cfa3bac666481d02e"
+	)
+
+	evmRes, err := queryClient.EVMAddress(ctx, &kudoratypes.QueryEVMAddressRequest{Address: bech32Addr})
+	require.NoError(t, err)
+	require.Equal(t, common.HexToAddress(hexAddr).Hex(), evmRes.EvmAddress)
+
+	bech32Res, err := queryClient.Bech32Address(ctx, &kudoratypes.QueryBech32AddressRequest{EvmAddress: evmRes.EvmAddress})
+	require.NoError(t, err)
+	require.Equal(t, bech32Addr, bech32Res.Address)
+
+	_, err = queryClient.EVMAddress(ctx, &kudoratypes.QueryEVMAddressRequest{Address: "cosmos10jmp6sgh4cc6zt3e8gw05wavvejgr5pwxyzabc"})
+	require.Error(t, err)
+	_, err = queryClient.Bech32Address(ctx, &kudoratypes.QueryBech32AddressRequest{EvmAddress: "0x1234"})
+	require.Error(t, err)
+}
+
+func TestBech32Prefixes(t *testing.T) {
+	app := setupTestApp(t)
+
+	prefixes := app.Bech32Prefixes()
+	require.Equal(t, "kudo", prefixes.AccountAddr)
+	require.Equal(t, Bech32Config{
+		AccountAddr:   Bech32PrefixAccAddr,
+		AccountPub:    Bech32PrefixAccPub,
+		ValidatorAddr: Bech32PrefixValAddr,
+		ValidatorPub:  Bech32PrefixValPub,
+		ConsensusAddr: Bech32PrefixConsAddr,
+		ConsensusPub:  Bech32PrefixConsPub,
+	}, prefixes)
+}
+
+func TestFundCommunityPoolFromFees(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).CacheContext()
+	require.NoError(t, app.DistrKeeper.FeePool.Set(ctx, distrtypes.InitialFeePool()))
+
+	params := kudoratypes.DefaultParams()
+	params.CommunityPoolFeePercent = 10
+	require.NoError(t, app.KudoraParamsKeeper.SetParams(ctx, params))
+
+	// collect fees for the block
+	feeCollector := app.AuthKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	fees := sdk.NewCoins(sdk.NewCoin(BaseDenom, math.NewInt(1_000_000)))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, fees))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, authtypes.FeeCollectorName, fees))
+	collected := app.BankKeeper.GetBalance(ctx, feeCollector, BaseDenom)
+
+	require.NoError(t, app.fundCommunityPoolFromFees(ctx))
+
+	expected := collected.Amount.QuoRaw(10)
+	feePool, err := app.DistrKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecFromInt(expected), feePool.CommunityPool.AmountOf(BaseDenom))
+	require.Equal(t, collected.Amount.Sub(expected), app.BankKeeper.GetBalance(ctx, feeCollector, BaseDenom).Amount)
+
+	params.CommunityPoolFeePercent = 101
+	require.Error(t, app.KudoraParamsKeeper.SetParams(ctx, params))
+}
+
+func TestEVMChainConfig(t *testing.T) {
+	app := setupTestApp(t)
+
+	cfg := app.EVMChainConfig()
+	require.NotNil(t, cfg)
+	require.Equal(t, cosmosChainIDToEVMChainID(testChainID), cfg.ChainId)
+
+	// all forks up to Cancun are active from genesis
+	ethCfg := cfg.EthereumConfig(nil)
+	require.Equal(t, new(big.Int).SetUint64(cfg.ChainId), ethCfg.ChainID)
+	require.True(t, ethCfg.IsLondon(big.NewInt(0)))
+	require.True(t, ethCfg.IsShanghai(big.NewInt(0), 0))
+	require.True(t, ethCfg.IsCancun(big.NewInt(0), 0))
+}
+
+// codeKeeper serves contract code from memory for maxCodeSizeHook.
+type codeKeeper map[common.Address][]byte
+
+func (k codeKeeper) GetAccount(_ sdk.Context, addr common.Address) *statedb.Account {
+	code, ok := k[addr]
+	if !ok {
+		return nil
+	}
+	return &statedb.Account{CodeHash: crypto.Keccak256(code)}
+}
+
+func (k codeKeeper) GetCode(_ sdk.Context, codeHash common.Hash) []byte {
+	for _, code := range k {
+		if crypto.Keccak256Hash(code) == codeHash {
+			return code
+		}
+	}
+	return nil
+}
+
+// staticParamsReader serves fixed Kudora params.
+type staticParamsReader kudoratypes.Params
+
+func (p staticParamsReader) GetParams(sdk.Context) kudoratypes.Params {
+	return kudoratypes.Params(p)
+}
+
+func TestMaxCodeSizeHook(t *testing.T) {
+	const maxCodeSize = 1024
+
+	small := common.HexToAddress("0x00000000000000000000000000000000000000c1")
+	large := common.HexToAddress("0x00000000000000000000000000000000000000c2")
+	keeper := codeKeeper{
+		small: bytes.Repeat([]byte{0x60}, maxCodeSize),
+		large: bytes.Repeat([]byte{0x61}, maxCodeSize+1),
+	}
+	params := kudoratypes.DefaultParams()
+	params.EvmMaxCodeSize = maxCodeSize
+	hook := maxCodeSizeHook{keeper: keeper, params: staticParamsReader(params)}
+
+	deployed := func(addr common.Address) *ethtypes.Receipt {
+		return &ethtypes.Receipt{Status: ethtypes.ReceiptStatusSuccessful, ContractAddress: addr}
+	}
+
+	// at the limit
+	require.NoError(t, hook.PostTxProcessing(sdk.Context{}, common.Address{}, core.Message{}, deployed(small)))
+
+	// just over the limit
+	err := hook.PostTxProcessing(sdk.Context{}, common.Address{}, core.Message{}, deployed(large))
+	require.ErrorIs(t, err, gethvm.ErrMaxCodeSizeExceeded)
+
+	// calls are not checked
+	require.NoError(t, hook.PostTxProcessing(sdk.Context{}, common.Address{}, core.Message{To: &large}, deployed(large)))
+
+	// no limit by default
+	hook.params = staticParamsReader(kudoratypes.DefaultParams())
+	require.NoError(t, hook.PostTxProcessing(sdk.Context{}, common.Address{}, core.Message{}, deployed(large)))
+
+	// the EIP-170 limit can't be raised
+	params.EvmMaxCodeSize = gethparams.MaxCodeSize + 1
+	require.Error(t, params.Validate())
+}
+
+func TestSimulationGasAdjustment(t *testing.T) {
+	adjustment, err := simulationGasAdjustment(simtestutil.AppOptionsMap{})
+	require.NoError(t, err)
+	require.Zero(t, adjustment)
+
+	_, err = simulationGasAdjustment(simtestutil.AppOptionsMap{FlagSimulationGasAdjustment: 0.5})
+	require.Error(t, err)
+
+	simulate := func([]byte) (sdk.GasInfo, *sdk.Result, error) {
+		return sdk.GasInfo{GasWanted: 200_000, GasUsed: 100_001}, &sdk.Result{}, nil
+	}
+	gasInfo, _, err := newAdjustedSimulateFn(simulate, 1.5)(nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(150_002), gasInfo.GasUsed)
+	require.Equal(t, uint64(200_000), gasInfo.GasWanted)
+
+	failing := func([]byte) (sdk.GasInfo, *sdk.Result, error) {
+		return sdk.GasInfo{GasUsed: 100}, nil, errors.New("out of gas")
+	}
+	gasInfo, _, err = newAdjustedSimulateFn(failing, 1.5)(nil)
+	require.Error(t, err)
+	require.Equal(t, uint64(100), gasInfo.GasUsed)
+}
+
+func TestModuleVersionMap(t *testing.T) {
+	app := setupTestApp(t)
+	ctx := newTestContext(app)
+
+	versions := app.ModuleVersionMap(ctx)
+	for _, name := range []string{
+		tokenfactorytypes.ModuleName,
+		ratelimittypes.ModuleName,
+		packetforwardtypes.ModuleName,
+	} {
+		require.Contains(t, versions, name)
+		require.Positive(t, versions[name], "module %s", name)
+	}
+}
+
+func TestUpgradeHandler(t *testing.T) {
+	app := setupTestApp(t)
+
+	require.True(t, app.UpgradeKeeper.HasHandler(UpgradeName))
+	for _, name := range upgradeAddedStores {
+		require.NotNil(t, app.GetKey(name), "store %s", name)
+	}
+}
+
+func TestSlashRedirectToCommunityPool(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).CacheContext()
+	require.NoError(t, app.DistrKeeper.FeePool.Set(ctx, distrtypes.InitialFeePool()))
+
+	stakingParams := stakingtypes.DefaultParams()
+	stakingParams.BondDenom = BaseDenom
+	require.NoError(t, app.StakingKeeper.SetParams(ctx, stakingParams))
+
+	params := kudoratypes.DefaultParams()
+	params.SlashToCommunityPool = true
+	require.NoError(t, app.KudoraParamsKeeper.SetParams(ctx, params))
+
+	// a bonded validator with 100 units of power
+	valAddr := sdk.ValAddress([]byte("slashed_validator___"))
+	validator, err := stakingtypes.NewValidator(valAddr.String(), ed25519.GenPrivKey().PubKey(), stakingtypes.Description{Moniker: "slashed"})
+	require.NoError(t, err)
+	tokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 100)
+	validator.Tokens = tokens
+	validator.DelegatorShares = math.LegacyNewDecFromInt(tokens)
+	validator.Status = stakingtypes.Bonded
+	require.NoError(t, app.StakingKeeper.SetValidator(ctx, validator))
+	require.NoError(t, app.StakingKeeper.SetValidatorByConsAddr(ctx, validator))
+	require.NoError(t, app.DistrKeeper.Hooks().AfterValidatorCreated(ctx, valAddr))
+
+	bonded := sdk.NewCoins(sdk.NewCoin(BaseDenom, tokens))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, bonded))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, stakingtypes.BondedPoolName, bonded))
+	supply := app.BankKeeper.GetSupply(ctx, BaseDenom)
+
+	// slash 10%, as the slashing begin blocker does
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+	burned, err := app.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), 100, math.LegacyNewDecWithPrec(1, 1))
+	require.NoError(t, err)
+	require.Equal(t, tokens.QuoRaw(10), burned)
+
+	// the slashed tokens end up in the community pool rather than burned
+	feePool, err := app.DistrKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecFromInt(burned), feePool.CommunityPool.AmountOf(BaseDenom))
+	require.Equal(t, supply, app.BankKeeper.GetSupply(ctx, BaseDenom))
+}
+
+func TestPrecompileGasOverrides(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).CacheContext()
+	p256Address := common.HexToAddress("0x0000000000000000000000000000000000000100")
+	sender := common.HexToAddress("0x00000000000000000000000000000000000e0a01")
+	input := make([]byte, 160)
+	app.AuthKeeper.SetAccount(ctx, app.AuthKeeper.NewAccountWithAddress(ctx, sender.Bytes()))
+
+	evmParams := app.EVMKeeper.GetParams(ctx)
+	evmParams.ActiveStaticPrecompiles = []string{p256Address.Hex()}
+	require.NoError(t, app.EVMKeeper.SetParams(ctx, evmParams))
+
+	// charge the gas actually used, not a share of the gas limit
+	feeMarketParams := app.FeeMarketKeeper.GetParams(ctx)
+	feeMarketParams.MinGasMultiplier = math.LegacyZeroDec()
+	require.NoError(t, app.FeeMarketKeeper.SetParams(ctx, feeMarketParams))
+
+	res, err := app.EVMKeeper.CallEVMWithData(ctx, sender, &p256Address, input, false, nil)
+	require.NoError(t, err)
+	require.False(t, res.Failed())
+	defaultGasUsed := res.GasUsed
+	defaultCost := app.staticPrecompiles[p256Address].RequiredGas(input)
+
+	// the EVM charges the overridden cost, higher or lower than the default
+	for _, gas := range []uint64{defaultCost + 10_000, defaultCost / 2} {
+		params := kudoratypes.DefaultParams()
+		params.EvmPrecompileGas = []kudoratypes.PrecompileGas{{Address: p256Address.Hex(), Gas: gas}}
+		require.NoError(t, app.KudoraParamsKeeper.SetParams(ctx, params))
+
+		res, err = app.EVMKeeper.CallEVMWithData(ctx, sender, &p256Address, input, false, nil)
+		require.NoError(t, err)
+		require.False(t, res.Failed())
+		require.Equal(t, defaultGasUsed-defaultCost+gas, res.GasUsed)
+	}
+
+	// only precompiles other than the Ethereum ones can be overridden
+	params := kudoratypes.DefaultParams()
+	params.EvmPrecompileGas = []kudoratypes.PrecompileGas{{Address: "0x0000000000000000000000000000000000000001", Gas: 2500}}
+	require.ErrorContains(t, app.KudoraParamsKeeper.SetParams(ctx, params), "Ethereum precompile")
+	params.EvmPrecompileGas = []kudoratypes.PrecompileGas{{Address: "bech32", Gas: 2500}}
+	require.Error(t, app.KudoraParamsKeeper.SetParams(ctx, params))
+}
+
+func TestBlockContractPrecompileCalls(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).CacheContext()
+	ecrecoverAddress := common.BytesToAddress([]byte{0x01})
+	p256Address := common.HexToAddress("0x0000000000000000000000000000000000000100")
+	sender := common.HexToAddress("0x00000000000000000000000000000000000e0a00")
+	app.AuthKeeper.SetAccount(ctx, app.AuthKeeper.NewAccountWithAddress(ctx, sender.Bytes()))
+
+	evmParams := app.EVMKeeper.GetParams(ctx)
+	evmParams.ActiveStaticPrecompiles = []string{p256Address.Hex()}
+	require.NoError(t, app.EVMKeeper.SetParams(ctx, evmParams))
+
+	// a contract returning whether its static call to the address passed as
+	// calldata succeeded
+	runtime := common.FromHex("0x60006000600060006000355afa60005260206000f3")
+	initCode := append(common.FromHex("0x6015600c60003960156000f3"), runtime...)
+	_, err := app.EVMKeeper.CallEVMWithData(ctx, sender, nil, initCode, true, nil)
+	require.NoError(t, err)
+	caller := crypto.CreateAddress(sender, 0)
+	contractCalls := func(precompile common.Address) bool {
+		res, err := app.EVMKeeper.CallEVMWithData(ctx, sender, &caller, common.LeftPadBytes(precompile.Bytes(), 32), false, nil)
+		require.NoError(t, err)
+		require.False(t, res.Failed())
+		return new(big.Int).SetBytes(res.Ret).Sign() > 0
+	}
+
+	// contracts may call precompiles by default
+	require.True(t, contractCalls(p256Address))
+
+	params := kudoratypes.DefaultParams()
+	params.EvmBlockContractPrecompileCalls = true
+	require.NoError(t, app.KudoraParamsKeeper.SetParams(ctx, params))
+
+	// a contract call is rejected
+	require.False(t, contractCalls(p256Address))
+
+	// the sender may still call them directly
+	res, err := app.EVMKeeper.CallEVMWithData(ctx, sender, &p256Address, nil, false, nil)
+	require.NoError(t, err)
+	require.False(t, res.Failed())
+
+	// the Ethereum precompiles stay callable by contracts
+	require.True(t, contractCalls(ecrecoverAddress))
+}
+
+func TestEnabledPrecompiles(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).CacheContext()
+	ecrecoverAddress := common.BytesToAddress([]byte{0x01})
+	bech32Address := common.HexToAddress("0x0000000000000000000000000000000000000400")
+
+	// without active static precompiles, only the Ethereum ones are enabled
+	params := app.EVMKeeper.GetParams(ctx)
+	params.ActiveStaticPrecompiles = nil
+	require.NoError(t, app.EVMKeeper.SetParams(ctx, params))
+
+	enabled := app.EnabledPrecompiles(ctx)
+	require.Len(t, enabled, len(gethvm.PrecompiledContractsPrague))
+	require.Contains(t, enabled, ecrecoverAddress)
+	require.NotContains(t, enabled, bech32Address)
+
+	// activating a custom precompile enables it
+	params.ActiveStaticPrecompiles = []string{bech32Address.Hex()}
+	require.NoError(t, app.EVMKeeper.SetParams(ctx, params))
+
+	enabled = app.EnabledPrecompiles(ctx)
+	require.Contains(t, enabled, ecrecoverAddress)
+	require.Contains(t, enabled, bech32Address)
+}
+
+func TestJailEVMMissedBlocks(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).CacheContext()
+	require.NoError(t, app.SlashingKeeper.SetParams(ctx, slashingtypes.DefaultParams()))
+
+	params := app.KudoraParamsKeeper.GetParams(ctx)
+	params.EvmJailMissedBlocks = 5
+	require.NoError(t, app.KudoraParamsKeeper.SetParams(ctx, params))
+
+	// a bonded validator with signing info
+	valAddr := sdk.ValAddress([]byte("missing_validator___"))
+	validator, err := stakingtypes.NewValidator(valAddr.String(), ed25519.GenPrivKey().PubKey(), stakingtypes.Description{Moniker: "missing"})
+	require.NoError(t, err)
+	validator.Status = stakingtypes.Bonded
+	require.NoError(t, app.StakingKeeper.SetValidator(ctx, validator))
+	require.NoError(t, app.StakingKeeper.SetValidatorByConsAddr(ctx, validator))
+	require.NoError(t, app.StakingKeeper.SetValidatorByPowerIndex(ctx, validator))
+
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+	setMissedBlocks := func(missed int64) {
+		info := slashingtypes.NewValidatorSigningInfo(consAddr, 0, 0, time.Unix(0, 0).UTC(), false, missed)
+		require.NoError(t, app.SlashingKeeper.SetValidatorSigningInfo(ctx, consAddr, info))
+	}
+	isJailed := func() bool {
+		validator, err := app.StakingKeeper.GetValidator(ctx, valAddr)
+		require.NoError(t, err)
+		return validator.IsJailed()
+	}
+
+	// at the threshold the validator stays bonded
+	setMissedBlocks(5)
+	require.NoError(t, kudoraModule{app: app}.EndBlock(ctx))
+	require.False(t, isJailed())
+
+	// past it the validator is jailed and its missed blocks are reset
+	setMissedBlocks(6)
+	require.NoError(t, kudoraModule{app: app}.EndBlock(ctx))
+	require.True(t, isJailed())
+
+	jailDuration, err := app.SlashingKeeper.DowntimeJailDuration(ctx)
+	require.NoError(t, err)
+	info, err := app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockHeader().Time.Add(jailDuration), info.JailedUntil)
+	require.Zero(t, info.MissedBlocksCounter)
+}
+
+func TestSuggestedGasPrice(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).CacheContext()
+
+	original := app.feeMarketPriorityTip
+	defer func() { app.feeMarketPriorityTip = original }()
+
+	params := app.FeeMarketKeeper.GetParams(ctx)
+	params.BaseFee = math.LegacyNewDec(1_000_000_000)
+	params.MinGasPrice = math.LegacyZeroDec()
+	require.NoError(t, app.FeeMarketKeeper.SetParams(ctx, params))
+
+	// without a tip the base fee is suggested
+	tip, err := feeMarketPriorityTip(simtestutil.AppOptionsMap{})
+	require.NoError(t, err)
+	app.feeMarketPriorityTip = tip
+	require.Equal(t, app.CurrentBaseFee(ctx), app.SuggestedGasPrice(ctx))
+
+	// the suggestion exceeds the base fee by the tip
+	tip, err = feeMarketPriorityTip(simtestutil.AppOptionsMap{FlagFeeMarketPriorityTip: "2000000"})
+	require.NoError(t, err)
+	app.feeMarketPriorityTip = tip
+	require.Equal(t, app.CurrentBaseFee(ctx).Add(math.LegacyNewDec(2_000_000)), app.SuggestedGasPrice(ctx))
+
+	// the tip is added to the min gas price when above the base fee
+	params.MinGasPrice = math.LegacyNewDec(5_000_000_000)
+	require.NoError(t, app.FeeMarketKeeper.SetParams(ctx, params))
+	require.Equal(t, math.LegacyNewDec(5_002_000_000), app.SuggestedGasPrice(ctx))
+
+	_, err = feeMarketPriorityTip(simtestutil.AppOptionsMap{FlagFeeMarketPriorityTip: "-1"})
+	require.Error(t, err)
+}
+
+// TestValidatorsPerAccount checks that an account cannot operate more than
+// one validator: the operator address is derived from the account address, so
+// x/staking already caps validators per account at one and there is no need
+// for a configurable cap.
+func TestValidatorsPerAccount(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).CacheContext()
+
+	stakingParams := stakingtypes.DefaultParams()
+	stakingParams.BondDenom = BaseDenom
+	require.NoError(t, app.StakingKeeper.SetParams(ctx, stakingParams))
+
+	operator := sdk.AccAddress([]byte("validator_operator__"))
+	fundTestAccount(t, app, ctx, operator, sdk.NewCoins(sdk.NewCoin(BaseDenom, math.NewInt(10_000_000))))
+
+	msgServer := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+	createValidator := func() error {
+		msg, err := stakingtypes.NewMsgCreateValidator(
+			sdk.ValAddress(operator).String(),
+			ed25519.GenPrivKey().PubKey(),
+			sdk.NewCoin(BaseDenom, math.NewInt(1_000_000)),
+			stakingtypes.NewDescription("validator", "", "", "", ""),
+			stakingtypes.NewCommissionRates(math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(2, 1), math.LegacyNewDecWithPrec(1, 2)),
+			math.OneInt(),
+		)
+		require.NoError(t, err)
+		_, err = msgServer.CreateValidator(ctx, msg)
+		return err
+	}
+
+	require.NoError(t, createValidator())
+	require.ErrorIs(t, createValidator(), stakingtypes.ErrValidatorOwnerExists)
+}
+
+func TestFeeGrantsForGrantee(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).CacheContext()
+
+	granter := sdk.AccAddress([]byte("feegrant_granter____"))
+	grantee := sdk.AccAddress([]byte("feegrant_grantee____"))
+	fundTestAccount(t, app, ctx, granter, sdk.NewCoins(sdk.NewCoin(BaseDenom, math.NewInt(1_000_000))))
+
+	grants, err := app.FeeGrantsForGrantee(ctx, grantee)
+	require.NoError(t, err)
+	require.Empty(t, grants)
+
+	spendLimit := sdk.NewCoins(sdk.NewCoin(BaseDenom, math.NewInt(1_000)))
+	require.NoError(t, app.FeeGrantKeeper.GrantAllowance(ctx, granter, grantee, &feegrant.BasicAllowance{SpendLimit: spendLimit}))
+
+	// an expired grant to the same grantee is left out
+	expiredGranter := sdk.AccAddress([]byte("feegrant_expired____"))
+	fundTestAccount(t, app, ctx, expiredGranter, sdk.NewCoins(sdk.NewCoin(BaseDenom, math.NewInt(1_000_000))))
+	expiration := ctx.BlockTime().Add(time.Hour)
+	require.NoError(t, app.FeeGrantKeeper.GrantAllowance(ctx, expiredGranter, grantee, &feegrant.BasicAllowance{Expiration: &expiration}))
+
+	expiredCtx := ctx.WithBlockTime(expiration)
+	res, err := newTestQueryClient(app, expiredCtx).FeeGrantsForGrantee(expiredCtx, &kudoratypes.QueryFeeGrantsForGranteeRequest{
+		Grantee: grantee.String(),
+	})
+	require.NoError(t, err)
+	grants = res.Grants
+	require.Len(t, grants, 1)
+	require.Equal(t, granter.String(), grants[0].Granter)
+	require.Equal(t, grantee.String(), grants[0].Grantee)
+
+	allowance, err := grants[0].GetGrant()
+	require.NoError(t, err)
+	require.Equal(t, spendLimit, allowance.(*feegrant.BasicAllowance).SpendLimit)
+
+	// grants are only listed for their grantee
+	grants, err = app.FeeGrantsForGrantee(ctx, granter)
+	require.NoError(t, err)
+	require.Empty(t, grants)
+}
+
+func TestFeeHistory(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).CacheContext()
+	ctx = ctx.WithConsensusParams(cmtproto.ConsensusParams{Block: &cmtproto.BlockParams{MaxGas: 1_000_000}})
+
+	// three blocks with a rising base fee and usage
+	for i, gasUsed := range []uint64{250_000, 500_000, 1_000_000} {
+		params := app.FeeMarketKeeper.GetParams(ctx)
+		params.BaseFee = math.LegacyNewDec(int64(1_000 * (i + 1)))
+		require.NoError(t, app.FeeMarketKeeper.SetParams(ctx, params))
+		app.recordFeeHistory(ctx.WithBlockHeight(int64(10+i)), gasUsed)
+	}
+
+	require.Len(t, app.FeeHistory(10), 3)
+
+	history := app.FeeHistory(2)
+	require.Len(t, history, 2)
+	require.Equal(t, int64(11), history[0].Height)
+	require.True(t, math.LegacyNewDec(2_000).Equal(history[0].BaseFee))
+	require.Equal(t, 0.5, history[0].GasUsedRatio)
+	require.Equal(t, int64(12), history[1].Height)
+	require.True(t, math.LegacyNewDec(3_000).Equal(history[1].BaseFee))
+	require.Equal(t, 1.0, history[1].GasUsedRatio)
+}
+
+func TestSnapshotConfig(t *testing.T) {
+	app := setupTestApp(t)
+
+	// the test app takes no snapshots
+	config, err := app.SnapshotConfig()
+	require.NoError(t, err)
+	require.Equal(t, SnapshotConfig{}, config)
+
+	store, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	manager := snapshots.NewManager(store, snapshottypes.NewSnapshotOptions(1_000, 2), app.CommitMultiStore(), nil, log.NewNopLogger())
+
+	config, err = snapshotConfig(manager)
+	require.NoError(t, err)
+	require.Equal(t, SnapshotConfig{Interval: 1_000, KeepRecent: 2}, config)
+}
+
+func TestTrippedMsgTypeURLs(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).CacheContext()
+
+	tripped, err := app.TrippedMsgTypeURLs(ctx)
+	require.NoError(t, err)
+	require.Empty(t, tripped)
+
+	sendURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	msgServer := circuitkeeper.NewMsgServerImpl(app.CircuitBreakerKeeper)
+	_, err = msgServer.TripCircuitBreaker(ctx, &circuittypes.MsgTripCircuitBreaker{
+		Authority:   authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		MsgTypeUrls: []string{sendURL},
+	})
+	require.NoError(t, err)
+
+	res, err := newTestQueryClient(app, ctx).TrippedMsgTypeURLs(ctx, &kudoratypes.QueryTrippedMsgTypeURLsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []string{sendURL}, res.TypeUrls)
+}
+
+func TestAutoCompoundRewards(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).CacheContext()
+
+	stakingParams := stakingtypes.DefaultParams()
+	stakingParams.BondDenom = BaseDenom
+	require.NoError(t, app.StakingKeeper.SetParams(ctx, stakingParams))
+
+	params := kudoratypes.DefaultParams()
+	params.AutoCompoundEpochBlocks = 10
+	require.NoError(t, app.KudoraParamsKeeper.SetParams(ctx, params))
+
+	// a validator with an opted-in delegator
+	valAddr := sdk.ValAddress([]byte("compound_validator__"))
+	validator, err := stakingtypes.NewValidator(valAddr.String(), ed25519.GenPrivKey().PubKey(), stakingtypes.Description{Moniker: "compound"})
+	require.NoError(t, err)
+	require.NoError(t, app.StakingKeeper.SetValidator(ctx, validator))
+	require.NoError(t, app.StakingKeeper.SetValidatorByConsAddr(ctx, validator))
+	require.NoError(t, app.DistrKeeper.Hooks().AfterValidatorCreated(ctx, valAddr))
+
+	delAddr := sdk.AccAddress([]byte("compound_delegator__"))
+	tokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	fundTestAccount(t, app, ctx, delAddr, sdk.NewCoins(sdk.NewCoin(BaseDenom, tokens)))
+	_, err = app.StakingKeeper.Delegate(ctx, delAddr, tokens, stakingtypes.Unbonded, validator, true)
+	require.NoError(t, err)
+	msg := &kudoratypes.MsgSetAutoCompound{Delegator: delAddr.String(), Enabled: true}
+	handler := app.MsgServiceRouter().Handler(msg)
+	require.NotNil(t, handler)
+	_, err = handler(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, app.AutoCompoundKeeper.IsAutoCompound(ctx, delAddr))
+
+	// the validator earns rewards
+	rewards := sdk.NewCoins(sdk.NewCoin(BaseDenom, math.NewInt(1_000_000)))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, rewards))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, distrtypes.ModuleName, rewards))
+	validator, err = app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+	require.NoError(t, app.DistrKeeper.AllocateTokensToValidator(ctx, validator, sdk.NewDecCoinsFromCoins(rewards...)))
+
+	delegatedTokens := func() math.Int {
+		validator, err := app.StakingKeeper.GetValidator(ctx, valAddr)
+		require.NoError(t, err)
+		return validator.GetTokens()
+	}
+
+	// nothing happens between epochs
+	require.NoError(t, app.autoCompoundRewards(ctx.WithBlockHeight(9)))
+	require.Equal(t, tokens, delegatedTokens())
+
+	// at the epoch the rewards are claimed and delegated again
+	require.NoError(t, app.autoCompoundRewards(ctx.WithBlockHeight(10)))
+	require.True(t, delegatedTokens().GT(tokens))
+	require.True(t, app.BankKeeper.GetBalance(ctx, delAddr, BaseDenom).IsZero())
+	require.Equal(t, []sdk.AccAddress{delAddr}, app.AutoCompoundKeeper.GetAutoCompoundDelegators(ctx))
+}
+
+func TestAutoCompoundRewardsPerBlockCap(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).CacheContext()
+
+	stakingParams := stakingtypes.DefaultParams()
+	stakingParams.BondDenom = BaseDenom
+	require.NoError(t, app.StakingKeeper.SetParams(ctx, stakingParams))
+
+	params := kudoratypes.DefaultParams()
+	params.AutoCompoundEpochBlocks = 10
+	params.AutoCompoundMaxDelegationsPerBlock = 1
+	require.NoError(t, app.KudoraParamsKeeper.SetParams(ctx, params))
+
+	valAddr := sdk.ValAddress([]byte("capped_validator____"))
+	validator, err := stakingtypes.NewValidator(valAddr.String(), ed25519.GenPrivKey().PubKey(), stakingtypes.Description{Moniker: "capped"})
+	require.NoError(t, err)
+	require.NoError(t, app.StakingKeeper.SetValidator(ctx, validator))
+	require.NoError(t, app.StakingKeeper.SetValidatorByConsAddr(ctx, validator))
+	require.NoError(t, app.DistrKeeper.Hooks().AfterValidatorCreated(ctx, valAddr))
+
+	// two opted-in delegators
+	tokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	delegators := []sdk.AccAddress{
+		sdk.AccAddress([]byte("capped_delegator_1__")),
+		sdk.AccAddress([]byte("capped_delegator_2__")),
+	}
+	for _, delAddr := range delegators {
+		fundTestAccount(t, app, ctx, delAddr, sdk.NewCoins(sdk.NewCoin(BaseDenom, tokens)))
+		validator, err = app.StakingKeeper.GetValidator(ctx, valAddr)
+		require.NoError(t, err)
+		_, err = app.StakingKeeper.Delegate(ctx, delAddr, tokens, stakingtypes.Unbonded, validator, true)
+		require.NoError(t, err)
+		app.AutoCompoundKeeper.SetAutoCompound(ctx, delAddr, true)
+	}
+
+	rewards := sdk.NewCoins(sdk.NewCoin(BaseDenom, math.NewInt(1_000_000)))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, rewards))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, distrtypes.ModuleName, rewards))
+	validator, err = app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+	require.NoError(t, app.DistrKeeper.AllocateTokensToValidator(ctx, validator, sdk.NewDecCoinsFromCoins(rewards...)))
+
+	delegatorShares := func(delAddr sdk.AccAddress) math.LegacyDec {
+		delegation, err := app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+		require.NoError(t, err)
+		return delegation.GetShares()
+	}
+	shares := delegatorShares(delegators[1])
+
+	// only the first delegator fits in the epoch block
+	require.NoError(t, app.autoCompoundRewards(ctx.WithBlockHeight(10)))
+	require.True(t, delegatorShares(delegators[0]).GT(shares))
+	require.Equal(t, shares, delegatorShares(delegators[1]))
+
+	// the epoch goes on in the next block
+	require.NoError(t, app.autoCompoundRewards(ctx.WithBlockHeight(11)))
+	require.True(t, delegatorShares(delegators[1]).GT(shares))
+
+	// and is over after it
+	_, inProgress := app.AutoCompoundKeeper.getCursor(ctx)
+	require.False(t, inProgress)
+}
+
+func TestNativeSupplyBreakdown(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).CacheContext()
+
+	stakingParams := stakingtypes.DefaultParams()
+	stakingParams.BondDenom = BaseDenom
+	require.NoError(t, app.StakingKeeper.SetParams(ctx, stakingParams))
+
+	before, err := app.GetNativeSupplyBreakdown(ctx)
+	require.NoError(t, err)
+
+	// a bonded validator
+	valAddr := sdk.ValAddress([]byte("supply_validator____"))
+	validator, err := stakingtypes.NewValidator(valAddr.String(), ed25519.GenPrivKey().PubKey(), stakingtypes.Description{Moniker: "supply"})
+	require.NoError(t, err)
+	validator.Status = stakingtypes.Bonded
+	require.NoError(t, app.StakingKeeper.SetValidator(ctx, validator))
+	require.NoError(t, app.StakingKeeper.SetValidatorByConsAddr(ctx, validator))
+	require.NoError(t, app.DistrKeeper.Hooks().AfterValidatorCreated(ctx, valAddr))
+
+	// an account bonds part of its tokens and gives some to the community pool
+	delAddr := sdk.AccAddress([]byte("supply_delegator____"))
+	fundTestAccount(t, app, ctx, delAddr, sdk.NewCoins(sdk.NewCoin(BaseDenom, math.NewInt(10_000_000))))
+	_, err = app.StakingKeeper.Delegate(ctx, delAddr, math.NewInt(6_000_000), stakingtypes.Unbonded, validator, true)
+	require.NoError(t, err)
+	require.NoError(t, app.DistrKeeper.FundCommunityPool(ctx, sdk.NewCoins(sdk.NewCoin(BaseDenom, math.NewInt(1_000_000))), delAddr))
+
+	breakdown, err := newTestQueryClient(app, ctx).NativeSupplyBreakdown(ctx, &kudoratypes.QueryNativeSupplyBreakdownRequest{})
+	require.NoError(t, err)
+	require.Equal(t, before.Total.AddRaw(10_000_000), breakdown.Total)
+	require.Equal(t, before.Bonded.AddRaw(6_000_000), breakdown.Bonded)
+	require.Equal(t, before.CommunityPool.AddRaw(1_000_000), breakdown.CommunityPool)
+	require.True(t, before.ModuleHeld.Equal(breakdown.ModuleHeld))
+	require.Equal(t, before.Circulating.AddRaw(3_000_000), breakdown.Circulating)
+	require.Equal(t, breakdown.Total, breakdown.Bonded.Add(breakdown.CommunityPool).Add(breakdown.ModuleHeld).Add(breakdown.Circulating))
+}
+
+func TestUpdateKudoraParams(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).CacheContext()
+
+	params := kudoratypes.DefaultParams()
+	params.BurnEvmGasRefunds = true
+	msg := &kudoratypes.MsgUpdateParams{
+		Authority: sdk.AccAddress([]byte("not_the_gov_account_")).String(),
+		Params:    params,
+	}
+	handler := app.MsgServiceRouter().Handler(msg)
+	require.NotNil(t, handler)
+
+	// only governance may update the params
+	_, err := handler(ctx, msg)
+	require.ErrorIs(t, err, errortypes.ErrUnauthorized)
+	require.Equal(t, kudoratypes.DefaultParams(), app.KudoraParamsKeeper.GetParams(ctx))
+
+	msg.Authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	_, err = handler(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, params, app.KudoraParamsKeeper.GetParams(ctx))
+
+	res, err := newTestQueryClient(app, ctx).Params(ctx, &kudoratypes.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, params, res.Params)
+}
+
+func TestKudoraGenesisMsgFees(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).CacheContext()
+	kudora := kudoraModule{app: app}
+
+	fee := sdk.NewCoins(sdk.NewCoin(BaseDenom, math.NewInt(1_000)))
+	createDenom := sdk.MsgTypeURL(&tokenfactorytypes.MsgCreateDenom{})
+	gs := kudoratypes.DefaultGenesis()
+	gs.Params.MsgFees = []kudoratypes.MsgFee{{MsgTypeUrl: createDenom, Fee: fee}}
+	bz := app.AppCodec().MustMarshalJSON(gs)
+
+	// the msg fees are set at genesis and exported back
+	require.NoError(t, kudora.ValidateGenesis(app.AppCodec(), nil, bz))
+	kudora.InitGenesis(ctx, app.AppCodec(), bz)
+	require.Equal(t, map[string]sdk.Coins{createDenom: fee}, app.KudoraParamsKeeper.GetMsgFees(ctx))
+
+	var exported kudoratypes.GenesisState
+	require.NoError(t, app.AppCodec().UnmarshalJSON(kudora.ExportGenesis(ctx, app.AppCodec()), &exported))
+	require.Equal(t, gs.Params.MsgFees, exported.Params.MsgFees)
+
+	// a msg type can only have one fee
+	gs.Params.MsgFees = append(gs.Params.MsgFees, kudoratypes.MsgFee{MsgTypeUrl: createDenom, Fee: fee})
+	bz = app.AppCodec().MustMarshalJSON(gs)
+	require.Error(t, kudora.ValidateGenesis(app.AppCodec(), nil, bz))
+}
+
+func TestBlockedProposalMsgs(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).CacheContext()
+
+	kudoraParams := kudoratypes.DefaultParams()
+	kudoraParams.BlockedProposalMsgs = []string{
+		sdk.MsgTypeURL(&banktypes.MsgSend{}),
+		sdk.MsgTypeURL(&govv1.MsgExecLegacyContent{}),
+	}
+	require.NoError(t, app.KudoraParamsKeeper.SetParams(ctx, kudoraParams))
+
+	am := govModule{keeper: app.GovKeeper, params: app.KudoraParamsKeeper}
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	msgServer := am.wrapMsgServer(govkeeper.NewMsgServerImpl(app.GovKeeper))
+	legacyMsgServer := govkeeper.NewLegacyMsgServerImpl(govAddr.String(), msgServer)
+
+	params, err := app.GovKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	proposer := sdk.AccAddress([]byte("proposer____________"))
+	fundTestAccount(t, app, ctx, proposer, params.MinDeposit)
+
+	submitProposal := func(msgs ...sdk.Msg) error {
+		msg, err := govv1.NewMsgSubmitProposal(msgs, params.MinDeposit, proposer.String(), "", "title", "summary", false)
+		require.NoError(t, err)
+		_, err = msgServer.SubmitProposal(ctx, msg)
+		return err
+	}
+	send := banktypes.NewMsgSend(govAddr, proposer, params.MinDeposit)
+
+	// proposals executing a blocked message, directly or through authz
+	require.ErrorIs(t, submitProposal(send), govtypes.ErrInvalidProposalMsg)
+
+	exec := authz.NewMsgExec(govAddr, []sdk.Msg{send})
+	require.ErrorIs(t, submitProposal(&exec), govtypes.ErrInvalidProposalMsg)
+
+	// legacy proposals go through the wrapped msg server
+	legacy, err := govv1beta1.NewMsgSubmitProposal(govv1beta1.NewTextProposal("title", "description"), params.MinDeposit, proposer)
+	require.NoError(t, err)
+	_, err = legacyMsgServer.SubmitProposal(ctx, legacy)
+	require.ErrorIs(t, err, govtypes.ErrInvalidProposalMsg)
+
+	// other proposals
+	update := &banktypes.MsgUpdateParams{Authority: govAddr.String(), Params: banktypes.DefaultParams()}
+	require.NoError(t, submitProposal(update))
+}
+
+func TestMinProposalDeposit(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).CacheContext()
+
+	params, err := app.GovKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	minDeposit := params.MinDeposit.Add(params.MinDeposit...)
+
+	kudoraParams := kudoratypes.DefaultParams()
+	kudoraParams.MinProposalDeposit = minDeposit
+	require.NoError(t, app.KudoraParamsKeeper.SetParams(ctx, kudoraParams))
+
+	am := govModule{keeper: app.GovKeeper, params: app.KudoraParamsKeeper}
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	msgServer := am.wrapMsgServer(govkeeper.NewMsgServerImpl(app.GovKeeper))
+	legacyMsgServer := govkeeper.NewLegacyMsgServerImpl(govAddr.String(), msgServer)
+
+	proposer := sdk.AccAddress([]byte("proposer____________"))
+	fundTestAccount(t, app, ctx, proposer, minDeposit)
+
+	submitProposal := func(deposit sdk.Coins) error {
+		msg, err := govv1.NewMsgSubmitProposal(nil, deposit, proposer.String(), "", "title", "summary", false)
+		require.NoError(t, err)
+		_, err = msgServer.SubmitProposal(ctx, msg)
+		return err
+	}
+
+	// below the chain minimum, including legacy proposals
+	require.ErrorIs(t, submitProposal(params.MinDeposit), govtypes.ErrMinDepositTooSmall)
+
+	legacy, err := govv1beta1.NewMsgSubmitProposal(govv1beta1.NewTextProposal("title", "description"), params.MinDeposit, proposer)
+	require.NoError(t, err)
+	_, err = legacyMsgServer.SubmitProposal(ctx, legacy)
+	require.ErrorIs(t, err, govtypes.ErrMinDepositTooSmall)
+
+	// at the chain minimum
+	require.NoError(t, submitProposal(minDeposit))
+}
+
+func TestMinSelfDelegation(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).CacheContext()
+
+	stakingParams := stakingtypes.DefaultParams()
+	stakingParams.BondDenom = BaseDenom
+	require.NoError(t, app.StakingKeeper.SetParams(ctx, stakingParams))
+
+	operator := sdk.AccAddress([]byte("min_self_delegation_"))
+	valAddr := sdk.ValAddress(operator)
+	fundTestAccount(t, app, ctx, operator, sdk.NewCoins(sdk.NewCoin(BaseDenom, math.NewInt(10_000_000))))
+
+	params := kudoratypes.DefaultParams()
+	params.MinSelfDelegation = math.NewInt(1_000_000)
+	require.NoError(t, app.KudoraParamsKeeper.SetParams(ctx, params))
+
+	am := stakingModule{params: app.KudoraParamsKeeper}
+	msgServer := am.wrapMsgServer(stakingkeeper.NewMsgServerImpl(app.StakingKeeper))
+	description := stakingtypes.NewDescription("validator", "", "", "", "")
+	createValidator := func(minSelfDelegation math.Int) error {
+		msg, err := stakingtypes.NewMsgCreateValidator(
+			valAddr.String(),
+			ed25519.GenPrivKey().PubKey(),
+			sdk.NewCoin(BaseDenom, math.NewInt(5_000_000)),
+			description,
+			stakingtypes.NewCommissionRates(math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(2, 1), math.LegacyNewDecWithPrec(1, 2)),
+			minSelfDelegation,
+		)
+		require.NoError(t, err)
+		_, err = msgServer.CreateValidator(ctx, msg)
+		return err
+	}
+
+	// below the chain minimum
+	require.ErrorIs(t, createValidator(math.NewInt(999_999)), errortypes.ErrInvalidRequest)
+
+	// at the chain minimum
+	require.NoError(t, createValidator(math.NewInt(1_000_000)))
+
+	// edits setting a minimum below the chain one are rejected, others pass
+	lowered := math.NewInt(10)
+	_, err := msgServer.EditValidator(ctx, stakingtypes.NewMsgEditValidator(valAddr.String(), description, nil, &lowered))
+	require.ErrorIs(t, err, errortypes.ErrInvalidRequest)
+
+	_, err = msgServer.EditValidator(ctx, stakingtypes.NewMsgEditValidator(valAddr.String(), description, nil, nil))
+	require.NoError(t, err)
+
+	params.MinSelfDelegation = math.NewInt(-1)
+	require.Error(t, app.KudoraParamsKeeper.SetParams(ctx, params))
+}
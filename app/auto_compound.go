@@ -0,0 +1,181 @@
+package app
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var (
+	// autoCompoundPrefix prefixes the delegators that opted in to the
+	// auto-compounding of their rewards, keyed by address.
+	autoCompoundPrefix = []byte{0x03}
+	// autoCompoundCursorKey holds the next delegator to compound while an
+	// epoch is spread over several blocks.
+	autoCompoundCursorKey = []byte{0x05}
+)
+
+// AutoCompoundKeeper keeps the delegators whose staking rewards are claimed
+// and delegated again every auto-compounding epoch.
+type AutoCompoundKeeper struct {
+	storeKey storetypes.StoreKey
+}
+
+// NewAutoCompoundKeeper creates a new AutoCompoundKeeper.
+func NewAutoCompoundKeeper(storeKey storetypes.StoreKey) AutoCompoundKeeper {
+	return AutoCompoundKeeper{storeKey: storeKey}
+}
+
+// SetAutoCompound opts delegator in to or out of auto-compounding.
+func (k AutoCompoundKeeper) SetAutoCompound(ctx sdk.Context, delegator sdk.AccAddress, enabled bool) {
+	if enabled {
+		k.store(ctx).Set(delegator, []byte{1})
+	} else {
+		k.store(ctx).Delete(delegator)
+	}
+}
+
+// IsAutoCompound reports whether delegator opted in to auto-compounding.
+func (k AutoCompoundKeeper) IsAutoCompound(ctx sdk.Context, delegator sdk.AccAddress) bool {
+	return k.store(ctx).Has(delegator)
+}
+
+// GetAutoCompoundDelegators returns the delegators that opted in to
+// auto-compounding, ordered by address.
+func (k AutoCompoundKeeper) GetAutoCompoundDelegators(ctx sdk.Context) []sdk.AccAddress {
+	iterator := k.store(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+
+	var delegators []sdk.AccAddress
+	for ; iterator.Valid(); iterator.Next() {
+		delegators = append(delegators, sdk.AccAddress(iterator.Key()))
+	}
+	return delegators
+}
+
+// getAutoCompoundDelegatorsFrom returns up to limit opted-in delegators,
+// ordered by address and starting at start, along with the delegator
+// following them, nil if none.
+func (k AutoCompoundKeeper) getAutoCompoundDelegatorsFrom(ctx sdk.Context, start sdk.AccAddress, limit uint64) ([]sdk.AccAddress, sdk.AccAddress) {
+	iterator := k.store(ctx).Iterator(start, nil)
+	defer iterator.Close()
+
+	var delegators []sdk.AccAddress
+	for ; iterator.Valid(); iterator.Next() {
+		if uint64(len(delegators)) == limit {
+			return delegators, sdk.AccAddress(iterator.Key())
+		}
+		delegators = append(delegators, sdk.AccAddress(iterator.Key()))
+	}
+	return delegators, nil
+}
+
+// getCursor returns the next delegator to compound, if an epoch is in
+// progress.
+func (k AutoCompoundKeeper) getCursor(ctx sdk.Context) (sdk.AccAddress, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(autoCompoundCursorKey)
+	return bz, bz != nil
+}
+
+// setCursor records the next delegator to compound, or ends the epoch in
+// progress if there is none.
+func (k AutoCompoundKeeper) setCursor(ctx sdk.Context, next sdk.AccAddress) {
+	if next == nil {
+		ctx.KVStore(k.storeKey).Delete(autoCompoundCursorKey)
+	} else {
+		ctx.KVStore(k.storeKey).Set(autoCompoundCursorKey, next)
+	}
+}
+
+func (k AutoCompoundKeeper) store(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), autoCompoundPrefix)
+}
+
+// autoCompoundRewards claims the staking rewards of the opted-in delegators
+// and delegates them again to the same validators, every epoch of the
+// auto-compound params. The delegations compounded per block are capped, so
+// an epoch may take several blocks, resuming where the previous block
+// stopped. Rewards from jailed validators are claimed but left in the
+// delegator's account.
+func (app *App) autoCompoundRewards(ctx sdk.Context) error {
+	params := app.KudoraParamsKeeper.GetParams(ctx)
+	if params.AutoCompoundEpochBlocks == 0 {
+		return nil
+	}
+
+	keeper := app.AutoCompoundKeeper
+	start, inProgress := keeper.getCursor(ctx)
+	if !inProgress && uint64(ctx.BlockHeight())%params.AutoCompoundEpochBlocks != 0 {
+		return nil
+	}
+
+	bondDenom, err := app.StakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	// every delegator costs at least one delegation, so no more than the cap
+	// can be compounded in this block
+	budget := params.AutoCompoundMaxDelegationsPerBlock
+	delegators, next := keeper.getAutoCompoundDelegatorsFrom(ctx, start, budget)
+	for _, delAddr := range delegators {
+		delegations, err := app.StakingKeeper.GetDelegatorDelegations(ctx, delAddr, uint16(params.AutoCompoundMaxDelegationsPerBlock))
+		if err != nil {
+			return err
+		}
+
+		// leave delegators not fitting in the rest of the block to the next one
+		cost := max(uint64(len(delegations)), 1)
+		if cost > budget {
+			next = delAddr
+			break
+		}
+		budget -= cost
+
+		// compound all or nothing for each delegator
+		cacheCtx, write := ctx.CacheContext()
+		if err := app.compoundDelegatorRewards(cacheCtx, delAddr, delegations, bondDenom); err != nil {
+			ctx.Logger().Error(
+				"failed to compound delegator rewards",
+				"module", KudoraModuleName, "delegator", delAddr.String(), "error", err,
+			)
+		} else {
+			write()
+		}
+	}
+
+	keeper.setCursor(ctx, next)
+	return nil
+}
+
+// compoundDelegatorRewards claims the rewards of the given delegations of
+// delAddr and delegates their bond denom part again.
+func (app *App) compoundDelegatorRewards(ctx sdk.Context, delAddr sdk.AccAddress, delegations []stakingtypes.Delegation, bondDenom string) error {
+	for _, delegation := range delegations {
+		valAddr, err := app.StakingKeeper.ValidatorAddressCodec().StringToBytes(delegation.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+		rewards, err := app.DistrKeeper.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+		if err != nil {
+			return err
+		}
+
+		amount := rewards.AmountOf(bondDenom)
+		if !amount.IsPositive() {
+			continue
+		}
+		validator, err := app.StakingKeeper.GetValidator(ctx, valAddr)
+		if err != nil {
+			return err
+		}
+		if validator.IsJailed() {
+			continue
+		}
+		if _, err := app.StakingKeeper.Delegate(ctx, delAddr, amount, stakingtypes.Unbonded, validator, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
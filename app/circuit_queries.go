@@ -0,0 +1,19 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TrippedMsgTypeURLs returns the type URLs of the messages currently
+// disabled by the circuit breaker, in lexical order.
+func (app *App) TrippedMsgTypeURLs(ctx sdk.Context) ([]string, error) {
+	var typeURLs []string
+	err := app.CircuitBreakerKeeper.DisableList.Walk(ctx, nil, func(typeURL string) (bool, error) {
+		typeURLs = append(typeURLs, typeURL)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return typeURLs, nil
+}
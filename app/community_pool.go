@@ -0,0 +1,26 @@
+package app
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// fundCommunityPoolFromFees moves the share of the fees collected during the
+// block set by the Kudora params to the community pool, on top of the
+// community tax the distribution module applies when it allocates the rest.
+func (app *App) fundCommunityPoolFromFees(ctx sdk.Context) error {
+	percent := app.KudoraParamsKeeper.GetParams(ctx).CommunityPoolFeePercent
+	if percent == 0 {
+		return nil
+	}
+
+	feeCollector := app.AuthKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	fees := sdk.NewDecCoinsFromCoins(app.BankKeeper.GetAllBalances(ctx, feeCollector)...)
+	share, _ := fees.MulDecTruncate(math.LegacyNewDecWithPrec(int64(percent), 2)).TruncateDecimal()
+	if share.IsZero() {
+		return nil
+	}
+
+	return app.DistrKeeper.FundCommunityPool(ctx, share, feeCollector)
+}
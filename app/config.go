@@ -279,3 +279,25 @@ func init() {
 	config.SetBech32PrefixForConsensusNode(consNodeAddressPrefix, consNodePubKeyPrefix)
 	config.Seal()
 }
+
+// StakingParamsSnapshot returns the bond denom and power reduction in effect
+// as of the latest committed block, i.e. the staking params bond denom and
+// the amount of bond denom tokens per unit of consensus power, rather than
+// the SDK defaults they may differ from.
+func (app *App) StakingParamsSnapshot() (bondDenom string, powerReduction math.Int, err error) {
+	ctx, err := app.CreateQueryContext(0, false)
+	if err != nil {
+		return "", math.Int{}, err
+	}
+	return app.stakingParamsSnapshot(ctx)
+}
+
+// stakingParamsSnapshot returns the bond denom and power reduction in effect
+// in ctx.
+func (app *App) stakingParamsSnapshot(ctx sdk.Context) (bondDenom string, powerReduction math.Int, err error) {
+	params, err := app.StakingKeeper.GetParams(ctx)
+	if err != nil {
+		return "", math.Int{}, err
+	}
+	return params.BondDenom, app.StakingKeeper.PowerReduction(ctx), nil
+}
@@ -1,8 +1,12 @@
 package app
 
 import (
+	"math/big"
 	"sync"
 	"testing"
+
+	"cosmossdk.io/math"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 const (
@@ -64,3 +68,35 @@ func TestEVMAppOptionsInitializationOnce(t *testing.T) {
 		t.Errorf("Multiple calls to EVMAppOptions returned different errors: %v vs %v", err1, err2)
 	}
 }
+
+// TestStakingParamsSnapshot verifies the bond denom and power reduction in effect
+func TestStakingParamsSnapshot(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).CacheContext()
+	if err := app.StakingKeeper.SetParams(ctx, stakingtypes.DefaultParams()); err != nil {
+		t.Fatalf("failed to set staking params: %v", err)
+	}
+
+	bondDenom, powerReduction, err := app.stakingParamsSnapshot(ctx)
+	if err != nil {
+		t.Fatalf("failed to read staking params: %v", err)
+	}
+	if bondDenom != "kud" {
+		t.Errorf("unexpected bond denom: %s", bondDenom)
+	}
+
+	expected := math.NewIntFromBigInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil))
+	if !powerReduction.Equal(expected) {
+		t.Errorf("unexpected power reduction: %s, expected %s", powerReduction, expected)
+	}
+
+	// the bond denom follows the staking params
+	params := stakingtypes.DefaultParams()
+	params.BondDenom = "stake"
+	if err := app.StakingKeeper.SetParams(ctx, params); err != nil {
+		t.Fatalf("failed to set staking params: %v", err)
+	}
+	if bondDenom, _, _ = app.stakingParamsSnapshot(ctx); bondDenom != "stake" {
+		t.Errorf("unexpected bond denom: %s", bondDenom)
+	}
+}
@@ -86,6 +86,11 @@ func (app *App) registerEVMModules(appOpts servertypes.AppOptions) error {
 		app.UnsafeFindStoreKey(feemarkettypes.TransientKey),
 	)
 
+	app.EVMGasPriceFloorKeeper = NewEVMGasPriceFloorKeeper(
+		app.GetKey(KudoraStoreKey),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	// NOTE: it's required to set up the EVM keeper before the ERC-20 keeper, because it is used in its instantiation.
 	app.EVMKeeper = evmkeeper.NewKeeper(
 		app.appCodec,
@@ -102,6 +107,9 @@ func (app *App) registerEVMModules(appOpts servertypes.AppOptions) error {
 		tracer,
 	)
 
+	// lower the EIP-170 max code size as set in the params
+	app.EVMKeeper.SetHooks(maxCodeSizeHook{keeper: app.EVMKeeper, params: app.KudoraParamsKeeper})
+
 	app.Erc20Keeper = erc20keeper.NewKeeper(
 		app.GetKey(erc20types.StoreKey),
 		app.appCodec,
@@ -127,6 +135,18 @@ func (app *App) registerEVMModules(appOpts servertypes.AppOptions) error {
 
 func (app *App) postRegisterEVMModules() error {
 	// register precompiles on EVMKeeper
+	precompiles, err := staticPrecompiles(app.KudoraParamsKeeper)
+	if err != nil {
+		return err
+	}
+
+	_ = app.EVMKeeper.WithStaticPrecompiles(precompiles)
+	app.staticPrecompiles = precompiles
+	return nil
+}
+
+// staticPrecompiles returns the precompiles available at their fixed address.
+func staticPrecompiles(params KudoraParamsReader) (map[common.Address]gethvm.PrecompiledContract, error) {
 	const bech32PrecompileBaseGas = 6_000
 
 	// secp256r1 precompile as per EIP-7212
@@ -134,7 +154,7 @@ func (app *App) postRegisterEVMModules() error {
 
 	bech32Precompile, err := bech32.NewPrecompile(bech32PrecompileBaseGas)
 	if err != nil {
-		return fmt.Errorf("failed to instantiate bech32 precompile: %w", err)
+		return nil, fmt.Errorf("failed to instantiate bech32 precompile: %w", err)
 	}
 
 	precompiles := maps.Clone(gethvm.PrecompiledContractsPrague) // clone from latest vm fork.
@@ -143,8 +163,11 @@ func (app *App) postRegisterEVMModules() error {
 
 	// add more stateful precompiles here, if needed.
 
-	_ = app.EVMKeeper.WithStaticPrecompiles(precompiles)
-	return nil
+	// tune the precompile gas costs and callers as set in the params
+	applyPrecompileGasOverrides(precompiles, params)
+	restrictPrecompilesToEOAs(precompiles, params)
+
+	return precompiles, nil
 }
 
 // setEVMMempool sets the EVM priority nonce mempool
@@ -274,3 +297,9 @@ func RegisterEVM(cdc codec.Codec, interfaceRegistry codectypes.InterfaceRegistry
 func ProvideMsgEthereumTxCustomGetSigner() signing.CustomGetSigner {
 	return evmtypes.MsgEthereumTxCustomGetSigner
 }
+
+// EVMChainConfig returns the EVM chain configuration in effect, with the
+// chain id and fork activations the EVM was configured with at startup.
+func (app *App) EVMChainConfig() *evmtypes.ChainConfig {
+	return evmtypes.GetChainConfig()
+}
@@ -0,0 +1,98 @@
+package app
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	kudoratypes "kudora/x/kudora/types"
+)
+
+// evmGasPriceFloorPrefix prefixes the minimum gas price of each EVM sender
+// having one, keyed by address.
+var evmGasPriceFloorPrefix = []byte{0x02}
+
+// EVMGasPriceFloorKeeper keeps an on-chain list of EVM senders required to
+// pay a higher gas price than everyone else, such as abused service accounts.
+type EVMGasPriceFloorKeeper struct {
+	storeKey  storetypes.StoreKey
+	authority string
+}
+
+// NewEVMGasPriceFloorKeeper creates a new EVMGasPriceFloorKeeper managed by authority.
+func NewEVMGasPriceFloorKeeper(storeKey storetypes.StoreKey, authority string) EVMGasPriceFloorKeeper {
+	return EVMGasPriceFloorKeeper{
+		storeKey:  storeKey,
+		authority: authority,
+	}
+}
+
+// SetEVMGasPriceFloor sets the minimum gas price of sender, which only the
+// authority may do. A zero price removes it.
+func (k EVMGasPriceFloorKeeper) SetEVMGasPriceFloor(ctx sdk.Context, authority string, sender common.Address, minGasPrice math.Int) error {
+	if authority != k.authority {
+		return errorsmod.Wrapf(errortypes.ErrUnauthorized, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+	if minGasPrice.IsNil() || minGasPrice.IsNegative() {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid minimum gas price %s", minGasPrice)
+	}
+
+	return k.setEVMGasPriceFloor(ctx, sender, minGasPrice)
+}
+
+func (k EVMGasPriceFloorKeeper) setEVMGasPriceFloor(ctx sdk.Context, sender common.Address, minGasPrice math.Int) error {
+	store := k.store(ctx)
+	if minGasPrice.IsZero() {
+		store.Delete(sender.Bytes())
+		return nil
+	}
+
+	bz, err := minGasPrice.Marshal()
+	if err != nil {
+		return err
+	}
+	store.Set(sender.Bytes(), bz)
+	return nil
+}
+
+// GetEVMGasPriceFloor returns the minimum gas price of sender, if it has one.
+func (k EVMGasPriceFloorKeeper) GetEVMGasPriceFloor(ctx sdk.Context, sender common.Address) (math.Int, bool) {
+	bz := k.store(ctx).Get(sender.Bytes())
+	if bz == nil {
+		return math.Int{}, false
+	}
+
+	var minGasPrice math.Int
+	if err := minGasPrice.Unmarshal(bz); err != nil {
+		return math.Int{}, false
+	}
+	return minGasPrice, true
+}
+
+// GetAllEVMGasPriceFloors returns the minimum gas price of every sender
+// having one, ordered by address.
+func (k EVMGasPriceFloorKeeper) GetAllEVMGasPriceFloors(ctx sdk.Context) ([]kudoratypes.EVMGasPriceFloor, error) {
+	iterator := k.store(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+
+	var floors []kudoratypes.EVMGasPriceFloor
+	for ; iterator.Valid(); iterator.Next() {
+		var minGasPrice math.Int
+		if err := minGasPrice.Unmarshal(iterator.Value()); err != nil {
+			return nil, err
+		}
+		floors = append(floors, kudoratypes.EVMGasPriceFloor{
+			Address:     common.BytesToAddress(iterator.Key()).Hex(),
+			MinGasPrice: minGasPrice,
+		})
+	}
+	return floors, nil
+}
+
+func (k EVMGasPriceFloorKeeper) store(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), evmGasPriceFloorPrefix)
+}
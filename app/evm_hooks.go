@@ -0,0 +1,47 @@
+package app
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/evm/x/vm/statedb"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	gethvm "github.com/ethereum/go-ethereum/core/vm"
+)
+
+var _ evmtypes.EvmHooks = maxCodeSizeHook{}
+
+// evmCodeKeeper defines the EVM keeper methods needed to read deployed code.
+type evmCodeKeeper interface {
+	GetAccount(ctx sdk.Context, addr common.Address) *statedb.Account
+	GetCode(ctx sdk.Context, codeHash common.Hash) []byte
+}
+
+// maxCodeSizeHook fails contract creation txs deploying more code than the
+// evm_max_code_size param, reverting the deployment. The EVM itself only
+// enforces the EIP-170 limit, so the param can only be lower. Contracts
+// created by other contracts are not checked.
+type maxCodeSizeHook struct {
+	keeper evmCodeKeeper
+	params KudoraParamsReader
+}
+
+// PostTxProcessing implements evmtypes.EvmHooks.
+func (h maxCodeSizeHook) PostTxProcessing(ctx sdk.Context, _ common.Address, msg core.Message, receipt *ethtypes.Receipt) error {
+	maxCodeSize := h.params.GetParams(ctx).EvmMaxCodeSize
+	if maxCodeSize == 0 || msg.To != nil || receipt.Status != ethtypes.ReceiptStatusSuccessful {
+		return nil
+	}
+
+	account := h.keeper.GetAccount(ctx, receipt.ContractAddress)
+	if account == nil {
+		return nil
+	}
+
+	if size := len(h.keeper.GetCode(ctx, common.BytesToHash(account.CodeHash))); uint64(size) > maxCodeSize {
+		return errorsmod.Wrapf(gethvm.ErrMaxCodeSizeExceeded, "code size %d, maximum allowed is %d", size, maxCodeSize)
+	}
+	return nil
+}
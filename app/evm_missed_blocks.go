@@ -0,0 +1,81 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// jailEVMMissedBlocks jails the bonded validators that missed more than the
+// evm_jail_missed_blocks param of the current signed blocks window. As for
+// downtime, they stay jailed for the slashing downtime jail duration and
+// their missed blocks are reset, but they are not slashed. Only the bonded
+// validators are checked, at most the staking max validators.
+func (app *App) jailEVMMissedBlocks(ctx sdk.Context) error {
+	threshold := app.KudoraParamsKeeper.GetParams(ctx).EvmJailMissedBlocks
+	if threshold == 0 {
+		return nil
+	}
+
+	var missing []sdk.ConsAddress
+	var iterErr error
+	if err := app.StakingKeeper.IterateBondedValidatorsByPower(
+		ctx,
+		func(_ int64, validator stakingtypes.ValidatorI) bool {
+			if validator.IsJailed() {
+				return false
+			}
+			consAddr, err := validator.GetConsAddr()
+			if err != nil {
+				iterErr = err
+				return true
+			}
+			info, err := app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+			if err != nil {
+				// no signing info until the validator signs its first block
+				return false
+			}
+			if info.MissedBlocksCounter > 0 && uint64(info.MissedBlocksCounter) > threshold {
+				missing = append(missing, consAddr)
+			}
+			return false
+		},
+	); err != nil {
+		return err
+	}
+	if iterErr != nil {
+		return iterErr
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	jailDuration, err := app.SlashingKeeper.DowntimeJailDuration(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, consAddr := range missing {
+		if err := app.SlashingKeeper.Jail(ctx, consAddr); err != nil {
+			return err
+		}
+
+		info, err := app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+		if err != nil {
+			return err
+		}
+		info.JailedUntil = ctx.BlockHeader().Time.Add(jailDuration)
+		info.MissedBlocksCounter = 0
+		if err := app.SlashingKeeper.SetValidatorSigningInfo(ctx, consAddr, info); err != nil {
+			return err
+		}
+		if err := app.SlashingKeeper.DeleteMissedBlockBitmap(ctx, consAddr); err != nil {
+			return err
+		}
+
+		ctx.Logger().Info(
+			"jailed validator missing blocks",
+			"module", KudoraModuleName, "validator", consAddr.String(), "threshold", threshold,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package app
+
+import (
+	"errors"
+	"maps"
+	"slices"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/evm/x/vm/statedb"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	gethvm "github.com/ethereum/go-ethereum/core/vm"
+
+	kudoratypes "kudora/x/kudora/types"
+)
+
+// gasOverridePrecompile charges the gas cost of the evm_precompile_gas
+// param for every call to the wrapped precompile, whatever its input, when
+// the param overrides it.
+type gasOverridePrecompile struct {
+	gethvm.PrecompiledContract
+
+	params KudoraParamsReader
+}
+
+// Run implements gethvm.PrecompiledContract. The EVM charges the default cost
+// of RequiredGas before running the precompile, which then charges or refunds
+// the difference with the overridden cost.
+func (p gasOverridePrecompile) Run(evm *gethvm.EVM, contract *gethvm.Contract, readonly bool) ([]byte, error) {
+	params, found, err := precompileParams(evm, p.params)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return p.PrecompiledContract.Run(evm, contract, readonly)
+	}
+
+	if gas, found := precompileGas(params, p.Address()); found {
+		charged := p.RequiredGas(contract.Input)
+		switch {
+		case gas > charged:
+			if !contract.UseGas(gas-charged, evm.Config.Tracer, tracing.GasChangeCallPrecompiledContract) {
+				return nil, gethvm.ErrOutOfGas
+			}
+		case gas < charged:
+			contract.RefundGas(charged-gas, evm.Config.Tracer, tracing.GasChangeCallPrecompiledContract)
+		}
+	}
+	return p.PrecompiledContract.Run(evm, contract, readonly)
+}
+
+// precompileParams reads the Kudora params from the state evm runs on. They
+// are read for free, so that the precompile wrappers don't change the gas of
+// the calls the params don't cover. It reports false when evm doesn't run on
+// the Cosmos state.
+func precompileParams(evm *gethvm.EVM, reader KudoraParamsReader) (kudoratypes.Params, bool, error) {
+	stateDB, ok := evm.StateDB.(*statedb.StateDB)
+	if !ok {
+		return kudoratypes.Params{}, false, nil
+	}
+	ctx, err := stateDB.GetCacheContext()
+	if err != nil {
+		return kudoratypes.Params{}, false, err
+	}
+	return reader.GetParams(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())), true, nil
+}
+
+// precompileGas returns the gas cost of the precompile at address set by the
+// evm_precompile_gas param, if any.
+func precompileGas(params kudoratypes.Params, address common.Address) (uint64, bool) {
+	for _, override := range params.EvmPrecompileGas {
+		if common.HexToAddress(override.Address) == address {
+			return override.Gas, true
+		}
+	}
+	return 0, false
+}
+
+// applyPrecompileGasOverrides wraps every precompile but the Ethereum ones,
+// whose costs are part of Ethereum compatibility, to charge the gas costs of
+// the evm_precompile_gas param.
+func applyPrecompileGasOverrides(precompiles map[common.Address]gethvm.PrecompiledContract, params KudoraParamsReader) {
+	for address, precompile := range precompiles {
+		if _, native := gethvm.PrecompiledContractsPrague[address]; native {
+			continue
+		}
+		precompiles[address] = gasOverridePrecompile{PrecompiledContract: precompile, params: params}
+	}
+}
+
+// errContractPrecompileCall is returned by precompiles restricted to
+// externally owned accounts when a contract calls them.
+var errContractPrecompileCall = errors.New("precompile can only be called by externally owned accounts")
+
+// eoaOnlyPrecompile rejects the calls to the wrapped precompile that do not
+// come straight from the transaction sender, when the
+// evm_block_contract_precompile_calls param is set.
+type eoaOnlyPrecompile struct {
+	gethvm.PrecompiledContract
+
+	params KudoraParamsReader
+}
+
+// Run implements gethvm.PrecompiledContract.
+func (p eoaOnlyPrecompile) Run(evm *gethvm.EVM, contract *gethvm.Contract, readonly bool) ([]byte, error) {
+	if contract.Caller() != evm.Origin {
+		params, found, err := precompileParams(evm, p.params)
+		if err != nil {
+			return nil, err
+		}
+		if found && params.EvmBlockContractPrecompileCalls {
+			return nil, errContractPrecompileCall
+		}
+	}
+	return p.PrecompiledContract.Run(evm, contract, readonly)
+}
+
+// restrictPrecompilesToEOAs wraps every precompile but the Ethereum ones so
+// that contracts cannot call them while the params say so.
+func restrictPrecompilesToEOAs(precompiles map[common.Address]gethvm.PrecompiledContract, params KudoraParamsReader) {
+	for address, precompile := range precompiles {
+		if _, native := gethvm.PrecompiledContractsPrague[address]; native {
+			continue
+		}
+		precompiles[address] = eoaOnlyPrecompile{PrecompiledContract: precompile, params: params}
+	}
+}
+
+// EnabledPrecompiles returns the addresses of the precompiles callable from
+// the EVM, in ascending order. The Ethereum precompiles are always enabled,
+// while the other static precompiles only are when listed in the active
+// static precompiles of the EVM params.
+func (app *App) EnabledPrecompiles(ctx sdk.Context) []common.Address {
+	params := app.EVMKeeper.GetParams(ctx)
+
+	var enabled []common.Address
+	for _, address := range slices.SortedFunc(maps.Keys(app.staticPrecompiles), common.Address.Cmp) {
+		if _, native := gethvm.PrecompiledContractsPrague[address]; native || params.IsActivePrecompile(address.Hex()) {
+			enabled = append(enabled, address)
+		}
+	}
+	return enabled
+}
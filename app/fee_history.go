@@ -0,0 +1,60 @@
+package app
+
+import (
+	"sync"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// maxFeeHistoryBlocks is the number of blocks kept for FeeHistory, matching
+// the most eth_feeHistory serves.
+const maxFeeHistoryBlocks = 1024
+
+// FeeHistoryEntry is the EVM base fee of a block and the fraction of its gas
+// limit its transactions used.
+type FeeHistoryEntry struct {
+	Height       int64
+	BaseFee      math.LegacyDec
+	GasUsedRatio float64
+}
+
+// feeHistory keeps the FeeHistoryEntry of the last blocks ended by this node.
+type feeHistory struct {
+	mu      sync.Mutex
+	entries []FeeHistoryEntry
+}
+
+// recordFeeHistory keeps the base fee and gas used ratio of the ending block
+// for FeeHistory.
+func (app *App) recordFeeHistory(ctx sdk.Context, gasUsed uint64) {
+	entry := FeeHistoryEntry{
+		Height:  ctx.BlockHeight(),
+		BaseFee: app.FeeMarketKeeper.GetBaseFee(ctx),
+	}
+	// a max gas of -1 means the block gas is unlimited
+	if block := ctx.ConsensusParams().Block; block != nil && block.MaxGas > 0 {
+		entry.GasUsedRatio = float64(gasUsed) / float64(block.MaxGas)
+	}
+
+	app.feeHistory.mu.Lock()
+	defer app.feeHistory.mu.Unlock()
+
+	app.feeHistory.entries = append(app.feeHistory.entries, entry)
+	if len(app.feeHistory.entries) > maxFeeHistoryBlocks {
+		app.feeHistory.entries = app.feeHistory.entries[len(app.feeHistory.entries)-maxFeeHistoryBlocks:]
+	}
+}
+
+// FeeHistory returns the base fee and gas used ratio of up to the last n
+// blocks ended by this node, oldest first, as needed by eth_feeHistory.
+func (app *App) FeeHistory(n int) []FeeHistoryEntry {
+	app.feeHistory.mu.Lock()
+	defer app.feeHistory.mu.Unlock()
+
+	entries := app.feeHistory.entries
+	if n >= 0 && len(entries) > n {
+		entries = entries[len(entries)-n:]
+	}
+	return append([]FeeHistoryEntry(nil), entries...)
+}
@@ -0,0 +1,42 @@
+package app
+
+import (
+	"cosmossdk.io/x/feegrant"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FeeGrantsForGrantee returns the unexpired fee grants, with their
+// allowances, of which grantee is the grantee.
+func (app *App) FeeGrantsForGrantee(ctx sdk.Context, grantee sdk.AccAddress) ([]feegrant.Grant, error) {
+	granteeStr, err := app.AuthKeeper.AddressCodec().BytesToString(grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	var grants []feegrant.Grant
+	var iterErr error
+	err = app.FeeGrantKeeper.IterateAllFeeAllowances(ctx, func(grant feegrant.Grant) bool {
+		if grant.Grantee != granteeStr {
+			return false
+		}
+
+		allowance, err := grant.GetGrant()
+		if err != nil {
+			iterErr = err
+			return true
+		}
+		expiration, err := allowance.ExpiresAt()
+		if err != nil {
+			iterErr = err
+			return true
+		}
+		if expiration == nil || ctx.BlockTime().Before(*expiration) {
+			grants = append(grants, grant)
+		}
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	return grants, iterErr
+}
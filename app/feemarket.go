@@ -0,0 +1,51 @@
+package app
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cast"
+)
+
+// feeMarketPriorityTip parses the priority tip set by
+// FlagFeeMarketPriorityTip, zero when unset.
+func feeMarketPriorityTip(appOpts servertypes.AppOptions) (math.LegacyDec, error) {
+	value := cast.ToString(appOpts.Get(FlagFeeMarketPriorityTip))
+	if value == "" {
+		return math.LegacyZeroDec(), nil
+	}
+
+	tip, err := math.LegacyNewDecFromStr(value)
+	if err != nil {
+		return math.LegacyDec{}, fmt.Errorf("invalid %s: %w", FlagFeeMarketPriorityTip, err)
+	}
+	if tip.IsNegative() {
+		return math.LegacyDec{}, fmt.Errorf("invalid %s: %s is negative", FlagFeeMarketPriorityTip, value)
+	}
+	return tip, nil
+}
+
+// CurrentBaseFee returns the EVM base fee of the current block.
+func (app *App) CurrentBaseFee(ctx sdk.Context) math.LegacyDec {
+	return app.FeeMarketKeeper.GetBaseFee(ctx)
+}
+
+// SuggestedGasPrice returns the gas price for a transaction to be included in
+// the next block: the base fee, or the feemarket min gas price when higher,
+// plus the configured priority tip.
+func (app *App) SuggestedGasPrice(ctx sdk.Context) math.LegacyDec {
+	price := app.CurrentBaseFee(ctx)
+	if price.IsNil() {
+		price = math.LegacyZeroDec()
+	}
+	if minGasPrice := app.FeeMarketKeeper.GetParams(ctx).MinGasPrice; !minGasPrice.IsNil() && minGasPrice.GT(price) {
+		price = minGasPrice
+	}
+
+	if app.feeMarketPriorityTip.IsNil() {
+		return price
+	}
+	return price.Add(app.feeMarketPriorityTip)
+}
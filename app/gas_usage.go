@@ -0,0 +1,50 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	antehandlers "kudora/app/ante"
+	kudoratypes "kudora/x/kudora/types"
+)
+
+// lastBlockGasUsageKey is the key of the gas usage of the last block in the
+// Kudora store.
+var lastBlockGasUsageKey = []byte{0x07}
+
+// recordBlockGasUsage stores the gas usage of the ending block for
+// LatestBlockGasUsage and FeeHistory. The gas of the failed transactions is
+// what the block gas meter counted beyond the successful ones.
+func (app *App) recordBlockGasUsage(ctx sdk.Context) error {
+	evmGas, cosmosGas, err := antehandlers.BlockGasUsage(ctx, app.anteOptions.TransientStoreService)
+	if err != nil {
+		return err
+	}
+	gasWanted, err := antehandlers.BlockGasWanted(ctx, app.anteOptions.TransientStoreService)
+	if err != nil {
+		return err
+	}
+
+	usage := kudoratypes.BlockGasUsage{
+		Height:    ctx.BlockHeight(),
+		EvmGas:    evmGas,
+		CosmosGas: cosmosGas,
+		GasWanted: gasWanted,
+	}
+	if meter := ctx.BlockGasMeter(); meter != nil && meter.GasConsumed() > evmGas+cosmosGas {
+		usage.FailedGas = meter.GasConsumed() - evmGas - cosmosGas
+	}
+
+	ctx.KVStore(app.GetKey(KudoraStoreKey)).Set(lastBlockGasUsageKey, app.appCodec.MustMarshal(&usage))
+	app.recordFeeHistory(ctx, usage.GasUsed())
+	return nil
+}
+
+// LatestBlockGasUsage returns the gas usage of the last block, zero before
+// the first one ends.
+func (app *App) LatestBlockGasUsage(ctx sdk.Context) kudoratypes.BlockGasUsage {
+	var usage kudoratypes.BlockGasUsage
+	if bz := ctx.KVStore(app.GetKey(KudoraStoreKey)).Get(lastBlockGasUsageKey); bz != nil {
+		app.appCodec.MustUnmarshal(bz, &usage)
+	}
+	return usage
+}
@@ -0,0 +1,170 @@
+package app
+
+import (
+	"context"
+
+	govmodulev1 "cosmossdk.io/api/cosmos/gov/module/v1"
+	"cosmossdk.io/depinject"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+// govModuleConfig wires the gov module as its app config entry would, with
+// the module wrapped to enforce Kudora's limits on proposals.
+func govModuleConfig() depinject.Config {
+	return depinject.Configs(
+		depinject.Supply(&govmodulev1.Module{}),
+		depinject.ProvideInModule(govtypes.ModuleName, ProvideGovModule),
+		depinject.InvokeInModule(govtypes.ModuleName, gov.InvokeAddRoutes, gov.InvokeSetHooks),
+	)
+}
+
+// ProvideGovModule provides the upstream gov module, wrapped with the limits
+// set in the Kudora params.
+func ProvideGovModule(in gov.ModuleInputs, params kudoraParamsInputs) gov.ModuleOutputs {
+	out := gov.ProvideModule(in)
+	out.Module = govModule{
+		AppModule: out.Module.(gov.AppModule),
+		keeper:    out.Keeper,
+		params:    params.Params,
+	}
+	return out
+}
+
+// govModule wraps the gov module to enforce Kudora's limits on proposals in
+// its msg server, which every submission goes through.
+type govModule struct {
+	gov.AppModule
+
+	keeper *govkeeper.Keeper
+	params KudoraParamsReader
+}
+
+// RegisterServices registers the upstream services, wrapping the msg server
+// with the configured limits. The legacy msg server calls the v1 one
+// directly, so it is rebuilt on top of the wrapped one.
+func (am govModule) RegisterServices(cfg module.Configurator) {
+	msgServer := am.wrapMsgServer(govkeeper.NewMsgServerImpl(am.keeper))
+	legacyMsgServer := govkeeper.NewLegacyMsgServerImpl(authtypes.NewModuleAddress(govtypes.ModuleName).String(), msgServer)
+
+	am.AppModule.RegisterServices(wrappingConfigurator{
+		Configurator: cfg,
+		wrap: func(impl interface{}) interface{} {
+			switch impl.(type) {
+			case govv1.MsgServer:
+				return msgServer
+			case govv1beta1.MsgServer:
+				return legacyMsgServer
+			}
+			return impl
+		},
+	})
+}
+
+// wrapMsgServer wraps the upstream msg server with the limits of the params.
+func (am govModule) wrapMsgServer(msgServer govv1.MsgServer) govv1.MsgServer {
+	if am.params == nil {
+		return msgServer
+	}
+	msgServer = newMinProposalDepositMsgServer(msgServer, am.params)
+	return newBlockedProposalMsgsMsgServer(msgServer, am.params)
+}
+
+// blockedProposalMsgsMsgServer rejects proposals that would execute messages
+// of a type blocked by the blocked_proposal_msgs param, including when they
+// are wrapped in an authz MsgExec.
+type blockedProposalMsgsMsgServer struct {
+	govv1.MsgServer
+
+	params KudoraParamsReader
+}
+
+func newBlockedProposalMsgsMsgServer(msgServer govv1.MsgServer, params KudoraParamsReader) blockedProposalMsgsMsgServer {
+	return blockedProposalMsgsMsgServer{
+		MsgServer: msgServer,
+		params:    params,
+	}
+}
+
+// SubmitProposal implements govv1.MsgServer.
+func (s blockedProposalMsgsMsgServer) SubmitProposal(
+	goCtx context.Context,
+	msg *govv1.MsgSubmitProposal,
+) (*govv1.MsgSubmitProposalResponse, error) {
+	blockedMsgs := s.params.GetParams(sdk.UnwrapSDKContext(goCtx)).BlockedProposalMsgs
+	if len(blockedMsgs) == 0 {
+		return s.MsgServer.SubmitProposal(goCtx, msg)
+	}
+
+	msgs, err := msg.GetMsgs()
+	if err != nil {
+		return nil, err
+	}
+	blocked := make(map[string]struct{}, len(blockedMsgs))
+	for _, typeURL := range blockedMsgs {
+		blocked[typeURL] = struct{}{}
+	}
+	if err := checkBlockedProposalMsgs(msgs, blocked); err != nil {
+		return nil, err
+	}
+	return s.MsgServer.SubmitProposal(goCtx, msg)
+}
+
+func checkBlockedProposalMsgs(msgs []sdk.Msg, blocked map[string]struct{}) error {
+	for _, msg := range msgs {
+		if _, isBlocked := blocked[sdk.MsgTypeURL(msg)]; isBlocked {
+			return errorsmod.Wrapf(govtypes.ErrInvalidProposalMsg, "%s messages can't be executed by governance", sdk.MsgTypeURL(msg))
+		}
+
+		exec, ok := msg.(*authz.MsgExec)
+		if !ok {
+			continue
+		}
+		inner, err := exec.GetMessages()
+		if err != nil {
+			return err
+		}
+		if err := checkBlockedProposalMsgs(inner, blocked); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// minProposalDepositMsgServer rejects proposals submitted with an initial
+// deposit below the min_proposal_deposit param, on top of the gov params.
+type minProposalDepositMsgServer struct {
+	govv1.MsgServer
+
+	params KudoraParamsReader
+}
+
+func newMinProposalDepositMsgServer(msgServer govv1.MsgServer, params KudoraParamsReader) minProposalDepositMsgServer {
+	return minProposalDepositMsgServer{
+		MsgServer: msgServer,
+		params:    params,
+	}
+}
+
+// SubmitProposal implements govv1.MsgServer.
+func (s minProposalDepositMsgServer) SubmitProposal(
+	goCtx context.Context,
+	msg *govv1.MsgSubmitProposal,
+) (*govv1.MsgSubmitProposalResponse, error) {
+	minDeposit := s.params.GetParams(sdk.UnwrapSDKContext(goCtx)).MinProposalDeposit
+	if deposit := msg.GetInitialDeposit(); !deposit.IsAllGTE(minDeposit) {
+		return nil, errorsmod.Wrapf(
+			govtypes.ErrMinDepositTooSmall,
+			"initial deposit %s is below the chain minimum %s", deposit, minDeposit,
+		)
+	}
+	return s.MsgServer.SubmitProposal(goCtx, msg)
+}
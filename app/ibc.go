@@ -4,10 +4,12 @@ import (
 	"cosmossdk.io/core/appmodule"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/CosmWasm/wasmd/x/wasm"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -17,7 +19,6 @@ import (
 	ibctransferkeeper "github.com/cosmos/evm/x/ibc/transfer/keeper"
 	ibctransferv2evm "github.com/cosmos/evm/x/ibc/transfer/v2"
 	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v10/packetforward"
-	packetforwardkeeper "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v10/packetforward/keeper"
 	packetforwardtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v10/packetforward/types"
 	ratelimit "github.com/cosmos/ibc-apps/modules/rate-limiting/v10"
 	ratelimittypes "github.com/cosmos/ibc-apps/modules/rate-limiting/v10/types"
@@ -41,6 +42,8 @@ import (
 	solomachine "github.com/cosmos/ibc-go/v10/modules/light-clients/06-solomachine"
 	ibctm "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
 	bindings "github.com/cosmos/tokenfactory/x/tokenfactory/bindings"
+
+	"kudora/app/middleware"
 )
 
 // registerIBCModules register IBC keepers and non dependency inject modules.
@@ -53,6 +56,7 @@ func (app *App) registerIBCModules(appOpts servertypes.AppOptions) error {
 		storetypes.NewKVStoreKey(icacontrollertypes.StoreKey),
 		storetypes.NewKVStoreKey(packetforwardtypes.StoreKey),
         storetypes.NewKVStoreKey(ratelimittypes.StoreKey),
+		storetypes.NewKVStoreKey(middleware.StoreKey),
 	); err != nil {
 		return err
 	}
@@ -92,6 +96,8 @@ func (app *App) registerIBCModules(appOpts servertypes.AppOptions) error {
 		govModuleAddr,
 	)
 
+	app.IBCMiddlewareKeeper = middleware.NewKeeper(app.GetKey(middleware.StoreKey))
+
 	if err := app.initIBCMiddlewareKeepers(); err != nil {
         return err
     }
@@ -178,37 +184,87 @@ func RegisterIBC(cdc codec.Codec) map[string]appmodule.AppModule {
 func (app *App) configureIBCMiddlewareStacks(appOpts servertypes.AppOptions) {
 	// =========================================
 	// IBC Classic (v1) Transfer Stack
-	// Order: ERC20 -> RateLimit -> PFM -> Transfer
+	// Order: PacketMetrics -> ERC20 -> RateLimit -> PFM -> FlowTracker -> TimeoutRecorder -> Transfer
 	// =========================================
 	
 	// Layer 1 (Bottom): Transfer base application
 	// Using cosmos/evm transfer module for ERC20 compatibility
 	var transferStack porttypes.IBCModule
 	transferStack = ibctransferevm.NewIBCModule(app.TransferKeeper)
+
+	// Layer 1b: Timeout Recorder
+	// Keeps refunded timeouts so their sender can resubmit them
+	transferStack = middleware.NewTimeoutRecorder(transferStack, app.IBCMiddlewareKeeper)
+	
+	// Layer 1c: Flow Tracker
+	// Accumulates the lifetime inflow and outflow of every denom
+	transferStack = middleware.NewFlowTracker(transferStack, app.IBCMiddlewareKeeper)
+
+	// Layer 1d: Denom Metadata
+	// Names new IBC vouchers after their source denom in their bank metadata
+	// when enabled in the Kudora params
+	transferStack = middleware.NewDenomMetadataSetter(transferStack, app.BankKeeper, app.KudoraParamsKeeper)
 	
 	// Layer 2: Packet Forward Middleware
 	// Enables multi-hop transfers (A -> B -> C)
-	transferStack = packetforward.NewIBCMiddleware(
-		transferStack,
-		app.PacketForwardKeeper,
-		0, // Number of retries on timeout (0 = no retries)
-		packetforwardkeeper.DefaultForwardTransferPacketTimeoutTimestamp,
-	)
+	// Retries on timeout and the forward timeout come from the Kudora params
+	transferStack = newPacketForwardMiddleware(transferStack, app.PacketForwardKeeper, app.KudoraParamsKeeper)
+
+	// Layer 2b: Forward Memo Rejecter
+	// Rejects forward memos while forwarding is disabled in the Kudora params
+	transferStack = middleware.NewForwardMemoRejecter(transferStack, app.KudoraParamsKeeper)
 	
 	// Layer 3: Rate Limit Middleware
 	// Protects against bridge exploits
+	unlimitedTransferStack := transferStack
 	transferStack = ratelimit.NewIBCMiddleware(
 		*app.RateLimitKeeper,
 		transferStack,
 	)
+
+	// Layer 3b: Rate Limit Exemptions
+	// Denoms exempt in the Kudora params are not checked against nor counted
+	// in the quotas
+	transferStack = middleware.NewRateLimitExemption(transferStack, unlimitedTransferStack, app.KudoraParamsKeeper)
 	
-	// Layer 4 (Top): ERC20 Middleware
+	// Layer 4: ERC20 Middleware
 	// Converts IBC tokens to ERC20 representation
-	// MUST be outermost to execute AFTER ICS20 OnRecvPacket
+	// MUST sit above the transfer layers to execute AFTER ICS20 OnRecvPacket
+	nativeTransferStack := transferStack
 	transferStack = erc20.NewIBCMiddleware(
 		app.Erc20Keeper,
 		transferStack,
 	)
+
+	// Layer 4b: ERC20 Allowlist
+	// Only the denoms allowlisted in the Kudora params are auto-converted,
+	// others stay native coins
+	transferStack = middleware.NewERC20Allowlist(transferStack, nativeTransferStack, app.KudoraParamsKeeper)
+
+	// Layer 4c: Memo Size Limit
+	// Rejects memos longer than the Kudora params allow before PFM or any
+	// other layer parses them
+	transferStack = middleware.NewMemoSizeLimit(transferStack, app.KudoraParamsKeeper)
+
+	// Layer 4d: Blocked Recipients
+	// Refunds transfers to local addresses blocked in the Kudora params for
+	// compliance reasons
+	transferStack = middleware.NewBlockedRecipients(transferStack, app.KudoraParamsKeeper)
+
+	// Layer 4e: Dust Filter
+	// Refunds transfers below the per-denom minimum amounts set in the Kudora
+	// params
+	transferStack = middleware.NewDustFilter(transferStack, app.KudoraParamsKeeper)
+
+	// Layer 4f: Packet Count Limit
+	// Caps the packets received per channel and window set in the Kudora params
+	transferStack = middleware.NewPacketCountLimit(transferStack, app.IBCMiddlewareKeeper, app.KudoraParamsKeeper)
+
+	// Layer 5 (Top): Packet Metrics
+	// Counts packets per channel; also wraps the transfer keeper to see sends
+	packetMetrics := middleware.NewPacketMetrics(transferStack, app.IBCKeeper.ChannelKeeper)
+	app.TransferKeeper.WithICS4Wrapper(packetMetrics)
+	transferStack = packetMetrics
 	
 	// =========================================
 	// IBC Classic (v1) ICA Stacks
@@ -226,6 +282,10 @@ func (app *App) configureIBCMiddlewareStacks(appOpts servertypes.AppOptions) {
 	// Wasm IBC Stack
 	// =========================================
 	wasmOpts := bindings.RegisterCustomPlugins(app.BankKeeper, &app.TokenFactoryKeeper)
+	wasmOpts = append(wasmOpts, wasmkeeper.WithMessageHandlerDecorator(
+		newDenomMetadataMessenger(app.GetKey(DenomSymbolsStoreKey), app.BankKeeper, &app.TokenFactoryKeeper, app.KudoraParamsKeeper),
+	))
+	wasmOpts = append(wasmOpts, app.tokenFactoryMessengerDecorators()...)
 	wasmStack, err := app.registerWasmModules(appOpts, wasmOpts...)
 	if err != nil {
 		panic(err)
@@ -251,6 +311,9 @@ func (app *App) configureIBCMiddlewareStacks(appOpts servertypes.AppOptions) {
 	
 	// Add ERC20 v2 middleware
 	transferStackV2 = erc20v2.NewIBCMiddleware(transferStackV2, app.Erc20Keeper)
+
+	// Refunds transfers to local addresses blocked in the Kudora params
+	transferStackV2 = middleware.NewBlockedRecipientsV2(transferStackV2, app.KudoraParamsKeeper)
 	
 	// Configure IBC v2 Router
 	ibcv2Router := ibcapi.NewRouter().
@@ -0,0 +1,57 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v10/packetforward"
+	packetforwardkeeper "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v10/packetforward/keeper"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+var _ porttypes.IBCModule = packetForwardMiddleware{}
+
+// packetForwardMiddleware is the packet forward middleware, taking the
+// retries and timeout of forwards from the Kudora params rather than fixed
+// values. The middleware only reads them when a forward is first sent: the
+// in-flight packet records them for its retries.
+type packetForwardMiddleware struct {
+	packetforward.IBCMiddleware
+
+	app          porttypes.IBCModule
+	keeper       *packetforwardkeeper.Keeper
+	paramsKeeper KudoraParamsReader
+}
+
+// newPacketForwardMiddleware wraps app with the packet forward middleware.
+func newPacketForwardMiddleware(
+	app porttypes.IBCModule,
+	keeper *packetforwardkeeper.Keeper,
+	paramsKeeper KudoraParamsReader,
+) packetForwardMiddleware {
+	return packetForwardMiddleware{
+		IBCMiddleware: packetforward.NewIBCMiddleware(app, keeper, 0, packetforwardkeeper.DefaultForwardTransferPacketTimeoutTimestamp),
+		app:           app,
+		keeper:        keeper,
+		paramsKeeper:  paramsKeeper,
+	}
+}
+
+// OnRecvPacket implements porttypes.IBCModule, forwarding with the retries
+// and timeout currently set in the params.
+func (m packetForwardMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	params := m.paramsKeeper.GetParams(ctx)
+	timeout := params.IbcPacketForwardTimeout
+	if timeout == 0 {
+		timeout = packetforwardkeeper.DefaultForwardTransferPacketTimeoutTimestamp
+	}
+
+	// params validation caps the retries to 255
+	pfm := packetforward.NewIBCMiddleware(m.app, m.keeper, uint8(params.IbcPacketForwardRetries), timeout)
+	return pfm.OnRecvPacket(ctx, channelVersion, packet, relayer)
+}
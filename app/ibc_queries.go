@@ -0,0 +1,162 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	connectiontypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibctm "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+)
+
+// IBCConnection summarizes an IBC connection end of this chain.
+type IBCConnection struct {
+	ConnectionID             string
+	ClientID                 string
+	State                    connectiontypes.State
+	CounterpartyConnectionID string
+	CounterpartyClientID     string
+}
+
+// IBCConnections returns all the IBC connections of this chain with their
+// client IDs and states.
+func (app *App) IBCConnections(ctx sdk.Context) []IBCConnection {
+	connections := app.IBCKeeper.ConnectionKeeper.GetAllConnections(ctx)
+
+	result := make([]IBCConnection, 0, len(connections))
+	for _, connection := range connections {
+		result = append(result, IBCConnection{
+			ConnectionID:             connection.Id,
+			ClientID:                 connection.ClientId,
+			State:                    connection.State,
+			CounterpartyConnectionID: connection.Counterparty.ConnectionId,
+			CounterpartyClientID:     connection.Counterparty.ClientId,
+		})
+	}
+	return result
+}
+
+// IBCConnectionsByClient returns the IBC connections built on top of the
+// light client clientID, in any state.
+func (app *App) IBCConnectionsByClient(ctx sdk.Context, clientID string) []IBCConnection {
+	var result []IBCConnection
+	for _, connection := range app.IBCConnections(ctx) {
+		if connection.ClientID == clientID {
+			result = append(result, connection)
+		}
+	}
+	return result
+}
+
+// IBCPendingPackets lists the outgoing packets of a channel that have been
+// committed but not yet acknowledged or timed out.
+type IBCPendingPackets struct {
+	PortID    string
+	ChannelID string
+	Sequences []uint64
+}
+
+// IBCPendingPacketsByChannel returns the pending outgoing packet sequences of
+// every channel that has any, so that stuck relays can be spotted.
+func (app *App) IBCPendingPacketsByChannel(ctx sdk.Context) []IBCPendingPackets {
+	var result []IBCPendingPackets
+	for _, channel := range app.IBCKeeper.ChannelKeeper.GetAllChannels(ctx) {
+		commitments := app.IBCKeeper.ChannelKeeper.GetAllPacketCommitmentsAtChannel(ctx, channel.PortId, channel.ChannelId)
+		if len(commitments) == 0 {
+			continue
+		}
+
+		sequences := make([]uint64, 0, len(commitments))
+		for _, commitment := range commitments {
+			sequences = append(sequences, commitment.Sequence)
+		}
+		result = append(result, IBCPendingPackets{
+			PortID:    channel.PortId,
+			ChannelID: channel.ChannelId,
+			Sequences: sequences,
+		})
+	}
+	return result
+}
+
+// IBCChannelCountsByState returns the number of IBC channels of this chain in
+// each state. Every state other than UNINITIALIZED is present, with zero if
+// no channel is in it.
+func (app *App) IBCChannelCountsByState(ctx sdk.Context) map[channeltypes.State]uint64 {
+	counts := make(map[channeltypes.State]uint64, len(channeltypes.State_name))
+	for state := range channeltypes.State_name {
+		if channeltypes.State(state) != channeltypes.UNINITIALIZED {
+			counts[channeltypes.State(state)] = 0
+		}
+	}
+
+	for _, channel := range app.IBCKeeper.ChannelKeeper.GetAllChannels(ctx) {
+		counts[channel.State]++
+	}
+	return counts
+}
+
+// IBCOutstandingAcks lists the received packets of a channel whose
+// acknowledgement has been written on this chain.
+type IBCOutstandingAcks struct {
+	PortID    string
+	ChannelID string
+	Sequences []uint64
+}
+
+// IBCOutstandingAcksByChannel returns the sequences of the received packets
+// with a written acknowledgement, grouped by channel. Acknowledgements stay
+// in state once relayed, so relayers check the listed sequences against the
+// packet commitments left on the counterparty to find the acks still to relay.
+func (app *App) IBCOutstandingAcksByChannel(ctx sdk.Context) []IBCOutstandingAcks {
+	var result []IBCOutstandingAcks
+	index := make(map[[2]string]int)
+	for _, ack := range app.IBCKeeper.ChannelKeeper.GetAllPacketAcks(ctx) {
+		key := [2]string{ack.PortId, ack.ChannelId}
+		i, ok := index[key]
+		if !ok {
+			i = len(result)
+			index[key] = i
+			result = append(result, IBCOutstandingAcks{
+				PortID:    ack.PortId,
+				ChannelID: ack.ChannelId,
+			})
+		}
+		result[i].Sequences = append(result[i].Sequences, ack.Sequence)
+	}
+	return result
+}
+
+// ICAHostAccount is an interchain account hosted on this chain, along with
+// the controller owning it.
+type ICAHostAccount struct {
+	Address          string
+	ConnectionID     string
+	ControllerPortID string
+	// ControllerChainID is empty unless the connection's client is a
+	// tendermint light client.
+	ControllerChainID string
+}
+
+// ICAHostAccounts returns every interchain account registered on this chain
+// by a controller chain, to audit which chains control accounts here.
+func (app *App) ICAHostAccounts(ctx sdk.Context) []ICAHostAccount {
+	accounts := app.ICAHostKeeper.GetAllInterchainAccounts(ctx)
+
+	result := make([]ICAHostAccount, 0, len(accounts))
+	for _, account := range accounts {
+		var chainID string
+		if connection, found := app.IBCKeeper.ConnectionKeeper.GetConnection(ctx, account.ConnectionId); found {
+			clientState, found := app.IBCKeeper.ClientKeeper.GetClientState(ctx, connection.ClientId)
+			if tmClientState, ok := clientState.(*ibctm.ClientState); found && ok {
+				chainID = tmClientState.ChainId
+			}
+		}
+
+		result = append(result, ICAHostAccount{
+			Address:           account.AccountAddress,
+			ConnectionID:      account.ConnectionId,
+			ControllerPortID:  account.PortId,
+			ControllerChainID: chainID,
+		})
+	}
+	return result
+}
@@ -0,0 +1,587 @@
+package app
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"strconv"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/evm/contracts"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	ibctransferevm "github.com/cosmos/evm/x/ibc/transfer"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+	solomachine "github.com/cosmos/ibc-go/v10/modules/light-clients/06-solomachine"
+	ibctm "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"kudora/app/middleware"
+	kudoratypes "kudora/x/kudora/types"
+)
+
+const (
+	testClientID                = "07-tendermint-0"
+	testConnectionID            = "connection-0"
+	testChannelID               = "channel-0"
+	testCounterpartyChannelID   = "channel-7"
+	testCounterpartyClientID    = "07-tendermint-3"
+	testCounterpartyConnection  = "connection-3"
+	testCounterpartyChainID     = "counterparty-1"
+	testCounterpartyLatestBlock = 100
+)
+
+// setupTestTransferChannel stores an active light client, an open connection
+// and an open transfer channel so that packets can be sent without a relayer.
+func setupTestTransferChannel(t *testing.T, app *App, ctx sdk.Context) {
+	t.Helper()
+
+	require.NoError(t, app.BankKeeper.SetParams(ctx, banktypes.DefaultParams()))
+	app.TransferKeeper.SetParams(ctx, transfertypes.DefaultParams())
+	app.IBCKeeper.ClientKeeper.SetParams(ctx, clienttypes.DefaultParams())
+
+	height := clienttypes.NewHeight(1, testCounterpartyLatestBlock)
+	clientState := ibctm.NewClientState(
+		testCounterpartyChainID,
+		ibctm.DefaultTrustLevel,
+		7*24*time.Hour,
+		21*24*time.Hour,
+		10*time.Second,
+		height,
+		commitmenttypes.GetSDKSpecs(),
+		[]string{"upgrade", "upgradedIBCState"},
+	)
+	app.IBCKeeper.ClientKeeper.SetClientState(ctx, testClientID, clientState)
+	app.IBCKeeper.ClientKeeper.SetClientConsensusState(ctx, testClientID, height, ibctm.NewConsensusState(
+		ctx.BlockTime(),
+		commitmenttypes.NewMerkleRoot([]byte("app_hash")),
+		[]byte("next_validators_hash"),
+	))
+
+	app.IBCKeeper.ConnectionKeeper.SetConnection(ctx, testConnectionID, connectiontypes.ConnectionEnd{
+		ClientId: testClientID,
+		Versions: connectiontypes.GetCompatibleVersions(),
+		State:    connectiontypes.OPEN,
+		Counterparty: connectiontypes.NewCounterparty(
+			testCounterpartyClientID,
+			testCounterpartyConnection,
+			commitmenttypes.NewMerklePrefix([]byte("ibc")),
+		),
+	})
+
+	app.IBCKeeper.ChannelKeeper.SetChannel(ctx, transfertypes.PortID, testChannelID, channeltypes.NewChannel(
+		channeltypes.OPEN,
+		channeltypes.UNORDERED,
+		channeltypes.NewCounterparty(transfertypes.PortID, testCounterpartyChannelID),
+		[]string{testConnectionID},
+		transfertypes.V1,
+	))
+	app.IBCKeeper.ChannelKeeper.SetNextSequenceSend(ctx, transfertypes.PortID, testChannelID, 1)
+}
+
+// sendTestTransfer sends amount of denom over the test channel and returns the packet sent.
+func sendTestTransfer(t *testing.T, app *App, ctx sdk.Context, sender sdk.AccAddress, coin sdk.Coin, memo string) channeltypes.Packet {
+	t.Helper()
+
+	timeout := uint64(ctx.BlockTime().Add(time.Hour).UnixNano())
+	res, err := app.TransferKeeper.Transfer(ctx, &transfertypes.MsgTransfer{
+		SourcePort:       transfertypes.PortID,
+		SourceChannel:    testChannelID,
+		Token:            coin,
+		Sender:           sender.String(),
+		Receiver:         "cosmos1receiver",
+		TimeoutHeight:    clienttypes.ZeroHeight(),
+		TimeoutTimestamp: timeout,
+		Memo:             memo,
+	})
+	require.NoError(t, err)
+
+	data := transfertypes.NewFungibleTokenPacketData(coin.Denom, coin.Amount.String(), sender.String(), "cosmos1receiver", memo)
+	return channeltypes.NewPacket(
+		data.GetBytes(),
+		res.Sequence,
+		transfertypes.PortID,
+		testChannelID,
+		transfertypes.PortID,
+		testCounterpartyChannelID,
+		clienttypes.ZeroHeight(),
+		timeout,
+	)
+}
+
+// lastSentPacket returns the last packet sent with ctx, as rebuilt from its
+// send_packet event.
+func lastSentPacket(t *testing.T, ctx sdk.Context) channeltypes.Packet {
+	t.Helper()
+
+	events := ctx.EventManager().Events()
+	for i := len(events) - 1; i >= 0; i-- {
+		if events[i].Type != channeltypes.EventTypeSendPacket {
+			continue
+		}
+
+		attributes := make(map[string]string, len(events[i].Attributes))
+		for _, attribute := range events[i].Attributes {
+			attributes[attribute.Key] = attribute.Value
+		}
+		data, err := hex.DecodeString(attributes[channeltypes.AttributeKeyDataHex])
+		require.NoError(t, err)
+		sequence, err := strconv.ParseUint(attributes[channeltypes.AttributeKeySequence], 10, 64)
+		require.NoError(t, err)
+		timeout, err := strconv.ParseUint(attributes[channeltypes.AttributeKeyTimeoutTimestamp], 10, 64)
+		require.NoError(t, err)
+
+		return channeltypes.NewPacket(
+			data,
+			sequence,
+			attributes[channeltypes.AttributeKeySrcPort],
+			attributes[channeltypes.AttributeKeySrcChannel],
+			attributes[channeltypes.AttributeKeyDstPort],
+			attributes[channeltypes.AttributeKeyDstChannel],
+			clienttypes.ZeroHeight(),
+			timeout,
+		)
+	}
+	require.FailNow(t, "no packet sent")
+	return channeltypes.Packet{}
+}
+
+// TestTransferConvertsNativeERC20 checks that the cosmos/evm transfer keeper
+// converts ERC20 tokens to their bank representation before sending them over
+// IBC, so that no extra middleware is needed to send them.
+func TestTransferConvertsNativeERC20(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).CacheContext()
+	setupTestTransferChannel(t, app, ctx)
+
+	// a native ERC20 token registered as a token pair
+	contract, err := app.Erc20Keeper.DeployERC20Contract(ctx, banktypes.Metadata{
+		Description: "test token",
+		Base:        "utest",
+		Display:     "test",
+		Name:        "Test",
+		Symbol:      "TEST",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "utest", Exponent: 0},
+			{Denom: "test", Exponent: 6},
+		},
+	})
+	require.NoError(t, err)
+	_, err = app.Erc20Keeper.RegisterERC20(ctx, &erc20types.MsgRegisterERC20{
+		Signer:         authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Erc20Addresses: []string{contract.Hex()},
+	})
+	require.NoError(t, err)
+
+	// the sender only holds the ERC20 representation
+	sender := sdk.AccAddress([]byte("erc20_ibc_sender____"))
+	fundTestAccount(t, app, ctx, sender, sdk.NewCoins(sdk.NewCoin(BaseDenom, math.NewInt(1))))
+	erc20ABI := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	_, err = app.EVMKeeper.CallEVM(ctx, erc20ABI, erc20types.ModuleAddress, contract, true, nil, "mint", common.BytesToAddress(sender), big.NewInt(1_000))
+	require.NoError(t, err)
+
+	denom := erc20types.CreateDenom(contract.Hex())
+	sendTestTransfer(t, app, ctx, sender, sdk.NewCoin(denom, math.NewInt(400)), "")
+
+	require.Equal(t, big.NewInt(600), app.Erc20Keeper.BalanceOf(ctx, erc20ABI, contract, common.BytesToAddress(sender)))
+	escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, testChannelID)
+	require.Equal(t, math.NewInt(400), app.BankKeeper.GetBalance(ctx, escrow, denom).Amount)
+}
+
+func TestResubmitTimedOutTransfer(t *testing.T) {
+	app := setupTestApp(t)
+	ctx := newTestContext(app)
+	setupTestTransferChannel(t, app, ctx)
+
+	sender := sdk.AccAddress([]byte("resubmit_sender_____"))
+	coin := sdk.NewCoin(BaseDenom, math.NewInt(1000))
+	fundTestAccount(t, app, ctx, sender, sdk.NewCoins(coin))
+
+	packet := sendTestTransfer(t, app, ctx, sender, coin, "")
+	require.True(t, app.BankKeeper.GetBalance(ctx, sender, BaseDenom).IsZero())
+
+	// time the packet out: the sender is refunded and the transfer recorded
+	recorder := middleware.NewTimeoutRecorder(ibctransferevm.NewIBCModule(app.TransferKeeper), app.IBCMiddlewareKeeper)
+	require.NoError(t, recorder.OnTimeoutPacket(ctx, transfertypes.V1, packet, sdk.AccAddress([]byte("relayer_____________"))))
+	require.Equal(t, coin, app.BankKeeper.GetBalance(ctx, sender, BaseDenom))
+
+	// only the original sender may resubmit
+	other := sdk.AccAddress([]byte("someone_else________"))
+	resubmit := &kudoratypes.MsgResubmitTimedOutTransfer{
+		Sender:        other.String(),
+		SourcePort:    transfertypes.PortID,
+		SourceChannel: testChannelID,
+		Sequence:      packet.Sequence,
+	}
+	handler := app.MsgServiceRouter().Handler(resubmit)
+	require.NotNil(t, handler)
+	_, err := handler(ctx, resubmit)
+	require.Error(t, err)
+
+	resubmit.Sender = sender.String()
+	res, err := handler(ctx, resubmit)
+	require.NoError(t, err)
+	var resp kudoratypes.MsgResubmitTimedOutTransferResponse
+	require.NoError(t, resp.Unmarshal(res.MsgResponses[0].Value))
+	require.Equal(t, packet.Sequence+1, resp.Sequence)
+	require.NotNil(t, app.IBCKeeper.ChannelKeeper.GetPacketCommitment(ctx, transfertypes.PortID, testChannelID, resp.Sequence))
+	require.True(t, app.BankKeeper.GetBalance(ctx, sender, BaseDenom).IsZero())
+
+	// the record is consumed by the resubmission
+	_, err = handler(ctx, resubmit)
+	require.Error(t, err)
+}
+
+func TestPruneTimedOutTransfers(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).CacheContext()
+	keeper := app.IBCMiddlewareKeeper
+	data := []byte(`{"denom":"kud","amount":"1","sender":"a","receiver":"b"}`)
+
+	keeper.SetTimedOutTransfer(ctx, transfertypes.PortID, testChannelID, 1, data)
+	keeper.SetTimedOutTransfer(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)), transfertypes.PortID, testChannelID, 2, data)
+
+	// records are kept for the retention period
+	app.pruneTimedOutTransfers(ctx.WithBlockTime(ctx.BlockTime().Add(TimedOutTransferRetention)))
+	_, found := keeper.GetTimedOutTransfer(ctx, transfertypes.PortID, testChannelID, 1)
+	require.True(t, found)
+
+	// then pruned in the order they were recorded
+	app.pruneTimedOutTransfers(ctx.WithBlockTime(ctx.BlockTime().Add(TimedOutTransferRetention + time.Minute)))
+	_, found = keeper.GetTimedOutTransfer(ctx, transfertypes.PortID, testChannelID, 1)
+	require.False(t, found)
+	_, found = keeper.GetTimedOutTransfer(ctx, transfertypes.PortID, testChannelID, 2)
+	require.True(t, found)
+}
+
+func TestIBCEscrowInvariant(t *testing.T) {
+	app := setupTestApp(t)
+	ctx := newTestContext(app)
+	setupTestTransferChannel(t, app, ctx)
+
+	sender := sdk.AccAddress([]byte("escrow_sender_______"))
+	coin := sdk.NewCoin(BaseDenom, math.NewInt(2500))
+	fundTestAccount(t, app, ctx, sender, sdk.NewCoins(coin))
+
+	before := app.IBCEscrowBalances(ctx)
+	sendTestTransfer(t, app, ctx, sender, coin, "")
+
+	res, err := newTestQueryClient(app, ctx).IBCEscrowBalances(ctx, &kudoratypes.QueryIBCEscrowBalancesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, before.Add(coin), res.Balances)
+
+	msg, broken := IBCEscrowInvariant(app)(ctx)
+	require.False(t, broken, msg)
+
+	// tokens sent to an escrow account outside of a transfer break it
+	cacheCtx, _ := ctx.CacheContext()
+	donor := sdk.AccAddress([]byte("escrow_donor________"))
+	donation := sdk.NewCoins(sdk.NewCoin(BaseDenom, math.NewInt(1)))
+	fundTestAccount(t, app, cacheCtx, donor, donation)
+	escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, testChannelID)
+	require.NoError(t, app.BankKeeper.SendCoins(cacheCtx, donor, escrow, donation))
+	_, broken = IBCEscrowInvariant(app)(cacheCtx)
+	require.True(t, broken)
+}
+
+func TestIBCTransferFlow(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).CacheContext()
+	queryClient := newTestQueryClient(app, ctx)
+
+	app.IBCMiddlewareKeeper.AddTransferFlow(ctx, BaseDenom, math.NewInt(40), math.NewInt(15))
+	app.IBCMiddlewareKeeper.AddTransferFlow(ctx, BaseDenom, math.ZeroInt(), math.NewInt(5))
+
+	res, err := queryClient.IBCTransferFlow(ctx, &kudoratypes.QueryIBCTransferFlowRequest{Denom: BaseDenom})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(40), res.Inflow)
+	require.Equal(t, math.NewInt(20), res.Outflow)
+
+	// untouched denoms have no flow
+	res, err = queryClient.IBCTransferFlow(ctx, &kudoratypes.QueryIBCTransferFlowRequest{Denom: "uatom"})
+	require.NoError(t, err)
+	require.True(t, res.Inflow.IsZero())
+	require.True(t, res.Outflow.IsZero())
+}
+
+func TestIBCConnections(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).CacheContext()
+	setupTestTransferChannel(t, app, ctx)
+
+	require.Contains(t, app.IBCConnections(ctx), IBCConnection{
+		ConnectionID:             testConnectionID,
+		ClientID:                 testClientID,
+		State:                    connectiontypes.OPEN,
+		CounterpartyConnectionID: testCounterpartyConnection,
+		CounterpartyClientID:     testCounterpartyClientID,
+	})
+}
+
+func TestIBCConnectionsByClient(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).CacheContext()
+	setupTestTransferChannel(t, app, ctx)
+
+	// a second connection to the same client, still in its handshake
+	app.IBCKeeper.ConnectionKeeper.SetConnection(ctx, "connection-1", connectiontypes.ConnectionEnd{
+		ClientId: testClientID,
+		Versions: connectiontypes.GetCompatibleVersions(),
+		State:    connectiontypes.INIT,
+		Counterparty: connectiontypes.NewCounterparty(
+			testCounterpartyClientID,
+			"",
+			commitmenttypes.NewMerklePrefix([]byte("ibc")),
+		),
+	})
+
+	queryClient := newTestQueryClient(app, ctx)
+	res, err := queryClient.IBCConnectionsByClient(ctx, &kudoratypes.QueryIBCConnectionsByClientRequest{ClientId: testClientID})
+	require.NoError(t, err)
+	require.Len(t, res.Connections, 2)
+	require.Equal(t, testConnectionID, res.Connections[0].ConnectionId)
+	require.Equal(t, connectiontypes.OPEN.String(), res.Connections[0].State)
+	require.Equal(t, "connection-1", res.Connections[1].ConnectionId)
+	require.Equal(t, connectiontypes.INIT.String(), res.Connections[1].State)
+
+	res, err = queryClient.IBCConnectionsByClient(ctx, &kudoratypes.QueryIBCConnectionsByClientRequest{ClientId: "07-tendermint-99"})
+	require.NoError(t, err)
+	require.Empty(t, res.Connections)
+}
+
+func TestIBCChannelCountsByState(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).CacheContext()
+
+	before := app.IBCChannelCountsByState(ctx)
+	require.Contains(t, before, channeltypes.INIT)
+	require.Contains(t, before, channeltypes.CLOSED)
+
+	setupTestTransferChannel(t, app, ctx)
+
+	res, err := newTestQueryClient(app, ctx).IBCChannelCountsByState(ctx, &kudoratypes.QueryIBCChannelCountsByStateRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Counts, len(before))
+	require.Contains(t, res.Counts, kudoratypes.ChannelStateCount{
+		State: channeltypes.OPEN.String(),
+		Count: before[channeltypes.OPEN] + 1,
+	})
+	require.Contains(t, res.Counts, kudoratypes.ChannelStateCount{
+		State: channeltypes.CLOSED.String(),
+		Count: before[channeltypes.CLOSED],
+	})
+}
+
+func TestIBCPendingPacketsByChannel(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).CacheContext()
+	setupTestTransferChannel(t, app, ctx)
+
+	sender := sdk.AccAddress([]byte("pending_sender______"))
+	coin := sdk.NewCoin(BaseDenom, math.NewInt(1000))
+	fundTestAccount(t, app, ctx, sender, sdk.NewCoins(coin))
+
+	// the packet is never acknowledged
+	packet := sendTestTransfer(t, app, ctx, sender, coin, "")
+
+	var pending []uint64
+	for _, channel := range app.IBCPendingPacketsByChannel(ctx) {
+		if channel.PortID == transfertypes.PortID && channel.ChannelID == testChannelID {
+			pending = channel.Sequences
+		}
+	}
+	require.Contains(t, pending, packet.Sequence)
+}
+
+func TestIBCOutstandingAcksByChannel(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).CacheContext()
+	setupTestTransferChannel(t, app, ctx)
+
+	// receive a packet and write its acknowledgement as core IBC does
+	data := transfertypes.NewFungibleTokenPacketData(BaseDenom, "1000", "cosmos1sender", sdk.AccAddress([]byte("ack_receiver________")).String(), "")
+	packet := channeltypes.NewPacket(
+		data.GetBytes(),
+		5,
+		transfertypes.PortID,
+		testCounterpartyChannelID,
+		transfertypes.PortID,
+		testChannelID,
+		clienttypes.ZeroHeight(),
+		uint64(ctx.BlockTime().Add(time.Hour).UnixNano()),
+	)
+	app.IBCKeeper.ChannelKeeper.SetPacketReceipt(ctx, packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+	require.NoError(t, app.IBCKeeper.ChannelKeeper.WriteAcknowledgement(ctx, packet, channeltypes.NewResultAcknowledgement([]byte{1})))
+
+	res, err := newTestQueryClient(app, ctx).IBCOutstandingAcksByChannel(ctx, &kudoratypes.QueryIBCOutstandingAcksByChannelRequest{})
+	require.NoError(t, err)
+	var outstanding []uint64
+	for _, channel := range res.Channels {
+		if channel.PortId == transfertypes.PortID && channel.ChannelId == testChannelID {
+			outstanding = channel.Sequences
+		}
+	}
+	require.Equal(t, []uint64{packet.Sequence}, outstanding)
+}
+
+func TestPacketForwardRetries(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).CacheContext()
+	setupTestTransferChannel(t, app, ctx)
+	relayer := sdk.AccAddress([]byte("relayer_____________"))
+
+	params := app.KudoraParamsKeeper.GetParams(ctx)
+	params.IbcPacketForwardRetries = 1
+	params.IbcPacketForwardTimeout = 10 * time.Minute
+	require.NoError(t, app.KudoraParamsKeeper.SetParams(ctx, params))
+	pfm := newPacketForwardMiddleware(ibctransferevm.NewIBCModule(app.TransferKeeper), app.PacketForwardKeeper, app.KudoraParamsKeeper)
+
+	// a counterparty token forwarded back over the channel it came from
+	memo := `{"forward":{"receiver":"cosmos1final","port":"transfer","channel":"` + testChannelID + `"}}`
+	data := transfertypes.NewFungibleTokenPacketData("uatom", "1000", "cosmos1sender", sdk.AccAddress([]byte("forward_receiver____")).String(), memo)
+	incoming := channeltypes.NewPacket(
+		data.GetBytes(),
+		1,
+		transfertypes.PortID,
+		testCounterpartyChannelID,
+		transfertypes.PortID,
+		testChannelID,
+		clienttypes.ZeroHeight(),
+		uint64(ctx.BlockTime().Add(time.Hour).UnixNano()),
+	)
+	require.Nil(t, pfm.OnRecvPacket(ctx, transfertypes.V1, incoming, relayer))
+	forward := lastSentPacket(t, ctx)
+	require.Equal(t, uint64(ctx.BlockTime().Add(10*time.Minute).UnixNano()), forward.TimeoutTimestamp)
+
+	// the forward fails transiently: it times out and is sent again
+	require.NoError(t, pfm.OnTimeoutPacket(ctx, transfertypes.V1, forward, relayer))
+	retry := lastSentPacket(t, ctx)
+	require.Equal(t, forward.Sequence+1, retry.Sequence)
+	require.NotNil(t, app.IBCKeeper.ChannelKeeper.GetPacketCommitment(ctx, transfertypes.PortID, testChannelID, retry.Sequence))
+	require.False(t, app.IBCKeeper.ChannelKeeper.HasPacketAcknowledgement(ctx, transfertypes.PortID, testChannelID, incoming.Sequence))
+
+	// once out of retries it gives up, failing the incoming transfer
+	require.NoError(t, pfm.OnTimeoutPacket(ctx, transfertypes.V1, retry, relayer))
+	require.Equal(t, retry.Sequence, lastSentPacket(t, ctx).Sequence)
+	require.True(t, app.IBCKeeper.ChannelKeeper.HasPacketAcknowledgement(ctx, transfertypes.PortID, testChannelID, incoming.Sequence))
+
+	params.IbcPacketForwardRetries = 256
+	require.Error(t, app.KudoraParamsKeeper.SetParams(ctx, params))
+}
+
+func TestICAHostAccounts(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).CacheContext()
+	setupTestTransferChannel(t, app, ctx)
+
+	// a controller chain registers an account over the test connection
+	account := sdk.AccAddress([]byte("ica_host_account____")).String()
+	app.ICAHostKeeper.SetInterchainAccountAddress(ctx, testConnectionID, "icacontroller-owner", account)
+
+	res, err := newTestQueryClient(app, ctx).ICAHostAccounts(ctx, &kudoratypes.QueryICAHostAccountsRequest{})
+	require.NoError(t, err)
+	require.Contains(t, res.Accounts, kudoratypes.ICAHostAccount{
+		Address:           account,
+		ConnectionId:      testConnectionID,
+		ControllerPortId:  "icacontroller-owner",
+		ControllerChainId: testCounterpartyChainID,
+	})
+}
+
+func TestIBCAllowedClients(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).CacheContext()
+	// the 02-client params, updated by governance, allowlist the client types
+	app.IBCKeeper.ClientKeeper.SetParams(ctx, clienttypes.NewParams(ibctm.ModuleName))
+
+	// disallowed client types are rejected before their states are decoded
+	_, err := app.IBCKeeper.ClientKeeper.CreateClient(ctx, solomachine.ModuleName, []byte("client_state"), []byte("consensus_state"))
+	require.ErrorIs(t, err, clienttypes.ErrInvalidClientType)
+
+	height := clienttypes.NewHeight(1, testCounterpartyLatestBlock)
+	clientState := ibctm.NewClientState(
+		testCounterpartyChainID,
+		ibctm.DefaultTrustLevel,
+		7*24*time.Hour,
+		21*24*time.Hour,
+		10*time.Second,
+		height,
+		commitmenttypes.GetSDKSpecs(),
+		[]string{"upgrade", "upgradedIBCState"},
+	)
+	consensusState := ibctm.NewConsensusState(
+		ctx.BlockTime(),
+		commitmenttypes.NewMerkleRoot([]byte("app_hash")),
+		bytes.Repeat([]byte{1}, 32),
+	)
+	clientID, err := app.IBCKeeper.ClientKeeper.CreateClient(ctx, ibctm.ModuleName, app.appCodec.MustMarshal(clientState), app.appCodec.MustMarshal(consensusState))
+	require.NoError(t, err)
+	require.Equal(t, ibcexported.Active, app.IBCKeeper.ClientKeeper.GetClientStatus(ctx, clientID))
+}
+
+func TestIBCDenomMetadata(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).CacheContext()
+	setupTestTransferChannel(t, app, ctx)
+	relayer := sdk.AccAddress([]byte("relayer_____________"))
+	receiver := sdk.AccAddress([]byte("metadata_receiver___"))
+	transferStack := middleware.NewDenomMetadataSetter(ibctransferevm.NewIBCModule(app.TransferKeeper), app.BankKeeper, app.KudoraParamsKeeper)
+
+	params := kudoratypes.DefaultParams()
+	params.IbcDenomMetadata = true
+	require.NoError(t, app.KudoraParamsKeeper.SetParams(ctx, params))
+
+	receive := func(baseDenom string, sequence uint64) {
+		data := transfertypes.NewFungibleTokenPacketData(baseDenom, "1000", "cosmos1sender", receiver.String(), "")
+		packet := channeltypes.NewPacket(
+			data.GetBytes(),
+			sequence,
+			transfertypes.PortID,
+			testCounterpartyChannelID,
+			transfertypes.PortID,
+			testChannelID,
+			clienttypes.ZeroHeight(),
+			uint64(ctx.BlockTime().Add(time.Hour).UnixNano()),
+		)
+		ack := transferStack.OnRecvPacket(ctx, transfertypes.V1, packet, relayer)
+		require.True(t, ack.Success())
+	}
+
+	// a denom received for the first time gets metadata
+	voucher := transfertypes.ExtractDenomFromPath(transfertypes.PortID + "/" + testChannelID + "/uatom").IBCDenom()
+	receive("uatom", 1)
+	metadata, found := app.BankKeeper.GetDenomMetaData(ctx, voucher)
+	require.True(t, found)
+	require.NoError(t, metadata.Validate())
+	require.Equal(t, voucher, metadata.Base)
+	require.Equal(t, "uatom", metadata.Name)
+	require.Equal(t, []string{"uatom"}, metadata.DenomUnits[0].Aliases)
+
+	// existing metadata is kept
+	metadata.Display = "atom"
+	metadata.DenomUnits = append(metadata.DenomUnits, &banktypes.DenomUnit{Denom: "atom", Exponent: 6})
+	app.BankKeeper.SetDenomMetaData(ctx, metadata)
+	receive("uatom", 2)
+	metadata, found = app.BankKeeper.GetDenomMetaData(ctx, voucher)
+	require.True(t, found)
+	require.Equal(t, "atom", metadata.Display)
+
+	// once disabled, the transfer module sets its own metadata
+	require.NoError(t, app.KudoraParamsKeeper.SetParams(ctx, kudoratypes.DefaultParams()))
+	receive("uosmo", 3)
+	voucher = transfertypes.ExtractDenomFromPath(transfertypes.PortID + "/" + testChannelID + "/uosmo").IBCDenom()
+	metadata, found = app.BankKeeper.GetDenomMetaData(ctx, voucher)
+	require.True(t, found)
+	require.NotEqual(t, "uosmo", metadata.Name)
+}
@@ -0,0 +1,67 @@
+package app
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+)
+
+// TransferResubmitTimeout is the relative timeout given to resubmitted transfers.
+const TransferResubmitTimeout = 10 * time.Minute
+
+// TimedOutTransferRetention is how long timed out transfers can be
+// resubmitted for, after which their records are pruned.
+const TimedOutTransferRetention = 7 * 24 * time.Hour
+
+// ResubmitTimedOutTransfer sends again a transfer that timed out and was
+// refunded to its sender, using a fresh timeout. It returns the sequence of
+// the new packet.
+func (app *App) ResubmitTimedOutTransfer(
+	ctx sdk.Context,
+	sender string,
+	sourcePort string,
+	sourceChannel string,
+	sequence uint64,
+) (uint64, error) {
+	data, found := app.IBCMiddlewareKeeper.GetTimedOutTransfer(ctx, sourcePort, sourceChannel, sequence)
+	if !found {
+		return 0, errorsmod.Wrapf(errortypes.ErrNotFound, "no timed out transfer %s/%s/%d", sourcePort, sourceChannel, sequence)
+	}
+	if data.Sender != sender {
+		return 0, errorsmod.Wrapf(errortypes.ErrUnauthorized, "transfer %s/%s/%d was not sent by %s", sourcePort, sourceChannel, sequence, sender)
+	}
+
+	amount, ok := math.NewIntFromString(data.Amount)
+	if !ok {
+		return 0, errorsmod.Wrapf(errortypes.ErrInvalidCoins, "invalid transfer amount %s", data.Amount)
+	}
+	denom := transfertypes.ExtractDenomFromPath(data.Denom)
+
+	res, err := app.TransferKeeper.Transfer(ctx, &transfertypes.MsgTransfer{
+		SourcePort:       sourcePort,
+		SourceChannel:    sourceChannel,
+		Token:            sdk.NewCoin(denom.IBCDenom(), amount),
+		Sender:           data.Sender,
+		Receiver:         data.Receiver,
+		TimeoutHeight:    clienttypes.ZeroHeight(),
+		TimeoutTimestamp: uint64(ctx.BlockTime().Add(TransferResubmitTimeout).UnixNano()),
+		Memo:             data.Memo,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	app.IBCMiddlewareKeeper.DeleteTimedOutTransfer(ctx, sourcePort, sourceChannel, sequence)
+	return res.Sequence, nil
+}
+
+// pruneTimedOutTransfers removes the records of the timed out transfers
+// older than TimedOutTransferRetention.
+func (app *App) pruneTimedOutTransfers(ctx sdk.Context) {
+	app.IBCMiddlewareKeeper.PruneTimedOutTransfers(ctx, ctx.BlockTime().Add(-TimedOutTransferRetention))
+}
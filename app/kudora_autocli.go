@@ -0,0 +1,130 @@
+package app
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	kudoratypes "kudora/x/kudora/types"
+)
+
+// AutoCLIOptions implements autocli.HasAutoCLIConfig, generating the CLI of
+// the Kudora Query and Msg services.
+func (kudoraModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: kudoratypes.Query_serviceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the Kudora params",
+				},
+				{
+					RpcMethod:      "EVMAddress",
+					Use:            "evm-address [address]",
+					Short:          "Query the 0x EVM address of a bech32 account address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "Bech32Address",
+					Use:            "bech32-address [evm-address]",
+					Short:          "Query the bech32 account address of a 0x EVM address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "evm_address"}},
+				},
+				{
+					RpcMethod: "IBCEscrowBalances",
+					Use:       "ibc-escrow-balances",
+					Short:     "Query the coins held by the IBC transfer escrow accounts",
+				},
+				{
+					RpcMethod:      "IBCTransferFlow",
+					Use:            "ibc-transfer-flow [denom]",
+					Short:          "Query the cumulative amounts of a denom received and sent over IBC",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "IBCConnectionsByClient",
+					Use:            "ibc-connections-by-client [client-id]",
+					Short:          "Query the IBC connections built on top of a light client",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "client_id"}},
+				},
+				{
+					RpcMethod: "IBCChannelCountsByState",
+					Use:       "ibc-channel-counts",
+					Short:     "Query the number of IBC channels in each state",
+				},
+				{
+					RpcMethod: "IBCOutstandingAcksByChannel",
+					Use:       "ibc-outstanding-acks",
+					Short:     "Query the received packets with a written acknowledgement, by channel",
+				},
+				{
+					RpcMethod: "ICAHostAccounts",
+					Use:       "ica-host-accounts",
+					Short:     "Query the interchain accounts hosted on this chain and their controllers",
+				},
+				{
+					RpcMethod: "RateLimits",
+					Use:       "rate-limits",
+					Short:     "Query the status of every IBC rate limit and when its window resets",
+				},
+				{
+					RpcMethod: "RateLimitFlowHistory",
+					Use:       "rate-limit-flow-history [denom] [channel-or-client-id]",
+					Short:     "Query the flows of the last windows of an IBC rate limit",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "channel_or_client_id"},
+					},
+				},
+				{
+					RpcMethod: "LatestBlockGasUsage",
+					Use:       "latest-block-gas-usage",
+					Short:     "Query the gas used and wanted by the transactions of the last block",
+				},
+				{
+					RpcMethod:      "DenomSummary",
+					Use:            "denom-summary [denom]",
+					Short:          "Query the state of a tokenfactory denom at once",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "DenomsByAdmin",
+					Use:            "denoms-by-admin [admin]",
+					Short:          "Query the tokenfactory denoms administered by an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "admin"}},
+				},
+				{
+					RpcMethod:      "AdminSupply",
+					Use:            "admin-supply [admin]",
+					Short:          "Query the tokenfactory denoms administered by an account and their supply",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "admin"}},
+				},
+				{
+					RpcMethod:      "DenomERC20Pair",
+					Use:            "denom-erc20-pair [denom]",
+					Short:          "Query the ERC20 token pair of a tokenfactory denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod: "TrippedMsgTypeURLs",
+					Use:       "tripped-msg-type-urls",
+					Short:     "Query the msg types disabled by the circuit breaker",
+				},
+				{
+					RpcMethod: "NativeSupplyBreakdown",
+					Use:       "native-supply-breakdown",
+					Short:     "Query the supply of the base denom split by holder",
+				},
+				{
+					RpcMethod:      "FeeGrantsForGrantee",
+					Use:            "fee-grants-for-grantee [grantee]",
+					Short:          "Query the unexpired fee grants of a grantee",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "grantee"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: kudoratypes.Msg_serviceDesc.ServiceName,
+		},
+	}
+}
@@ -0,0 +1,105 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/ethereum/go-ethereum/common"
+
+	kudoratypes "kudora/x/kudora/types"
+)
+
+var _ module.HasGenesis = kudoraModule{}
+
+// DefaultGenesis implements module.HasGenesisBasics.
+func (kudoraModule) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(kudoratypes.DefaultGenesis())
+}
+
+// ValidateGenesis implements module.HasGenesisBasics.
+func (kudoraModule) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
+	var gs kudoratypes.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &gs); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", KudoraModuleName, err)
+	}
+	return gs.Validate()
+}
+
+// InitGenesis implements module.HasGenesis.
+func (m kudoraModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, bz json.RawMessage) {
+	var gs kudoratypes.GenesisState
+	cdc.MustUnmarshalJSON(bz, &gs)
+
+	if err := m.app.KudoraParamsKeeper.SetParams(ctx, gs.Params); err != nil {
+		panic(err)
+	}
+	for _, hooks := range gs.BeforeSendHooks {
+		if err := m.app.BeforeSendHooksKeeper.setBeforeSendHooks(ctx, hooks.Denom, hooks.Contracts); err != nil {
+			panic(err)
+		}
+	}
+	for _, denom := range gs.MetadataLockedDenoms {
+		m.app.DenomMetadataLockKeeper.lockDenomMetadata(ctx, denom)
+	}
+	for _, denom := range gs.MintPausedDenoms {
+		m.app.DenomMintPauseKeeper.setMintPaused(ctx, denom, true)
+	}
+	for _, denom := range gs.SendDisabledDenoms {
+		m.app.DenomSendEnabledKeeper.setDisabledByAdmin(ctx, denom, true)
+	}
+	for _, history := range gs.AdminHistories {
+		m.app.DenomAdminHistoryKeeper.setAdminHistory(ctx, history.Denom, history.Changes)
+	}
+	for _, burned := range gs.BurnedDenoms {
+		if err := m.app.DenomBurnKeeper.setBurned(ctx, burned.Denom, burned.Amount); err != nil {
+			panic(err)
+		}
+	}
+	for _, floor := range gs.EVMGasPriceFloors {
+		if err := m.app.EVMGasPriceFloorKeeper.setEVMGasPriceFloor(ctx, common.HexToAddress(floor.Address), floor.MinGasPrice); err != nil {
+			panic(err)
+		}
+	}
+	for _, delegator := range gs.AutoCompoundDelegators {
+		m.app.AutoCompoundKeeper.SetAutoCompound(ctx, sdk.MustAccAddressFromBech32(delegator), true)
+	}
+}
+
+// ExportGenesis implements module.HasGenesis.
+func (m kudoraModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	hooks, err := m.app.BeforeSendHooksKeeper.GetAllBeforeSendHooks(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	burns, err := m.app.DenomBurnKeeper.GetAllBurned(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	floors, err := m.app.EVMGasPriceFloorKeeper.GetAllEVMGasPriceFloors(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	var delegators []string
+	for _, delegator := range m.app.AutoCompoundKeeper.GetAutoCompoundDelegators(ctx) {
+		delegators = append(delegators, delegator.String())
+	}
+
+	return cdc.MustMarshalJSON(&kudoratypes.GenesisState{
+		Params:                 m.app.KudoraParamsKeeper.GetParams(ctx),
+		BeforeSendHooks:        hooks,
+		MetadataLockedDenoms:   m.app.DenomMetadataLockKeeper.GetLockedDenoms(ctx),
+		MintPausedDenoms:       m.app.DenomMintPauseKeeper.GetMintPausedDenoms(ctx),
+		SendDisabledDenoms:     m.app.DenomSendEnabledKeeper.GetDisabledByAdminDenoms(ctx),
+		AdminHistories:         m.app.DenomAdminHistoryKeeper.GetAllAdminHistories(ctx),
+		BurnedDenoms:           burns,
+		EVMGasPriceFloors:      floors,
+		AutoCompoundDelegators: delegators,
+	})
+}
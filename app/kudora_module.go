@@ -0,0 +1,125 @@
+package app
+
+import (
+	"context"
+
+	"cosmossdk.io/client/v2/autocli"
+	"cosmossdk.io/core/appmodule"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+
+	kudoratypes "kudora/x/kudora/types"
+)
+
+const (
+	// KudoraModuleName is the name of the app-local module running Kudora's block hooks.
+	KudoraModuleName = "kudora"
+
+	// KudoraStoreKey is the store holding the state of Kudora's block hooks.
+	KudoraStoreKey = KudoraModuleName
+
+	// KudoraTransientStoreKey is the transient store holding Kudora's per-block counters.
+	KudoraTransientStoreKey = "transient_kudora"
+)
+
+var (
+	_ module.AppModule          = kudoraModule{}
+	_ module.HasServices        = kudoraModule{}
+	_ appmodule.HasBeginBlocker = kudoraModule{}
+	_ appmodule.HasEndBlocker   = kudoraModule{}
+	_ autocli.HasAutoCLIConfig  = kudoraModule{}
+)
+
+// kudoraModule hooks Kudora-specific, app-level logic into the block
+// lifecycle, carries the genesis state of Kudora's extensions to other
+// modules and serves the Msg and Query services managing and reading them.
+type kudoraModule struct {
+	app *App
+}
+
+// Name implements module.HasName.
+func (kudoraModule) Name() string { return KudoraModuleName }
+
+// IsOnePerModuleType implements depinject.OnePerModuleType.
+func (kudoraModule) IsOnePerModuleType() {}
+
+// IsAppModule implements appmodule.AppModule.
+func (kudoraModule) IsAppModule() {}
+
+// RegisterLegacyAminoCodec implements module.AppModuleBasic.
+func (kudoraModule) RegisterLegacyAminoCodec(*codec.LegacyAmino) {}
+
+// RegisterInterfaces implements module.AppModuleBasic.
+func (kudoraModule) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	kudoratypes.RegisterInterfaces(registry)
+}
+
+// RegisterGRPCGatewayRoutes implements module.AppModuleBasic.
+func (kudoraModule) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *gwruntime.ServeMux) {
+	if err := kudoratypes.RegisterQueryHandlerClient(context.Background(), mux, kudoratypes.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
+
+// RegisterServices implements module.HasServices.
+func (m kudoraModule) RegisterServices(cfg module.Configurator) {
+	kudoratypes.RegisterMsgServer(cfg.MsgServer(), kudoraMsgServer{app: m.app})
+	kudoratypes.RegisterQueryServer(cfg.QueryServer(), kudoraQueryServer{app: m.app})
+}
+
+// RegisterKudora registers the Kudora module for CLI, which builds its module
+// basics without an app.
+func RegisterKudora(cdc codec.Codec) map[string]appmodule.AppModule {
+	kudoratypes.RegisterInterfaces(cdc.InterfaceRegistry())
+
+	return map[string]appmodule.AppModule{
+		KudoraModuleName: kudoraModule{},
+	}
+}
+
+// BeginBlock implements appmodule.HasBeginBlocker.
+func (m kudoraModule) BeginBlock(goCtx context.Context) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.app.recordRateLimitWindows(ctx); err != nil {
+		ctx.Logger().Error("failed to record rate limit windows", "module", KudoraModuleName, "error", err)
+	}
+
+	return nil
+}
+
+// EndBlock implements appmodule.HasEndBlocker.
+func (m kudoraModule) EndBlock(goCtx context.Context) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if m.app.checkIBCEscrowInvariant {
+		if msg, broken := IBCEscrowInvariant(m.app)(ctx); broken {
+			ctx.Logger().Error("invariant broken", "module", KudoraModuleName, "details", msg)
+		}
+	}
+
+	if err := m.app.fundCommunityPoolFromFees(ctx); err != nil {
+		ctx.Logger().Error("failed to fund community pool from fees", "module", KudoraModuleName, "error", err)
+	}
+
+	if err := m.app.jailEVMMissedBlocks(ctx); err != nil {
+		ctx.Logger().Error("failed to jail validators missing blocks", "module", KudoraModuleName, "error", err)
+	}
+
+	if err := m.app.autoCompoundRewards(ctx); err != nil {
+		ctx.Logger().Error("failed to auto-compound rewards", "module", KudoraModuleName, "error", err)
+	}
+
+	if err := m.app.recordBlockGasUsage(ctx); err != nil {
+		ctx.Logger().Error("failed to record block gas usage", "module", KudoraModuleName, "error", err)
+	}
+
+	m.app.pruneTimedOutTransfers(ctx)
+	m.app.pruneContractStorageEntries(ctx)
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package app
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	kudoratypes "kudora/x/kudora/types"
+)
+
+var _ kudoratypes.MsgServer = kudoraMsgServer{}
+
+// kudoraMsgServer implements the Kudora Msg service on top of the keepers of
+// the Kudora extensions.
+type kudoraMsgServer struct {
+	app *App
+}
+
+// UpdateParams implements kudoratypes.MsgServer.
+func (s kudoraMsgServer) UpdateParams(
+	goCtx context.Context,
+	msg *kudoratypes.MsgUpdateParams,
+) (*kudoratypes.MsgUpdateParamsResponse, error) {
+	keeper := s.app.KudoraParamsKeeper
+	if msg.Authority != keeper.GetAuthority() {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized, "invalid authority; expected %s, got %s", keeper.GetAuthority(), msg.Authority)
+	}
+
+	// the counts of a previous contract storage cap are stale, it can only be
+	// enabled again once EndBlock has pruned them
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if msg.Params.WasmMaxContractStorageEntries != 0 &&
+		keeper.GetParams(ctx).WasmMaxContractStorageEntries == 0 &&
+		hasContractStorageEntries(ctx, s.app.GetKey(KudoraStoreKey)) {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "the contract storage entries of the previous cap are still being pruned")
+	}
+
+	if err := keeper.SetParams(ctx, msg.Params); err != nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+	return &kudoratypes.MsgUpdateParamsResponse{}, nil
+}
+
+// SetAutoCompound implements kudoratypes.MsgServer.
+func (s kudoraMsgServer) SetAutoCompound(
+	goCtx context.Context,
+	msg *kudoratypes.MsgSetAutoCompound,
+) (*kudoratypes.MsgSetAutoCompoundResponse, error) {
+	delegator, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid delegator address: %s", err)
+	}
+
+	s.app.AutoCompoundKeeper.SetAutoCompound(sdk.UnwrapSDKContext(goCtx), delegator, msg.Enabled)
+	return &kudoratypes.MsgSetAutoCompoundResponse{}, nil
+}
+
+// SetMintPaused implements kudoratypes.MsgServer.
+func (s kudoraMsgServer) SetMintPaused(
+	goCtx context.Context,
+	msg *kudoratypes.MsgSetMintPaused,
+) (*kudoratypes.MsgSetMintPausedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := s.app.DenomMintPauseKeeper.SetMintPaused(ctx, msg.Sender, msg.Denom, msg.Paused); err != nil {
+		return nil, err
+	}
+	return &kudoratypes.MsgSetMintPausedResponse{}, nil
+}
+
+// LockDenomMetadata implements kudoratypes.MsgServer.
+func (s kudoraMsgServer) LockDenomMetadata(
+	goCtx context.Context,
+	msg *kudoratypes.MsgLockDenomMetadata,
+) (*kudoratypes.MsgLockDenomMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := s.app.DenomMetadataLockKeeper.LockDenomMetadata(ctx, msg.Sender, msg.Denom); err != nil {
+		return nil, err
+	}
+	return &kudoratypes.MsgLockDenomMetadataResponse{}, nil
+}
+
+// SetBeforeSendHooks implements kudoratypes.MsgServer.
+func (s kudoraMsgServer) SetBeforeSendHooks(
+	goCtx context.Context,
+	msg *kudoratypes.MsgSetBeforeSendHooks,
+) (*kudoratypes.MsgSetBeforeSendHooksResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := s.app.BeforeSendHooksKeeper.SetBeforeSendHooks(ctx, msg.Sender, msg.Denom, msg.Contracts); err != nil {
+		return nil, err
+	}
+	return &kudoratypes.MsgSetBeforeSendHooksResponse{}, nil
+}
+
+// SetEVMGasPriceFloor implements kudoratypes.MsgServer.
+func (s kudoraMsgServer) SetEVMGasPriceFloor(
+	goCtx context.Context,
+	msg *kudoratypes.MsgSetEVMGasPriceFloor,
+) (*kudoratypes.MsgSetEVMGasPriceFloorResponse, error) {
+	if !common.IsHexAddress(msg.Sender) {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid EVM sender address %s", msg.Sender)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender := common.HexToAddress(msg.Sender)
+	if err := s.app.EVMGasPriceFloorKeeper.SetEVMGasPriceFloor(ctx, msg.Authority, sender, msg.MinGasPrice); err != nil {
+		return nil, err
+	}
+	return &kudoratypes.MsgSetEVMGasPriceFloorResponse{}, nil
+}
+
+// SetDenomSendEnabled implements kudoratypes.MsgServer.
+func (s kudoraMsgServer) SetDenomSendEnabled(
+	goCtx context.Context,
+	msg *kudoratypes.MsgSetDenomSendEnabled,
+) (*kudoratypes.MsgSetDenomSendEnabledResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := s.app.DenomSendEnabledKeeper.SetSendEnabled(ctx, msg.Sender, msg.Denom, msg.Enabled); err != nil {
+		return nil, err
+	}
+	return &kudoratypes.MsgSetDenomSendEnabledResponse{}, nil
+}
+
+// ResubmitTimedOutTransfer implements kudoratypes.MsgServer.
+func (s kudoraMsgServer) ResubmitTimedOutTransfer(
+	goCtx context.Context,
+	msg *kudoratypes.MsgResubmitTimedOutTransfer,
+) (*kudoratypes.MsgResubmitTimedOutTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sequence, err := s.app.ResubmitTimedOutTransfer(ctx, msg.Sender, msg.SourcePort, msg.SourceChannel, msg.Sequence)
+	if err != nil {
+		return nil, err
+	}
+	return &kudoratypes.MsgResubmitTimedOutTransferResponse{Sequence: sequence}, nil
+}
@@ -0,0 +1,179 @@
+package app
+
+import (
+	"time"
+
+	"cosmossdk.io/depinject"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	kudoratypes "kudora/x/kudora/types"
+)
+
+// kudoraParamsKey is the key of the Kudora params in the Kudora store.
+var kudoraParamsKey = []byte{0x04}
+
+// KudoraParamsKeeper keeps the on-chain params of the Kudora extensions. They
+// are set at genesis and then only by the authority, so that every validator
+// runs the extensions with the same values.
+type KudoraParamsKeeper struct {
+	cdc       codec.BinaryCodec
+	storeKey  storetypes.StoreKey
+	authority string
+}
+
+// NewKudoraParamsKeeper creates a new KudoraParamsKeeper managed by authority.
+func NewKudoraParamsKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, authority string) KudoraParamsKeeper {
+	return KudoraParamsKeeper{
+		cdc:       cdc,
+		storeKey:  storeKey,
+		authority: authority,
+	}
+}
+
+// kudoraParamsConfig provides the Kudora params to the depinject modules.
+func kudoraParamsConfig() depinject.Config {
+	return depinject.Provide(ProvideKudoraParamsReader)
+}
+
+// ProvideKudoraParamsReader reads the params from app, whose keeper is only
+// set once the depinject modules are built.
+func ProvideKudoraParamsReader(app *App) KudoraParamsReader {
+	return appKudoraParamsReader{app: app}
+}
+
+type appKudoraParamsReader struct {
+	app *App
+}
+
+// GetParams implements KudoraParamsReader.
+func (r appKudoraParamsReader) GetParams(ctx sdk.Context) kudoratypes.Params {
+	return r.app.KudoraParamsKeeper.GetParams(ctx)
+}
+
+// GetAuthority returns the address allowed to update the params.
+func (k KudoraParamsKeeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the Kudora params, the defaults if none were set yet.
+func (k KudoraParamsKeeper) GetParams(ctx sdk.Context) kudoratypes.Params {
+	bz := ctx.KVStore(k.storeKey).Get(kudoraParamsKey)
+	if bz == nil {
+		return kudoratypes.DefaultParams()
+	}
+
+	var params kudoratypes.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams validates and stores the Kudora params.
+func (k KudoraParamsKeeper) SetParams(ctx sdk.Context, params kudoratypes.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Set(kudoraParamsKey, k.cdc.MustMarshal(&params))
+	return nil
+}
+
+// BurnEVMGasRefunds reports whether the fees of unused EVM gas are burnt
+// instead of refunded.
+func (k KudoraParamsKeeper) BurnEVMGasRefunds(ctx sdk.Context) bool {
+	return k.GetParams(ctx).BurnEvmGasRefunds
+}
+
+// MinGasPriceBootstrapBlocks implements ante.BootstrapBlocksKeeper.
+func (k KudoraParamsKeeper) MinGasPriceBootstrapBlocks(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).MinGasPriceBootstrapBlocks
+}
+
+// MaxTxSigners implements ante.MaxSignersKeeper.
+func (k KudoraParamsKeeper) MaxTxSigners(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).MaxTxSigners
+}
+
+// RejectExpiredTxs implements ante.DeadlineKeeper.
+func (k KudoraParamsKeeper) RejectExpiredTxs(ctx sdk.Context) bool {
+	return k.GetParams(ctx).RejectExpiredTxs
+}
+
+// MaxWasmInstantiationsPerBlock implements ante.WasmInstantiationLimitKeeper.
+func (k KudoraParamsKeeper) MaxWasmInstantiationsPerBlock(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).WasmMaxInstantiationsPerBlock
+}
+
+// GetMsgFees implements ante.MsgFeeKeeper.
+func (k KudoraParamsKeeper) GetMsgFees(ctx sdk.Context) map[string]sdk.Coins {
+	params := k.GetParams(ctx)
+	if len(params.MsgFees) == 0 {
+		return nil
+	}
+
+	fees := make(map[string]sdk.Coins, len(params.MsgFees))
+	for _, fee := range params.MsgFees {
+		fees[fee.MsgTypeUrl] = fee.Fee
+	}
+	return fees
+}
+
+// IBCDenomMetadataEnabled implements middleware.DenomMetadataParamsKeeper.
+func (k KudoraParamsKeeper) IBCDenomMetadataEnabled(ctx sdk.Context) bool {
+	return k.GetParams(ctx).IbcDenomMetadata
+}
+
+// ERC20DenomAllowlist implements middleware.ERC20AllowlistKeeper.
+func (k KudoraParamsKeeper) ERC20DenomAllowlist(ctx sdk.Context) []string {
+	return k.GetParams(ctx).IbcErc20DenomAllowlist
+}
+
+// PacketForwardDisabled implements middleware.PacketForwardParamsKeeper.
+func (k KudoraParamsKeeper) PacketForwardDisabled(ctx sdk.Context) bool {
+	return k.GetParams(ctx).IbcDisablePacketForward
+}
+
+// RateLimitExemptDenoms implements middleware.RateLimitExemptionKeeper.
+func (k KudoraParamsKeeper) RateLimitExemptDenoms(ctx sdk.Context) []string {
+	return k.GetParams(ctx).IbcRatelimitExemptDenoms
+}
+
+// MaxMemoBytes implements middleware.MemoSizeLimitKeeper.
+func (k KudoraParamsKeeper) MaxMemoBytes(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).IbcMaxMemoBytes
+}
+
+// IBCBlockedRecipients implements middleware.BlockedRecipientsKeeper.
+func (k KudoraParamsKeeper) IBCBlockedRecipients(ctx sdk.Context) []sdk.AccAddress {
+	params := k.GetParams(ctx)
+	if len(params.IbcBlockedRecipients) == 0 {
+		return nil
+	}
+
+	recipients := make([]sdk.AccAddress, 0, len(params.IbcBlockedRecipients))
+	for _, recipient := range params.IbcBlockedRecipients {
+		// the params only hold valid addresses
+		addr, err := sdk.AccAddressFromBech32(recipient)
+		if err == nil {
+			recipients = append(recipients, addr)
+		}
+	}
+	return recipients
+}
+
+// IBCDustThresholds implements middleware.DustFilterKeeper.
+func (k KudoraParamsKeeper) IBCDustThresholds(ctx sdk.Context) sdk.Coins {
+	return k.GetParams(ctx).IbcDustThresholds
+}
+
+// GetPacketCountLimit implements middleware.PacketCountLimitKeeper.
+func (k KudoraParamsKeeper) GetPacketCountLimit(ctx sdk.Context, channelID string) (uint64, time.Duration, bool) {
+	params := k.GetParams(ctx)
+	for _, limit := range params.IbcPacketCountLimits {
+		if limit.ChannelId == channelID {
+			return limit.MaxPackets, params.IbcPacketCountWindow, true
+		}
+	}
+	return 0, 0, false
+}
@@ -0,0 +1,303 @@
+package app
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	kudoratypes "kudora/x/kudora/types"
+)
+
+var _ kudoratypes.QueryServer = kudoraQueryServer{}
+
+// kudoraQueryServer implements the Kudora Query service on top of the read
+// methods of the App.
+type kudoraQueryServer struct {
+	app *App
+}
+
+// Params implements kudoratypes.QueryServer.
+func (s kudoraQueryServer) Params(
+	goCtx context.Context,
+	_ *kudoratypes.QueryParamsRequest,
+) (*kudoratypes.QueryParamsResponse, error) {
+	params := s.app.KudoraParamsKeeper.GetParams(sdk.UnwrapSDKContext(goCtx))
+	return &kudoratypes.QueryParamsResponse{Params: params}, nil
+}
+
+// EVMAddress implements kudoratypes.QueryServer.
+func (s kudoraQueryServer) EVMAddress(
+	_ context.Context,
+	req *kudoratypes.QueryEVMAddressRequest,
+) (*kudoratypes.QueryEVMAddressResponse, error) {
+	evmAddr, err := s.app.EVMAddress(req.Address)
+	if err != nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidAddress, err.Error())
+	}
+	return &kudoratypes.QueryEVMAddressResponse{EvmAddress: evmAddr.Hex()}, nil
+}
+
+// Bech32Address implements kudoratypes.QueryServer.
+func (s kudoraQueryServer) Bech32Address(
+	_ context.Context,
+	req *kudoratypes.QueryBech32AddressRequest,
+) (*kudoratypes.QueryBech32AddressResponse, error) {
+	addr, err := s.app.Bech32Address(req.EvmAddress)
+	if err != nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidAddress, err.Error())
+	}
+	return &kudoratypes.QueryBech32AddressResponse{Address: addr}, nil
+}
+
+// IBCEscrowBalances implements kudoratypes.QueryServer.
+func (s kudoraQueryServer) IBCEscrowBalances(
+	goCtx context.Context,
+	_ *kudoratypes.QueryIBCEscrowBalancesRequest,
+) (*kudoratypes.QueryIBCEscrowBalancesResponse, error) {
+	balances := s.app.IBCEscrowBalances(sdk.UnwrapSDKContext(goCtx))
+	return &kudoratypes.QueryIBCEscrowBalancesResponse{Balances: balances}, nil
+}
+
+// IBCTransferFlow implements kudoratypes.QueryServer.
+func (s kudoraQueryServer) IBCTransferFlow(
+	goCtx context.Context,
+	req *kudoratypes.QueryIBCTransferFlowRequest,
+) (*kudoratypes.QueryIBCTransferFlowResponse, error) {
+	flow := s.app.IBCTransferFlow(sdk.UnwrapSDKContext(goCtx), req.Denom)
+	return &kudoratypes.QueryIBCTransferFlowResponse{Inflow: flow.Inflow, Outflow: flow.Outflow}, nil
+}
+
+// IBCConnectionsByClient implements kudoratypes.QueryServer.
+func (s kudoraQueryServer) IBCConnectionsByClient(
+	goCtx context.Context,
+	req *kudoratypes.QueryIBCConnectionsByClientRequest,
+) (*kudoratypes.QueryIBCConnectionsByClientResponse, error) {
+	var connections []kudoratypes.IBCConnection
+	for _, connection := range s.app.IBCConnectionsByClient(sdk.UnwrapSDKContext(goCtx), req.ClientId) {
+		connections = append(connections, kudoratypes.IBCConnection{
+			ConnectionId:             connection.ConnectionID,
+			ClientId:                 connection.ClientID,
+			State:                    connection.State.String(),
+			CounterpartyConnectionId: connection.CounterpartyConnectionID,
+			CounterpartyClientId:     connection.CounterpartyClientID,
+		})
+	}
+	return &kudoratypes.QueryIBCConnectionsByClientResponse{Connections: connections}, nil
+}
+
+// IBCChannelCountsByState implements kudoratypes.QueryServer.
+func (s kudoraQueryServer) IBCChannelCountsByState(
+	goCtx context.Context,
+	_ *kudoratypes.QueryIBCChannelCountsByStateRequest,
+) (*kudoratypes.QueryIBCChannelCountsByStateResponse, error) {
+	counts := s.app.IBCChannelCountsByState(sdk.UnwrapSDKContext(goCtx))
+
+	states := slices.Sorted(maps.Keys(counts))
+	res := &kudoratypes.QueryIBCChannelCountsByStateResponse{
+		Counts: make([]kudoratypes.ChannelStateCount, 0, len(states)),
+	}
+	for _, state := range states {
+		res.Counts = append(res.Counts, kudoratypes.ChannelStateCount{State: state.String(), Count: counts[state]})
+	}
+	return res, nil
+}
+
+// IBCOutstandingAcksByChannel implements kudoratypes.QueryServer.
+func (s kudoraQueryServer) IBCOutstandingAcksByChannel(
+	goCtx context.Context,
+	_ *kudoratypes.QueryIBCOutstandingAcksByChannelRequest,
+) (*kudoratypes.QueryIBCOutstandingAcksByChannelResponse, error) {
+	var channels []kudoratypes.ChannelSequences
+	for _, acks := range s.app.IBCOutstandingAcksByChannel(sdk.UnwrapSDKContext(goCtx)) {
+		channels = append(channels, kudoratypes.ChannelSequences{
+			PortId:    acks.PortID,
+			ChannelId: acks.ChannelID,
+			Sequences: acks.Sequences,
+		})
+	}
+	return &kudoratypes.QueryIBCOutstandingAcksByChannelResponse{Channels: channels}, nil
+}
+
+// ICAHostAccounts implements kudoratypes.QueryServer.
+func (s kudoraQueryServer) ICAHostAccounts(
+	goCtx context.Context,
+	_ *kudoratypes.QueryICAHostAccountsRequest,
+) (*kudoratypes.QueryICAHostAccountsResponse, error) {
+	var accounts []kudoratypes.ICAHostAccount
+	for _, account := range s.app.ICAHostAccounts(sdk.UnwrapSDKContext(goCtx)) {
+		accounts = append(accounts, kudoratypes.ICAHostAccount{
+			Address:           account.Address,
+			ConnectionId:      account.ConnectionID,
+			ControllerPortId:  account.ControllerPortID,
+			ControllerChainId: account.ControllerChainID,
+		})
+	}
+	return &kudoratypes.QueryICAHostAccountsResponse{Accounts: accounts}, nil
+}
+
+// RateLimits implements kudoratypes.QueryServer.
+func (s kudoraQueryServer) RateLimits(
+	goCtx context.Context,
+	_ *kudoratypes.QueryRateLimitsRequest,
+) (*kudoratypes.QueryRateLimitsResponse, error) {
+	var rateLimits []kudoratypes.RateLimitStatus
+	for _, rateLimit := range s.app.RateLimits(sdk.UnwrapSDKContext(goCtx)) {
+		status := kudoratypes.RateLimitStatus{ResetsIn: rateLimit.ResetsIn}
+		if path := rateLimit.Path; path != nil {
+			status.Denom = path.Denom
+			status.ChannelOrClientId = path.ChannelOrClientId
+		}
+		if quota := rateLimit.Quota; quota != nil {
+			status.MaxPercentSend = quota.MaxPercentSend
+			status.MaxPercentRecv = quota.MaxPercentRecv
+			status.DurationHours = quota.DurationHours
+		}
+		if flow := rateLimit.Flow; flow != nil {
+			status.Inflow = flow.Inflow
+			status.Outflow = flow.Outflow
+			status.ChannelValue = flow.ChannelValue
+		}
+		rateLimits = append(rateLimits, status)
+	}
+	return &kudoratypes.QueryRateLimitsResponse{RateLimits: rateLimits}, nil
+}
+
+// RateLimitFlowHistory implements kudoratypes.QueryServer.
+func (s kudoraQueryServer) RateLimitFlowHistory(
+	goCtx context.Context,
+	req *kudoratypes.QueryRateLimitFlowHistoryRequest,
+) (*kudoratypes.QueryRateLimitFlowHistoryResponse, error) {
+	n := -1
+	if req.Limit > 0 {
+		n = int(req.Limit)
+	}
+
+	var windows []kudoratypes.RateLimitWindow
+	for _, window := range s.app.RateLimitFlowHistory(sdk.UnwrapSDKContext(goCtx), req.Denom, req.ChannelOrClientId, n) {
+		windows = append(windows, kudoratypes.RateLimitWindow{
+			End:          window.End,
+			Inflow:       window.Inflow,
+			Outflow:      window.Outflow,
+			ChannelValue: window.ChannelValue,
+		})
+	}
+	return &kudoratypes.QueryRateLimitFlowHistoryResponse{Windows: windows}, nil
+}
+
+// LatestBlockGasUsage implements kudoratypes.QueryServer.
+func (s kudoraQueryServer) LatestBlockGasUsage(
+	goCtx context.Context,
+	_ *kudoratypes.QueryLatestBlockGasUsageRequest,
+) (*kudoratypes.QueryLatestBlockGasUsageResponse, error) {
+	return &kudoratypes.QueryLatestBlockGasUsageResponse{
+		Usage: s.app.LatestBlockGasUsage(sdk.UnwrapSDKContext(goCtx)),
+	}, nil
+}
+
+// DenomSummary implements kudoratypes.QueryServer.
+func (s kudoraQueryServer) DenomSummary(
+	goCtx context.Context,
+	req *kudoratypes.QueryDenomSummaryRequest,
+) (*kudoratypes.QueryDenomSummaryResponse, error) {
+	summary, err := s.app.GetDenomSummary(sdk.UnwrapSDKContext(goCtx), req.Denom)
+	if err != nil {
+		return nil, err
+	}
+	return &kudoratypes.QueryDenomSummaryResponse{
+		Summary: kudoratypes.DenomSummary{
+			Denom:           summary.Denom,
+			Creator:         summary.Creator,
+			Admin:           summary.Admin,
+			Supply:          summary.Supply,
+			Metadata:        summary.Metadata,
+			MetadataLocked:  summary.MetadataLocked,
+			MintPaused:      summary.MintPaused,
+			BeforeSendHooks: summary.BeforeSendHooks,
+		},
+	}, nil
+}
+
+// DenomsByAdmin implements kudoratypes.QueryServer.
+func (s kudoraQueryServer) DenomsByAdmin(
+	goCtx context.Context,
+	req *kudoratypes.QueryDenomsByAdminRequest,
+) (*kudoratypes.QueryDenomsByAdminResponse, error) {
+	denoms := s.app.GetDenomsByAdmin(sdk.UnwrapSDKContext(goCtx), req.Admin)
+	return &kudoratypes.QueryDenomsByAdminResponse{Denoms: denoms}, nil
+}
+
+// AdminSupply implements kudoratypes.QueryServer.
+func (s kudoraQueryServer) AdminSupply(
+	goCtx context.Context,
+	req *kudoratypes.QueryAdminSupplyRequest,
+) (*kudoratypes.QueryAdminSupplyResponse, error) {
+	supply := s.app.GetAdminSupply(sdk.UnwrapSDKContext(goCtx), req.Admin)
+	return &kudoratypes.QueryAdminSupplyResponse{Denoms: supply.Denoms, Supply: supply.Supply}, nil
+}
+
+// DenomERC20Pair implements kudoratypes.QueryServer.
+func (s kudoraQueryServer) DenomERC20Pair(
+	goCtx context.Context,
+	req *kudoratypes.QueryDenomERC20PairRequest,
+) (*kudoratypes.QueryDenomERC20PairResponse, error) {
+	pair, err := s.app.GetDenomERC20Pair(sdk.UnwrapSDKContext(goCtx), req.Denom)
+	if err != nil {
+		return nil, err
+	}
+	return &kudoratypes.QueryDenomERC20PairResponse{
+		Registered:      pair.Registered,
+		ContractAddress: pair.ContractAddress,
+		Enabled:         pair.Enabled,
+	}, nil
+}
+
+// TrippedMsgTypeURLs implements kudoratypes.QueryServer.
+func (s kudoraQueryServer) TrippedMsgTypeURLs(
+	goCtx context.Context,
+	_ *kudoratypes.QueryTrippedMsgTypeURLsRequest,
+) (*kudoratypes.QueryTrippedMsgTypeURLsResponse, error) {
+	typeURLs, err := s.app.TrippedMsgTypeURLs(sdk.UnwrapSDKContext(goCtx))
+	if err != nil {
+		return nil, err
+	}
+	return &kudoratypes.QueryTrippedMsgTypeURLsResponse{TypeUrls: typeURLs}, nil
+}
+
+// NativeSupplyBreakdown implements kudoratypes.QueryServer.
+func (s kudoraQueryServer) NativeSupplyBreakdown(
+	goCtx context.Context,
+	_ *kudoratypes.QueryNativeSupplyBreakdownRequest,
+) (*kudoratypes.QueryNativeSupplyBreakdownResponse, error) {
+	breakdown, err := s.app.GetNativeSupplyBreakdown(sdk.UnwrapSDKContext(goCtx))
+	if err != nil {
+		return nil, err
+	}
+	return &kudoratypes.QueryNativeSupplyBreakdownResponse{
+		Total:         breakdown.Total,
+		Bonded:        breakdown.Bonded,
+		CommunityPool: breakdown.CommunityPool,
+		ModuleHeld:    breakdown.ModuleHeld,
+		Circulating:   breakdown.Circulating,
+	}, nil
+}
+
+// FeeGrantsForGrantee implements kudoratypes.QueryServer.
+func (s kudoraQueryServer) FeeGrantsForGrantee(
+	goCtx context.Context,
+	req *kudoratypes.QueryFeeGrantsForGranteeRequest,
+) (*kudoratypes.QueryFeeGrantsForGranteeResponse, error) {
+	grantee, err := s.app.AuthKeeper.AddressCodec().StringToBytes(req.Grantee)
+	if err != nil {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid grantee address: %s", err)
+	}
+
+	grants, err := s.app.FeeGrantsForGrantee(sdk.UnwrapSDKContext(goCtx), grantee)
+	if err != nil {
+		return nil, err
+	}
+	return &kudoratypes.QueryFeeGrantsForGranteeResponse{Grants: grants}, nil
+}
@@ -0,0 +1,71 @@
+package middleware
+
+import (
+	"encoding/json"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+var _ porttypes.IBCModule = BlockedRecipients{}
+
+// BlockedRecipientsKeeper provides the local addresses transfers are
+// rejected for.
+type BlockedRecipientsKeeper interface {
+	IBCBlockedRecipients(ctx sdk.Context) []sdk.AccAddress
+}
+
+// BlockedRecipients rejects incoming transfers to a set of local recipient
+// addresses, refunding the sender on the counterparty chain.
+type BlockedRecipients struct {
+	porttypes.IBCModule
+
+	keeper BlockedRecipientsKeeper
+}
+
+// NewBlockedRecipients wraps the given transfer stack, rejecting transfers to
+// the recipients keeper returns.
+func NewBlockedRecipients(app porttypes.IBCModule, keeper BlockedRecipientsKeeper) BlockedRecipients {
+	return BlockedRecipients{IBCModule: app, keeper: keeper}
+}
+
+// OnRecvPacket rejects transfers to a blocked recipient. Receivers are
+// compared by address bytes, so a re-encoded bech32 of a blocked address, in
+// uppercase for instance, is rejected too.
+func (m BlockedRecipients) OnRecvPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	blocked := m.keeper.IBCBlockedRecipients(ctx)
+	if len(blocked) == 0 {
+		return m.IBCModule.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	}
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(packet.GetData(), &data); err == nil {
+		if isBlockedRecipient(blocked, data.Receiver) {
+			return channeltypes.NewErrorAcknowledgement(errBlockedRecipient(data.Receiver))
+		}
+	}
+
+	return m.IBCModule.OnRecvPacket(ctx, channelVersion, packet, relayer)
+}
+
+// isBlockedRecipient reports whether receiver decodes to one of the blocked
+// addresses.
+func isBlockedRecipient(blocked []sdk.AccAddress, receiver string) bool {
+	addr, err := sdk.AccAddressFromBech32(receiver)
+	return err == nil && slices.ContainsFunc(blocked, func(b sdk.AccAddress) bool { return b.Equals(addr) })
+}
+
+func errBlockedRecipient(receiver string) error {
+	return errorsmod.Wrapf(errortypes.ErrUnauthorized, "recipient %s is blocked", receiver)
+}
@@ -0,0 +1,75 @@
+package middleware_test
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	"github.com/stretchr/testify/require"
+
+	"kudora/app/middleware"
+)
+
+// blockedRecipients serves fixed blocked recipients.
+type blockedRecipients []sdk.AccAddress
+
+func (r blockedRecipients) IBCBlockedRecipients(sdk.Context) []sdk.AccAddress {
+	return r
+}
+
+func TestBlockedRecipients(t *testing.T) {
+	sanctioned := sdk.AccAddress([]byte("sanctioned__________"))
+	receiver := sdk.AccAddress([]byte("receiver____________"))
+
+	app := &recordingModule{}
+	blocked := middleware.NewBlockedRecipients(app, blockedRecipients{sanctioned})
+
+	dataTo := func(receiver string) []byte {
+		return transfertypes.NewFungibleTokenPacketData("uatom", "100", "cosmos1sender", receiver, "").GetBytes()
+	}
+
+	// blocked recipient
+	ack := blocked.OnRecvPacket(sdk.Context{}, transfertypes.V1, newIncomingPacket(dataTo(sanctioned.String()), 1), nil)
+	require.False(t, ack.Success())
+	require.Empty(t, app.received)
+
+	// blocked recipient, encoded in uppercase
+	ack = blocked.OnRecvPacket(sdk.Context{}, transfertypes.V1, newIncomingPacket(dataTo(strings.ToUpper(sanctioned.String())), 2), nil)
+	require.False(t, ack.Success())
+	require.Empty(t, app.received)
+
+	// any other recipient
+	ack = blocked.OnRecvPacket(sdk.Context{}, transfertypes.V1, newIncomingPacket(dataTo(receiver.String()), 3), nil)
+	require.True(t, ack.Success())
+	require.Len(t, app.received, 1)
+}
+
+func TestBlockedRecipientsV2(t *testing.T) {
+	sanctioned := sdk.AccAddress([]byte("sanctioned__________"))
+	receiver := sdk.AccAddress([]byte("receiver____________"))
+
+	app := &recordingModuleV2{}
+	blocked := middleware.NewBlockedRecipientsV2(app, blockedRecipients{sanctioned})
+
+	payloadTo := func(receiver string) channeltypesv2.Payload {
+		return channeltypesv2.Payload{
+			SourcePort:      testPort,
+			DestinationPort: testPort,
+			Version:         transfertypes.V1,
+			Encoding:        transfertypes.EncodingJSON,
+			Value:           transfertypes.NewFungibleTokenPacketData("uatom", "100", "cosmos1sender", receiver, "").GetBytes(),
+		}
+	}
+
+	// blocked recipient
+	res := blocked.OnRecvPacket(sdk.Context{}, "client-7", "client-0", 1, payloadTo(sanctioned.String()), nil)
+	require.Equal(t, channeltypesv2.PacketStatus_Failure, res.Status)
+	require.Empty(t, app.received)
+
+	// any other recipient
+	res = blocked.OnRecvPacket(sdk.Context{}, "client-7", "client-0", 2, payloadTo(receiver.String()), nil)
+	require.Equal(t, channeltypesv2.PacketStatus_Success, res.Status)
+	require.Len(t, app.received, 1)
+}
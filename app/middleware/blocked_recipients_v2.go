@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	ibcapi "github.com/cosmos/ibc-go/v10/modules/core/api"
+)
+
+var _ ibcapi.IBCModule = BlockedRecipientsV2{}
+
+// BlockedRecipientsV2 is BlockedRecipients for the IBC v2 transfer stack,
+// rejecting incoming v2 transfers to the blocked local addresses.
+type BlockedRecipientsV2 struct {
+	ibcapi.IBCModule
+
+	keeper BlockedRecipientsKeeper
+}
+
+// NewBlockedRecipientsV2 wraps the given IBC v2 transfer stack, rejecting
+// transfers to the recipients keeper returns.
+func NewBlockedRecipientsV2(app ibcapi.IBCModule, keeper BlockedRecipientsKeeper) BlockedRecipientsV2 {
+	return BlockedRecipientsV2{IBCModule: app, keeper: keeper}
+}
+
+// OnRecvPacket rejects transfers to a blocked recipient. Payloads that don't
+// decode are left to the transfer module to reject.
+func (m BlockedRecipientsV2) OnRecvPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) channeltypesv2.RecvPacketResult {
+	blocked := m.keeper.IBCBlockedRecipients(ctx)
+	if len(blocked) == 0 {
+		return m.IBCModule.OnRecvPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+	}
+
+	data, err := transfertypes.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+	if err == nil && isBlockedRecipient(blocked, data.Receiver) {
+		return channeltypesv2.RecvPacketResult{Status: channeltypesv2.PacketStatus_Failure}
+	}
+
+	return m.IBCModule.OnRecvPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+}
@@ -0,0 +1,101 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+var _ porttypes.IBCModule = DenomMetadataSetter{}
+
+// DenomMetadataKeeper is the part of the bank keeper DenomMetadataSetter uses.
+type DenomMetadataKeeper interface {
+	HasDenomMetaData(ctx context.Context, denom string) bool
+	SetDenomMetaData(ctx context.Context, metadata banktypes.Metadata)
+}
+
+// DenomMetadataParamsKeeper reports whether DenomMetadataSetter is enabled.
+type DenomMetadataParamsKeeper interface {
+	IBCDenomMetadataEnabled(ctx sdk.Context) bool
+}
+
+// DenomMetadataSetter gives the IBC vouchers received for the first time a
+// minimal bank metadata named after their source denom, so that wallets
+// show "uatom" rather than its full trace. It is set before the transfer
+// application runs, which then keeps it instead of setting its own.
+// Metadata that already exists is left alone.
+type DenomMetadataSetter struct {
+	porttypes.IBCModule
+
+	bankKeeper   DenomMetadataKeeper
+	paramsKeeper DenomMetadataParamsKeeper
+}
+
+// NewDenomMetadataSetter wraps the given transfer application.
+func NewDenomMetadataSetter(app porttypes.IBCModule, bankKeeper DenomMetadataKeeper, paramsKeeper DenomMetadataParamsKeeper) DenomMetadataSetter {
+	return DenomMetadataSetter{
+		IBCModule:    app,
+		bankKeeper:   bankKeeper,
+		paramsKeeper: paramsKeeper,
+	}
+}
+
+// OnRecvPacket sets the metadata of the voucher before it is received, if it
+// has none. The core IBC handler discards it along with the other writes of
+// the receive if the acknowledgement is an error.
+func (m DenomMetadataSetter) OnRecvPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	if !m.paramsKeeper.IBCDenomMetadataEnabled(ctx) {
+		return m.IBCModule.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	}
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
+		return m.IBCModule.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	}
+
+	// tokens coming back to this chain are not vouchers
+	sourcePrefix := packet.GetSourcePort() + "/" + packet.GetSourceChannel() + "/"
+	if strings.HasPrefix(data.Denom, sourcePrefix) {
+		return m.IBCModule.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	}
+
+	denom := transfertypes.ExtractDenomFromPath(packet.GetDestPort() + "/" + packet.GetDestChannel() + "/" + data.Denom)
+	voucher := denom.IBCDenom()
+	if !m.bankKeeper.HasDenomMetaData(ctx, voucher) {
+		m.bankKeeper.SetDenomMetaData(ctx, newVoucherMetadata(denom))
+	}
+	return m.IBCModule.OnRecvPacket(ctx, channelVersion, packet, relayer)
+}
+
+// newVoucherMetadata returns the metadata of the voucher of denom. The
+// source denom is an alias of the voucher, which is also its display unit:
+// both have exponent 0, and bank metadata requires the exponents of their
+// units to increase.
+func newVoucherMetadata(denom transfertypes.Denom) banktypes.Metadata {
+	voucher := denom.IBCDenom()
+	return banktypes.Metadata{
+		Description: fmt.Sprintf("IBC token from %s", denom.Path()),
+		DenomUnits: []*banktypes.DenomUnit{{
+			Denom:    voucher,
+			Exponent: 0,
+			Aliases:  []string{denom.Base},
+		}},
+		Base:    voucher,
+		Display: voucher,
+		Name:    denom.Base,
+		Symbol:  strings.ToUpper(denom.Base),
+	}
+}
@@ -0,0 +1,22 @@
+package middleware
+
+import (
+	"strings"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+)
+
+// ReceivedDenom returns the denom under which the tokens of an incoming
+// transfer packet are credited on this chain.
+func ReceivedDenom(packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData) string {
+	sourcePrefix := packet.GetSourcePort() + "/" + packet.GetSourceChannel() + "/"
+	if strings.HasPrefix(data.Denom, sourcePrefix) {
+		// the token is coming back to this chain: strip the hop it was sent through
+		return transfertypes.ExtractDenomFromPath(strings.TrimPrefix(data.Denom, sourcePrefix)).IBCDenom()
+	}
+
+	// the token is received as a voucher minted on this chain
+	destPrefix := packet.GetDestPort() + "/" + packet.GetDestChannel() + "/"
+	return transfertypes.ExtractDenomFromPath(destPrefix + data.Denom).IBCDenom()
+}
@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+var _ porttypes.IBCModule = DustFilter{}
+
+// DustFilterKeeper provides the dust thresholds of incoming transfers.
+type DustFilterKeeper interface {
+	// IBCDustThresholds returns the minimum amounts of incoming transfers,
+	// keyed by the denom received on this chain.
+	IBCDustThresholds(ctx sdk.Context) sdk.Coins
+}
+
+// DustFilter rejects incoming transfers of less than a per-denom minimum
+// amount, refunding the sender on the counterparty chain. Denoms without a
+// threshold are not checked.
+type DustFilter struct {
+	porttypes.IBCModule
+
+	keeper DustFilterKeeper
+}
+
+// NewDustFilter wraps the given transfer stack, rejecting transfers below the
+// thresholds keeper returns.
+func NewDustFilter(app porttypes.IBCModule, keeper DustFilterKeeper) DustFilter {
+	return DustFilter{IBCModule: app, keeper: keeper}
+}
+
+// OnRecvPacket rejects transfers below the threshold of their received denom.
+func (m DustFilter) OnRecvPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	thresholds := m.keeper.IBCDustThresholds(ctx)
+	if thresholds.Empty() {
+		return m.IBCModule.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	}
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(packet.GetData(), &data); err == nil {
+		denom := ReceivedDenom(packet, data)
+		threshold := thresholds.AmountOf(denom)
+		amount, ok := math.NewIntFromString(data.Amount)
+		if ok && amount.LT(threshold) {
+			return channeltypes.NewErrorAcknowledgement(errorsmod.Wrapf(
+				errortypes.ErrInvalidCoins, "transfer of %s%s is below the dust threshold of %s", amount, denom, threshold,
+			))
+		}
+	}
+
+	return m.IBCModule.OnRecvPacket(ctx, channelVersion, packet, relayer)
+}
@@ -0,0 +1,44 @@
+package middleware_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	"github.com/stretchr/testify/require"
+
+	"kudora/app/middleware"
+)
+
+// dustThresholds serves fixed dust thresholds.
+type dustThresholds sdk.Coins
+
+func (d dustThresholds) IBCDustThresholds(sdk.Context) sdk.Coins {
+	return sdk.Coins(d)
+}
+
+func TestDustFilter(t *testing.T) {
+	app := &recordingModule{}
+
+	dataOf := func(amount string) []byte {
+		return transfertypes.NewFungibleTokenPacketData("uatom", amount, "cosmos1sender", "kudo1receiver", "").GetBytes()
+	}
+	voucher := middleware.ReceivedDenom(newIncomingPacket(dataOf("1"), 0), transfertypes.FungibleTokenPacketData{Denom: "uatom"})
+	filter := middleware.NewDustFilter(app, dustThresholds(sdk.NewCoins(sdk.NewInt64Coin(voucher, 1000))))
+
+	// below the threshold
+	ack := filter.OnRecvPacket(sdk.Context{}, transfertypes.V1, newIncomingPacket(dataOf("999"), 1), nil)
+	require.False(t, ack.Success())
+	require.Empty(t, app.received)
+
+	// at the threshold
+	ack = filter.OnRecvPacket(sdk.Context{}, transfertypes.V1, newIncomingPacket(dataOf("1000"), 2), nil)
+	require.True(t, ack.Success())
+	require.Len(t, app.received, 1)
+
+	// denoms without a threshold
+	other := transfertypes.NewFungibleTokenPacketData("uosmo", "1", "cosmos1sender", "kudo1receiver", "").GetBytes()
+	ack = filter.OnRecvPacket(sdk.Context{}, transfertypes.V1, newIncomingPacket(other, 3), nil)
+	require.True(t, ack.Success())
+	require.Len(t, app.received, 2)
+}
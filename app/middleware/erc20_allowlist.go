@@ -0,0 +1,67 @@
+package middleware
+
+import (
+	"encoding/json"
+	"slices"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+var _ porttypes.IBCModule = ERC20Allowlist{}
+
+// ERC20AllowlistKeeper provides the denoms the ERC20 middleware converts.
+type ERC20AllowlistKeeper interface {
+	// ERC20DenomAllowlist returns the received denoms the ERC20 middleware
+	// converts, every denom if it is empty.
+	ERC20DenomAllowlist(ctx sdk.Context) []string
+}
+
+// ERC20Allowlist only lets incoming transfers of allowlisted denoms go through
+// the ERC20 middleware. Transfers of any other denom skip the ERC20 layer and
+// are credited as native coins.
+type ERC20Allowlist struct {
+	// IBCModule is the stack topped by the ERC20 middleware.
+	porttypes.IBCModule
+
+	// native is the same stack without the ERC20 middleware.
+	native porttypes.IBCModule
+	keeper ERC20AllowlistKeeper
+}
+
+// NewERC20Allowlist creates a new ERC20Allowlist. erc20Stack must be the
+// ERC20 middleware wrapping nativeStack.
+func NewERC20Allowlist(erc20Stack, nativeStack porttypes.IBCModule, keeper ERC20AllowlistKeeper) ERC20Allowlist {
+	return ERC20Allowlist{
+		IBCModule: erc20Stack,
+		native:    nativeStack,
+		keeper:    keeper,
+	}
+}
+
+// OnRecvPacket routes the packet through the ERC20 middleware only if the received denom is allowlisted.
+func (m ERC20Allowlist) OnRecvPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	allowed := m.keeper.ERC20DenomAllowlist(ctx)
+	if len(allowed) == 0 {
+		return m.IBCModule.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	}
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
+		return m.IBCModule.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	}
+
+	if !slices.Contains(allowed, ReceivedDenom(packet, data)) {
+		return m.native.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	}
+
+	return m.IBCModule.OnRecvPacket(ctx, channelVersion, packet, relayer)
+}
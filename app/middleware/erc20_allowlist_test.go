@@ -0,0 +1,47 @@
+package middleware_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	"github.com/stretchr/testify/require"
+
+	"kudora/app/middleware"
+)
+
+// erc20Allowlist serves a fixed ERC20 denom allowlist.
+type erc20Allowlist []string
+
+func (l erc20Allowlist) ERC20DenomAllowlist(sdk.Context) []string {
+	return l
+}
+
+func TestERC20AllowlistRoutesByReceivedDenom(t *testing.T) {
+	allowed := transfertypes.ExtractDenomFromPath(testPort + "/" + testChannelID + "/uatom").IBCDenom()
+
+	erc20Stack := &recordingModule{}
+	nativeStack := &recordingModule{}
+	allowlist := middleware.NewERC20Allowlist(erc20Stack, nativeStack, erc20Allowlist{allowed})
+
+	packetFor := func(denom string) []byte {
+		return transfertypes.NewFungibleTokenPacketData(denom, "100", "cosmos1sender", "kudora1receiver", "").GetBytes()
+	}
+
+	// allowlisted denom goes through the ERC20 middleware
+	allowlist.OnRecvPacket(sdk.Context{}, transfertypes.V1, newIncomingPacket(packetFor("uatom"), 1), nil)
+	require.Len(t, erc20Stack.received, 1)
+	require.Empty(t, nativeStack.received)
+
+	// any other denom skips it
+	allowlist.OnRecvPacket(sdk.Context{}, transfertypes.V1, newIncomingPacket(packetFor("uosmo"), 2), nil)
+	require.Len(t, erc20Stack.received, 1)
+	require.Len(t, nativeStack.received, 1)
+	require.Equal(t, uint64(2), nativeStack.received[0].Sequence)
+
+	// an empty allowlist converts every denom
+	allowlist = middleware.NewERC20Allowlist(erc20Stack, nativeStack, erc20Allowlist{})
+	allowlist.OnRecvPacket(sdk.Context{}, transfertypes.V1, newIncomingPacket(packetFor("uosmo"), 3), nil)
+	require.Len(t, erc20Stack.received, 2)
+	require.Len(t, nativeStack.received, 1)
+}
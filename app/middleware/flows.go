@@ -0,0 +1,117 @@
+package middleware
+
+import (
+	"encoding/json"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+var _ porttypes.IBCModule = FlowTracker{}
+
+// TransferFlow is the amount of a denom transferred in and out of this chain
+// over IBC.
+type TransferFlow struct {
+	Inflow  math.Int `json:"inflow"`
+	Outflow math.Int `json:"outflow"`
+}
+
+// FlowTracker accumulates the amounts of every denom successfully received
+// and sent over IBC. Outgoing transfers count once acknowledged, as refunded
+// ones never left the chain.
+type FlowTracker struct {
+	porttypes.IBCModule
+
+	keeper Keeper
+}
+
+// NewFlowTracker wraps the given transfer application.
+func NewFlowTracker(app porttypes.IBCModule, keeper Keeper) FlowTracker {
+	return FlowTracker{
+		IBCModule: app,
+		keeper:    keeper,
+	}
+}
+
+// OnRecvPacket adds the amount of a successfully received transfer to the
+// inflow of its local denom.
+func (t FlowTracker) OnRecvPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	ack := t.IBCModule.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	if !ack.Success() {
+		return ack
+	}
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
+		return ack
+	}
+	if amount, ok := math.NewIntFromString(data.Amount); ok {
+		t.keeper.AddTransferFlow(ctx, ReceivedDenom(packet, data), amount, math.ZeroInt())
+	}
+	return ack
+}
+
+// OnAcknowledgementPacket adds the amount of a successfully acknowledged
+// transfer to the outflow of its local denom.
+func (t FlowTracker) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if err := t.IBCModule.OnAcknowledgementPacket(ctx, channelVersion, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil || !ack.Success() {
+		return nil
+	}
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
+		return nil
+	}
+	if amount, ok := math.NewIntFromString(data.Amount); ok {
+		denom := transfertypes.ExtractDenomFromPath(data.Denom).IBCDenom()
+		t.keeper.AddTransferFlow(ctx, denom, math.ZeroInt(), amount)
+	}
+	return nil
+}
+
+// AddTransferFlow adds to the cumulative inflow and outflow of denom.
+func (k Keeper) AddTransferFlow(ctx sdk.Context, denom string, inflow, outflow math.Int) {
+	flow := k.GetTransferFlow(ctx, denom)
+	flow.Inflow = flow.Inflow.Add(inflow)
+	flow.Outflow = flow.Outflow.Add(outflow)
+
+	bz, err := json.Marshal(flow)
+	if err != nil {
+		panic(err)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), TransferFlowPrefix)
+	store.Set([]byte(denom), bz)
+}
+
+// GetTransferFlow returns the cumulative inflow and outflow of denom.
+func (k Keeper) GetTransferFlow(ctx sdk.Context, denom string) TransferFlow {
+	flow := TransferFlow{Inflow: math.ZeroInt(), Outflow: math.ZeroInt()}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), TransferFlowPrefix)
+	if bz := store.Get([]byte(denom)); bz != nil {
+		_ = json.Unmarshal(bz, &flow)
+	}
+	return flow
+}
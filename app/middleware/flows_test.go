@@ -0,0 +1,56 @@
+package middleware_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	"kudora/app/middleware"
+)
+
+func TestFlowTracker(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(middleware.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	keeper := middleware.NewKeeper(storeKey)
+	tracker := middleware.NewFlowTracker(&recordingModule{}, keeper)
+
+	// two incoming transfers of the same token
+	atom := transfertypes.ExtractDenomFromPath(testPort + "/" + testChannelID + "/uatom").IBCDenom()
+	for sequence, amount := range []string{"100", "250"} {
+		data := transfertypes.NewFungibleTokenPacketData("uatom", amount, "cosmos1sender", "kudo1receiver", "").GetBytes()
+		ack := tracker.OnRecvPacket(ctx, transfertypes.V1, newIncomingPacket(data, uint64(sequence+1)), nil)
+		require.True(t, ack.Success())
+	}
+
+	// an outgoing transfer of part of it, acknowledged successfully
+	outgoing := func(amount string, sequence uint64) channeltypes.Packet {
+		data := transfertypes.NewFungibleTokenPacketData(testPort+"/"+testChannelID+"/uatom", amount, "kudo1sender", "cosmos1receiver", "").GetBytes()
+		return channeltypes.NewPacket(
+			data, sequence,
+			testPort, testChannelID,
+			testPort, testCounterpartyChannelID,
+			clienttypes.ZeroHeight(), 1_000_000_000,
+		)
+	}
+	success := channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()
+	require.NoError(t, tracker.OnAcknowledgementPacket(ctx, transfertypes.V1, outgoing("120", 1), success, nil))
+
+	// a failed one is refunded and doesn't count
+	failure := channeltypes.NewErrorAcknowledgement(transfertypes.ErrReceiveDisabled).Acknowledgement()
+	require.NoError(t, tracker.OnAcknowledgementPacket(ctx, transfertypes.V1, outgoing("30", 2), failure, nil))
+
+	flow := keeper.GetTransferFlow(ctx, atom)
+	require.Equal(t, math.NewInt(350), flow.Inflow)
+	require.Equal(t, math.NewInt(120), flow.Outflow)
+
+	// untouched denoms have no flow
+	flow = keeper.GetTransferFlow(ctx, "kud")
+	require.True(t, flow.Inflow.IsZero())
+	require.True(t, flow.Outflow.IsZero())
+}
@@ -0,0 +1,69 @@
+package middleware
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+// ForwardMemoKey is the memo key carrying packet-forward-middleware instructions.
+const ForwardMemoKey = "forward"
+
+var _ porttypes.IBCModule = ForwardMemoRejecter{}
+
+// PacketForwardParamsKeeper reports whether packet forwarding is disabled.
+type PacketForwardParamsKeeper interface {
+	PacketForwardDisabled(ctx sdk.Context) bool
+}
+
+// ForwardMemoRejecter sits above the packet forward middleware to disable
+// it: while forwarding is disabled, incoming transfers asking to be forwarded
+// are rejected with an error acknowledgement instead of being forwarded.
+type ForwardMemoRejecter struct {
+	porttypes.IBCModule
+
+	paramsKeeper PacketForwardParamsKeeper
+}
+
+// NewForwardMemoRejecter wraps the given packet forward middleware.
+func NewForwardMemoRejecter(app porttypes.IBCModule, paramsKeeper PacketForwardParamsKeeper) ForwardMemoRejecter {
+	return ForwardMemoRejecter{IBCModule: app, paramsKeeper: paramsKeeper}
+}
+
+// OnRecvPacket rejects transfers whose memo carries forwarding instructions
+// while forwarding is disabled.
+func (m ForwardMemoRejecter) OnRecvPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	if !m.paramsKeeper.PacketForwardDisabled(ctx) {
+		return m.IBCModule.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	}
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(packet.GetData(), &data); err == nil && hasForwardMemo(data.Memo) {
+		return channeltypes.NewErrorAcknowledgement(
+			errorsmod.Wrap(errortypes.ErrInvalidRequest, "packet forwarding is disabled on this chain"),
+		)
+	}
+
+	return m.IBCModule.OnRecvPacket(ctx, channelVersion, packet, relayer)
+}
+
+// hasForwardMemo reports whether memo is a JSON object with forwarding instructions.
+func hasForwardMemo(memo string) bool {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &fields); err != nil {
+		return false
+	}
+	_, ok := fields[ForwardMemoKey]
+	return ok
+}
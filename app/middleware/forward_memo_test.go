@@ -0,0 +1,43 @@
+package middleware_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	"github.com/stretchr/testify/require"
+
+	"kudora/app/middleware"
+)
+
+// packetForwardDisabled reports a fixed packet forward setting.
+type packetForwardDisabled bool
+
+func (d packetForwardDisabled) PacketForwardDisabled(sdk.Context) bool {
+	return bool(d)
+}
+
+func TestForwardMemoRejecter(t *testing.T) {
+	app := &recordingModule{}
+	rejecter := middleware.NewForwardMemoRejecter(app, packetForwardDisabled(true))
+
+	forwardMemo := `{"forward":{"receiver":"osmo1receiver","port":"transfer","channel":"channel-1"}}`
+	data := transfertypes.NewFungibleTokenPacketData("uatom", "100", "cosmos1sender", "kudo1receiver", forwardMemo).GetBytes()
+
+	ack := rejecter.OnRecvPacket(sdk.Context{}, transfertypes.V1, newIncomingPacket(data, 1), nil)
+	require.False(t, ack.Success())
+	require.Empty(t, app.received)
+
+	// other memos go through
+	data = transfertypes.NewFungibleTokenPacketData("uatom", "100", "cosmos1sender", "kudo1receiver", `{"note":"hello"}`).GetBytes()
+	ack = rejecter.OnRecvPacket(sdk.Context{}, transfertypes.V1, newIncomingPacket(data, 2), nil)
+	require.True(t, ack.Success())
+	require.Len(t, app.received, 1)
+
+	// forward memos go through while forwarding is enabled
+	rejecter = middleware.NewForwardMemoRejecter(app, packetForwardDisabled(false))
+	data = transfertypes.NewFungibleTokenPacketData("uatom", "100", "cosmos1sender", "kudo1receiver", forwardMemo).GetBytes()
+	ack = rejecter.OnRecvPacket(sdk.Context{}, transfertypes.V1, newIncomingPacket(data, 3), nil)
+	require.True(t, ack.Success())
+	require.Len(t, app.received, 2)
+}
@@ -0,0 +1,73 @@
+package middleware
+
+import (
+	"encoding/json"
+	"time"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+)
+
+// Keeper gives the Kudora transfer middlewares access to their store.
+type Keeper struct {
+	storeKey storetypes.StoreKey
+}
+
+// NewKeeper creates a new middleware Keeper.
+func NewKeeper(storeKey storetypes.StoreKey) Keeper {
+	return Keeper{storeKey: storeKey}
+}
+
+// SetTimedOutTransfer records the packet data of a timed out outgoing transfer.
+func (k Keeper) SetTimedOutTransfer(ctx sdk.Context, portID, channelID string, sequence uint64, data []byte) {
+	key := packetKey(portID, channelID, sequence)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), TimedOutTransferPrefix)
+	store.Set(key, data)
+
+	timeStore := prefix.NewStore(ctx.KVStore(k.storeKey), TimedOutTransferTimePrefix)
+	timeStore.Set(append(sdk.FormatTimeBytes(ctx.BlockTime()), key...), []byte{})
+}
+
+// GetTimedOutTransfer returns the packet data of a timed out outgoing transfer, if recorded.
+func (k Keeper) GetTimedOutTransfer(ctx sdk.Context, portID, channelID string, sequence uint64) (transfertypes.FungibleTokenPacketData, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), TimedOutTransferPrefix)
+	bz := store.Get(packetKey(portID, channelID, sequence))
+	if bz == nil {
+		return transfertypes.FungibleTokenPacketData{}, false
+	}
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(bz, &data); err != nil {
+		return transfertypes.FungibleTokenPacketData{}, false
+	}
+	return data, true
+}
+
+// DeleteTimedOutTransfer removes the record of a timed out outgoing transfer.
+func (k Keeper) DeleteTimedOutTransfer(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), TimedOutTransferPrefix)
+	store.Delete(packetKey(portID, channelID, sequence))
+}
+
+// PruneTimedOutTransfers removes the records of the timed out outgoing
+// transfers recorded before the given time. Resubmitted transfers were
+// already removed, their time index entries are dropped here.
+func (k Keeper) PruneTimedOutTransfers(ctx sdk.Context, before time.Time) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), TimedOutTransferPrefix)
+	timeStore := prefix.NewStore(ctx.KVStore(k.storeKey), TimedOutTransferTimePrefix)
+
+	end := sdk.FormatTimeBytes(before)
+	iterator := timeStore.Iterator(nil, end)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key[len(end):])
+		timeStore.Delete(key)
+	}
+}
@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// StoreKey is the store key holding the state of the Kudora transfer middlewares.
+	StoreKey = "kudoraibc"
+)
+
+var (
+	// TimedOutTransferPrefix indexes the packet data of timed out outgoing transfers.
+	TimedOutTransferPrefix = []byte{0x01}
+	// RateLimitHistoryPrefix indexes the flows of past rate limit windows.
+	RateLimitHistoryPrefix = []byte{0x02}
+	// TransferFlowPrefix indexes the cumulative IBC inflow and outflow of denoms.
+	TransferFlowPrefix = []byte{0x03}
+	// PacketCountPrefix indexes the incoming packet counts of channels in
+	// their current window.
+	PacketCountPrefix = []byte{0x04}
+	// TimedOutTransferTimePrefix indexes the timed out outgoing transfers by
+	// the time they were recorded at, for pruning.
+	TimedOutTransferTimePrefix = []byte{0x05}
+)
+
+// packetKey returns the key suffix identifying a packet by port, channel and sequence.
+func packetKey(portID, channelID string, sequence uint64) []byte {
+	key := []byte(portID + "/" + channelID + "/")
+	return append(key, sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// rateLimitKey returns the key suffix identifying a rate limit. Channel and
+// client IDs can't contain a slash, unlike denoms.
+func rateLimitKey(denom, channelOrClientID string) []byte {
+	return []byte(channelOrClientID + "/" + denom)
+}
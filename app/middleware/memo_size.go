@@ -0,0 +1,58 @@
+package middleware
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+var _ porttypes.IBCModule = MemoSizeLimit{}
+
+// MemoSizeLimitKeeper provides the memo length limit of incoming transfers.
+type MemoSizeLimitKeeper interface {
+	// MaxMemoBytes returns the longest memo accepted, zero if there is no
+	// limit.
+	MaxMemoBytes(ctx sdk.Context) uint64
+}
+
+// MemoSizeLimit rejects incoming transfers whose memo is longer than the
+// configured number of bytes, before any middleware parses it.
+type MemoSizeLimit struct {
+	porttypes.IBCModule
+
+	keeper MemoSizeLimitKeeper
+}
+
+// NewMemoSizeLimit wraps the given transfer stack, allowing memos of at most
+// the length keeper returns.
+func NewMemoSizeLimit(app porttypes.IBCModule, keeper MemoSizeLimitKeeper) MemoSizeLimit {
+	return MemoSizeLimit{IBCModule: app, keeper: keeper}
+}
+
+// OnRecvPacket rejects transfers with an oversized memo.
+func (m MemoSizeLimit) OnRecvPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	maxMemoBytes := m.keeper.MaxMemoBytes(ctx)
+	if maxMemoBytes == 0 {
+		return m.IBCModule.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	}
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(packet.GetData(), &data); err == nil && uint64(len(data.Memo)) > maxMemoBytes {
+		return channeltypes.NewErrorAcknowledgement(errorsmod.Wrapf(
+			errortypes.ErrInvalidRequest, "memo is %d bytes long, maximum allowed is %d", len(data.Memo), maxMemoBytes,
+		))
+	}
+
+	return m.IBCModule.OnRecvPacket(ctx, channelVersion, packet, relayer)
+}
@@ -0,0 +1,44 @@
+package middleware_test
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	"github.com/stretchr/testify/require"
+
+	"kudora/app/middleware"
+)
+
+// maxMemoBytes serves a fixed memo length limit.
+type maxMemoBytes uint64
+
+func (m maxMemoBytes) MaxMemoBytes(sdk.Context) uint64 {
+	return uint64(m)
+}
+
+func TestMemoSizeLimit(t *testing.T) {
+	app := &recordingModule{}
+	limit := middleware.NewMemoSizeLimit(app, maxMemoBytes(64))
+
+	dataWithMemo := func(memo string) []byte {
+		return transfertypes.NewFungibleTokenPacketData("uatom", "100", "cosmos1sender", "kudo1receiver", memo).GetBytes()
+	}
+
+	// over the limit
+	ack := limit.OnRecvPacket(sdk.Context{}, transfertypes.V1, newIncomingPacket(dataWithMemo(strings.Repeat("x", 65)), 1), nil)
+	require.False(t, ack.Success())
+	require.Empty(t, app.received)
+
+	// at the limit
+	ack = limit.OnRecvPacket(sdk.Context{}, transfertypes.V1, newIncomingPacket(dataWithMemo(strings.Repeat("x", 64)), 2), nil)
+	require.True(t, ack.Success())
+	require.Len(t, app.received, 1)
+
+	// a zero limit lets any memo through
+	limit = middleware.NewMemoSizeLimit(app, maxMemoBytes(0))
+	ack = limit.OnRecvPacket(sdk.Context{}, transfertypes.V1, newIncomingPacket(dataWithMemo(strings.Repeat("x", 65)), 3), nil)
+	require.True(t, ack.Success())
+	require.Len(t, app.received, 2)
+}
@@ -0,0 +1,130 @@
+package middleware
+
+import (
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+	metrics "github.com/hashicorp/go-metrics"
+)
+
+// Packet directions used to label the packet counters.
+const (
+	DirectionSend    = "send"
+	DirectionRecv    = "recv"
+	DirectionAck     = "ack"
+	DirectionTimeout = "timeout"
+)
+
+// PacketsMetricKey is the key of the counter incremented for every packet
+// going through PacketMetrics, labeled by channel and direction.
+var PacketsMetricKey = []string{"kudora", "ibc", "packets"}
+
+var (
+	_ porttypes.IBCModule   = PacketMetrics{}
+	_ porttypes.ICS4Wrapper = PacketMetrics{}
+)
+
+// PacketMetrics counts the packets sent, received, acknowledged and timed
+// out on each channel. Outgoing packets are only seen if it is also set as
+// the ICS4Wrapper of the sending keeper.
+type PacketMetrics struct {
+	porttypes.IBCModule
+
+	ics4Wrapper porttypes.ICS4Wrapper
+}
+
+// NewPacketMetrics wraps the given application and ICS4Wrapper.
+func NewPacketMetrics(app porttypes.IBCModule, ics4Wrapper porttypes.ICS4Wrapper) PacketMetrics {
+	return PacketMetrics{
+		IBCModule:   app,
+		ics4Wrapper: ics4Wrapper,
+	}
+}
+
+// OnRecvPacket counts the packet on its destination channel.
+func (m PacketMetrics) OnRecvPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	incrPacketCounter(packet.GetDestChannel(), DirectionRecv)
+	return m.IBCModule.OnRecvPacket(ctx, channelVersion, packet, relayer)
+}
+
+// OnAcknowledgementPacket counts the packet on its source channel.
+func (m PacketMetrics) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if err := m.IBCModule.OnAcknowledgementPacket(ctx, channelVersion, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	incrPacketCounter(packet.GetSourceChannel(), DirectionAck)
+	return nil
+}
+
+// OnTimeoutPacket counts the packet on its source channel.
+func (m PacketMetrics) OnTimeoutPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	if err := m.IBCModule.OnTimeoutPacket(ctx, channelVersion, packet, relayer); err != nil {
+		return err
+	}
+
+	incrPacketCounter(packet.GetSourceChannel(), DirectionTimeout)
+	return nil
+}
+
+// SendPacket implements porttypes.ICS4Wrapper and counts the packet once it has been sent.
+func (m PacketMetrics) SendPacket(
+	ctx sdk.Context,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	sequence, err := m.ics4Wrapper.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	if err != nil {
+		return 0, err
+	}
+
+	incrPacketCounter(sourceChannel, DirectionSend)
+	return sequence, nil
+}
+
+// WriteAcknowledgement implements porttypes.ICS4Wrapper.
+func (m PacketMetrics) WriteAcknowledgement(
+	ctx sdk.Context,
+	packet ibcexported.PacketI,
+	ack ibcexported.Acknowledgement,
+) error {
+	return m.ics4Wrapper.WriteAcknowledgement(ctx, packet, ack)
+}
+
+// GetAppVersion implements porttypes.ICS4Wrapper.
+func (m PacketMetrics) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return m.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
+
+func incrPacketCounter(channelID, direction string) {
+	telemetry.IncrCounterWithLabels(
+		PacketsMetricKey,
+		1,
+		[]metrics.Label{
+			telemetry.NewLabel("channel", channelID),
+			telemetry.NewLabel("direction", direction),
+		},
+	)
+}
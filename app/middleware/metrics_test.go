@@ -0,0 +1,75 @@
+package middleware_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	metrics "github.com/hashicorp/go-metrics"
+	"github.com/stretchr/testify/require"
+
+	"kudora/app/middleware"
+)
+
+// setupMetricsSink enables telemetry and returns the in-memory sink collecting the metrics.
+func setupMetricsSink(t *testing.T) *metrics.InmemSink {
+	t.Helper()
+
+	_, err := telemetry.New(telemetry.Config{Enabled: true})
+	require.NoError(t, err)
+
+	sink := metrics.NewInmemSink(time.Hour, time.Hour)
+	cfg := metrics.DefaultConfig("")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	_, err = metrics.NewGlobal(cfg, sink)
+	require.NoError(t, err)
+
+	return sink
+}
+
+// packetCount sums the packet counter for the given channel and direction.
+func packetCount(sink *metrics.InmemSink, channelID, direction string) float64 {
+	var total float64
+	for _, interval := range sink.Data() {
+		for _, counter := range interval.Counters {
+			if counter.Name != "kudora.ibc.packets" {
+				continue
+			}
+
+			labels := make(map[string]string, len(counter.Labels))
+			for _, label := range counter.Labels {
+				labels[label.Name] = label.Value
+			}
+			if labels["channel"] == channelID && labels["direction"] == direction {
+				total += counter.Sum
+			}
+		}
+	}
+	return total
+}
+
+func TestPacketMetricsCountsSendAndRecv(t *testing.T) {
+	sink := setupMetricsSink(t)
+
+	app := &recordingModule{}
+	ics4 := &recordingICS4Wrapper{}
+	packetMetrics := middleware.NewPacketMetrics(app, ics4)
+
+	data := transfertypes.NewFungibleTokenPacketData("uatom", "100", "cosmos1sender", "kudora1receiver", "").GetBytes()
+
+	sequence, err := packetMetrics.SendPacket(sdk.Context{}, testPort, testChannelID, clienttypes.ZeroHeight(), 1_000_000_000, data)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), sequence)
+	require.Equal(t, float64(1), packetCount(sink, testChannelID, middleware.DirectionSend))
+	require.Zero(t, packetCount(sink, testChannelID, middleware.DirectionRecv))
+
+	packetMetrics.OnRecvPacket(sdk.Context{}, transfertypes.V1, newIncomingPacket(data, 1), nil)
+	packetMetrics.OnRecvPacket(sdk.Context{}, transfertypes.V1, newIncomingPacket(data, 2), nil)
+	require.Len(t, app.received, 2)
+	require.Equal(t, float64(2), packetCount(sink, testChannelID, middleware.DirectionRecv))
+	require.Equal(t, float64(1), packetCount(sink, testChannelID, middleware.DirectionSend))
+}
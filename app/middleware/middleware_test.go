@@ -0,0 +1,95 @@
+package middleware_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcapi "github.com/cosmos/ibc-go/v10/modules/core/api"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+const (
+	testPort                  = "transfer"
+	testChannelID             = "channel-0"
+	testCounterpartyChannelID = "channel-7"
+)
+
+// recordingModule is an IBC application recording the packets it receives.
+// Callbacks it doesn't override panic.
+type recordingModule struct {
+	porttypes.IBCModule
+
+	received []channeltypes.Packet
+}
+
+func (m *recordingModule) OnRecvPacket(
+	_ sdk.Context,
+	_ string,
+	packet channeltypes.Packet,
+	_ sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	m.received = append(m.received, packet)
+	return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+}
+
+// recordingModuleV2 is an IBC v2 application recording the payloads it
+// receives. Callbacks it doesn't override panic.
+type recordingModuleV2 struct {
+	ibcapi.IBCModule
+
+	received []channeltypesv2.Payload
+}
+
+func (m *recordingModuleV2) OnRecvPacket(
+	_ sdk.Context,
+	_ string,
+	_ string,
+	_ uint64,
+	payload channeltypesv2.Payload,
+	_ sdk.AccAddress,
+) channeltypesv2.RecvPacketResult {
+	m.received = append(m.received, payload)
+	return channeltypesv2.RecvPacketResult{Status: channeltypesv2.PacketStatus_Success, Acknowledgement: []byte{byte(1)}}
+}
+
+// newIncomingPacket returns a packet sent to this chain by the counterparty of testChannelID.
+func newIncomingPacket(data []byte, sequence uint64) channeltypes.Packet {
+	return channeltypes.NewPacket(
+		data, sequence,
+		testPort, testCounterpartyChannelID,
+		testPort, testChannelID,
+		clienttypes.ZeroHeight(), 1_000_000_000,
+	)
+}
+
+// recordingICS4Wrapper is an ICS4Wrapper handing out increasing sequences.
+// Methods it doesn't override panic.
+type recordingICS4Wrapper struct {
+	porttypes.ICS4Wrapper
+
+	sequence uint64
+}
+
+func (w *recordingICS4Wrapper) SendPacket(
+	_ sdk.Context,
+	_ string,
+	_ string,
+	_ clienttypes.Height,
+	_ uint64,
+	_ []byte,
+) (uint64, error) {
+	w.sequence++
+	return w.sequence, nil
+}
+
+func (m *recordingModule) OnAcknowledgementPacket(
+	_ sdk.Context,
+	_ string,
+	_ channeltypes.Packet,
+	_ []byte,
+	_ sdk.AccAddress,
+) error {
+	return nil
+}
@@ -0,0 +1,104 @@
+package middleware
+
+import (
+	"encoding/json"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+var _ porttypes.IBCModule = PacketCountLimit{}
+
+// PacketCount is the number of packets received on a channel in the window
+// starting at WindowStart.
+type PacketCount struct {
+	WindowStart time.Time `json:"window_start"`
+	Count       uint64    `json:"count"`
+}
+
+// PacketCountLimitKeeper provides the packet count limits of the channels.
+type PacketCountLimitKeeper interface {
+	// GetPacketCountLimit returns the number of packets channelID accepts
+	// per window, and the window, if the channel is limited.
+	GetPacketCountLimit(ctx sdk.Context, channelID string) (maxPackets uint64, window time.Duration, found bool)
+}
+
+// PacketCountLimit caps the number of packets received on each limited
+// channel per window of block time, to mitigate packet floods. Windows are
+// aligned on multiples of their duration. Packets over the limit are
+// rejected, refunding their sender, and don't count.
+type PacketCountLimit struct {
+	porttypes.IBCModule
+
+	keeper       Keeper
+	limitsKeeper PacketCountLimitKeeper
+}
+
+// NewPacketCountLimit wraps the given transfer stack, accepting per window
+// at most the packets limitsKeeper allows on each channel.
+func NewPacketCountLimit(app porttypes.IBCModule, keeper Keeper, limitsKeeper PacketCountLimitKeeper) PacketCountLimit {
+	return PacketCountLimit{
+		IBCModule:    app,
+		keeper:       keeper,
+		limitsKeeper: limitsKeeper,
+	}
+}
+
+// OnRecvPacket rejects packets once the limit of their channel is reached in
+// the current window.
+func (m PacketCountLimit) OnRecvPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	channelID := packet.GetDestChannel()
+	limit, window, ok := m.limitsKeeper.GetPacketCountLimit(ctx, channelID)
+	if !ok {
+		return m.IBCModule.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	}
+
+	windowStart := ctx.BlockTime().Truncate(window)
+	count := m.keeper.GetPacketCount(ctx, channelID)
+	if !count.WindowStart.Equal(windowStart) {
+		count = PacketCount{WindowStart: windowStart}
+	}
+	if count.Count >= limit {
+		return channeltypes.NewErrorAcknowledgement(errorsmod.Wrapf(
+			channeltypes.ErrInvalidPacket, "channel %s received its limit of %d packets since %s",
+			channelID, limit, windowStart.UTC().Format(time.RFC3339),
+		))
+	}
+
+	count.Count++
+	m.keeper.SetPacketCount(ctx, channelID, count)
+	return m.IBCModule.OnRecvPacket(ctx, channelVersion, packet, relayer)
+}
+
+// SetPacketCount records the packet count of a channel in its current window.
+func (k Keeper) SetPacketCount(ctx sdk.Context, channelID string, count PacketCount) {
+	bz, err := json.Marshal(count)
+	if err != nil {
+		panic(err)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), PacketCountPrefix)
+	store.Set([]byte(channelID), bz)
+}
+
+// GetPacketCount returns the packet count of a channel in the last window it
+// received a packet in.
+func (k Keeper) GetPacketCount(ctx sdk.Context, channelID string) PacketCount {
+	var count PacketCount
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), PacketCountPrefix)
+	if bz := store.Get([]byte(channelID)); bz != nil {
+		_ = json.Unmarshal(bz, &count)
+	}
+	return count
+}
@@ -0,0 +1,55 @@
+package middleware_test
+
+import (
+	"testing"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	"github.com/stretchr/testify/require"
+
+	"kudora/app/middleware"
+)
+
+// packetCountLimits limits channels to a number of packets per hour.
+type packetCountLimits map[string]uint64
+
+func (l packetCountLimits) GetPacketCountLimit(_ sdk.Context, channelID string) (uint64, time.Duration, bool) {
+	limit, ok := l[channelID]
+	return limit, time.Hour, ok
+}
+
+func TestPacketCountLimit(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(middleware.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	ctx = ctx.WithBlockTime(time.Date(2025, 1, 1, 10, 15, 0, 0, time.UTC))
+
+	app := &recordingModule{}
+	limiter := middleware.NewPacketCountLimit(app, middleware.NewKeeper(storeKey), packetCountLimits{testChannelID: 2})
+	data := transfertypes.NewFungibleTokenPacketData("uatom", "100", "cosmos1sender", "kudo1receiver", "").GetBytes()
+
+	// up to the limit
+	for sequence := uint64(1); sequence <= 2; sequence++ {
+		ack := limiter.OnRecvPacket(ctx, transfertypes.V1, newIncomingPacket(data, sequence), nil)
+		require.True(t, ack.Success())
+	}
+
+	// over the limit in the same window
+	ack := limiter.OnRecvPacket(ctx.WithBlockTime(ctx.BlockTime().Add(30*time.Minute)), transfertypes.V1, newIncomingPacket(data, 3), nil)
+	require.False(t, ack.Success())
+	require.Len(t, app.received, 2)
+
+	// the next window starts over
+	ack = limiter.OnRecvPacket(ctx.WithBlockTime(ctx.BlockTime().Add(45*time.Minute)), transfertypes.V1, newIncomingPacket(data, 4), nil)
+	require.True(t, ack.Success())
+	require.Len(t, app.received, 3)
+
+	// other channels are not limited
+	packet := newIncomingPacket(data, 5)
+	packet.DestinationChannel = "channel-7"
+	for i := 0; i < 3; i++ {
+		require.True(t, limiter.OnRecvPacket(ctx, transfertypes.V1, packet, nil).Success())
+	}
+}
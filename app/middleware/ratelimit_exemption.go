@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"encoding/json"
+	"slices"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+var _ porttypes.IBCModule = RateLimitExemption{}
+
+// RateLimitExemptionKeeper provides the denoms exempt from the rate limits.
+type RateLimitExemptionKeeper interface {
+	RateLimitExemptDenoms(ctx sdk.Context) []string
+}
+
+// RateLimitExemption lets incoming transfers of exempt denoms skip the rate
+// limit middleware, so they are neither checked against nor counted in the
+// channel quotas.
+type RateLimitExemption struct {
+	// IBCModule is the stack topped by the rate limit middleware.
+	porttypes.IBCModule
+
+	// unlimited is the same stack without the rate limit middleware.
+	unlimited porttypes.IBCModule
+	keeper    RateLimitExemptionKeeper
+}
+
+// NewRateLimitExemption creates a new RateLimitExemption. rateLimitStack
+// must be the rate limit middleware wrapping unlimitedStack.
+func NewRateLimitExemption(rateLimitStack, unlimitedStack porttypes.IBCModule, keeper RateLimitExemptionKeeper) RateLimitExemption {
+	return RateLimitExemption{
+		IBCModule: rateLimitStack,
+		unlimited: unlimitedStack,
+		keeper:    keeper,
+	}
+}
+
+// OnRecvPacket bypasses the rate limit middleware if the received denom is exempt.
+func (m RateLimitExemption) OnRecvPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	exempt := m.keeper.RateLimitExemptDenoms(ctx)
+	if len(exempt) == 0 {
+		return m.IBCModule.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	}
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
+		return m.IBCModule.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	}
+
+	if slices.Contains(exempt, ReceivedDenom(packet, data)) {
+		return m.unlimited.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	}
+
+	return m.IBCModule.OnRecvPacket(ctx, channelVersion, packet, relayer)
+}
@@ -0,0 +1,73 @@
+package middleware_test
+
+import (
+	"errors"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+	"github.com/stretchr/testify/require"
+
+	"kudora/app/middleware"
+)
+
+// quotaModule mimics the rate limit middleware: it rejects incoming
+// transfers above its quota and passes the others to the wrapped app.
+type quotaModule struct {
+	porttypes.IBCModule
+
+	quota math.Int
+}
+
+func (m quotaModule) OnRecvPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	amount, ok := math.NewIntFromString(data.Amount)
+	if !ok || amount.GT(m.quota) {
+		return channeltypes.NewErrorAcknowledgement(errors.New("quota exceeded"))
+	}
+
+	return m.IBCModule.OnRecvPacket(ctx, channelVersion, packet, relayer)
+}
+
+// rateLimitExemptDenoms serves fixed rate limit exempt denoms.
+type rateLimitExemptDenoms []string
+
+func (d rateLimitExemptDenoms) RateLimitExemptDenoms(sdk.Context) []string {
+	return d
+}
+
+func TestRateLimitExemptDenomBypassesQuota(t *testing.T) {
+	exempt := transfertypes.ExtractDenomFromPath(testPort + "/" + testChannelID + "/uatom").IBCDenom()
+
+	app := &recordingModule{}
+	rateLimitStack := quotaModule{IBCModule: app, quota: math.NewInt(1_000)}
+	exemption := middleware.NewRateLimitExemption(rateLimitStack, app, rateLimitExemptDenoms{exempt})
+
+	packetFor := func(denom, amount string) []byte {
+		return transfertypes.NewFungibleTokenPacketData(denom, amount, "cosmos1sender", "kudora1receiver", "").GetBytes()
+	}
+
+	// a transfer above the quota of a denom that isn't exempt is rejected
+	ack := exemption.OnRecvPacket(sdk.Context{}, transfertypes.V1, newIncomingPacket(packetFor("uosmo", "5000"), 1), nil)
+	require.False(t, ack.Success())
+	require.Empty(t, app.received)
+
+	// the exempt denom goes through regardless of the quota
+	ack = exemption.OnRecvPacket(sdk.Context{}, transfertypes.V1, newIncomingPacket(packetFor("uatom", "5000"), 2), nil)
+	require.True(t, ack.Success())
+	require.Len(t, app.received, 1)
+	require.Equal(t, uint64(2), app.received[0].Sequence)
+}
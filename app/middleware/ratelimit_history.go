@@ -0,0 +1,58 @@
+package middleware
+
+import (
+	"encoding/json"
+	"time"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RateLimitWindow is the flow of a rate limit over one of its past windows.
+type RateLimitWindow struct {
+	// End is the time the window ended and the flow was reset.
+	End          time.Time `json:"end"`
+	Inflow       math.Int  `json:"inflow"`
+	Outflow      math.Int  `json:"outflow"`
+	ChannelValue math.Int  `json:"channel_value"`
+}
+
+// AppendRateLimitWindow records a past window of the rate limit of denom on
+// channelOrClientID, keeping only the maxWindows most recent ones.
+func (k Keeper) AppendRateLimitWindow(
+	ctx sdk.Context,
+	denom, channelOrClientID string,
+	window RateLimitWindow,
+	maxWindows uint64,
+) error {
+	windows := append(k.GetRateLimitWindows(ctx, denom, channelOrClientID), window)
+	if uint64(len(windows)) > maxWindows {
+		windows = windows[uint64(len(windows))-maxWindows:]
+	}
+
+	bz, err := json.Marshal(windows)
+	if err != nil {
+		return err
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), RateLimitHistoryPrefix)
+	store.Set(rateLimitKey(denom, channelOrClientID), bz)
+	return nil
+}
+
+// GetRateLimitWindows returns the recorded past windows of the rate limit of
+// denom on channelOrClientID, oldest first.
+func (k Keeper) GetRateLimitWindows(ctx sdk.Context, denom, channelOrClientID string) []RateLimitWindow {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), RateLimitHistoryPrefix)
+	bz := store.Get(rateLimitKey(denom, channelOrClientID))
+	if bz == nil {
+		return nil
+	}
+
+	var windows []RateLimitWindow
+	if err := json.Unmarshal(bz, &windows); err != nil {
+		return nil
+	}
+	return windows
+}
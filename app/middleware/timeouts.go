@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+)
+
+var _ porttypes.IBCModule = TimeoutRecorder{}
+
+// TimeoutRecorder records the packet data of outgoing transfers once they
+// have timed out and been refunded, so they can be resubmitted later on.
+type TimeoutRecorder struct {
+	porttypes.IBCModule
+
+	keeper Keeper
+}
+
+// NewTimeoutRecorder wraps the given transfer application.
+func NewTimeoutRecorder(app porttypes.IBCModule, keeper Keeper) TimeoutRecorder {
+	return TimeoutRecorder{
+		IBCModule: app,
+		keeper:    keeper,
+	}
+}
+
+// OnTimeoutPacket refunds the transfer through the wrapped application and records it.
+func (r TimeoutRecorder) OnTimeoutPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	if err := r.IBCModule.OnTimeoutPacket(ctx, channelVersion, packet, relayer); err != nil {
+		return err
+	}
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
+		// not a transfer packet, nothing to record
+		return nil
+	}
+
+	r.keeper.SetTimedOutTransfer(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), packet.GetData())
+	return nil
+}
@@ -0,0 +1,55 @@
+package app
+
+import (
+	"cosmossdk.io/depinject"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"google.golang.org/grpc"
+
+	kudoratypes "kudora/x/kudora/types"
+)
+
+// wrappingConfigurator hands out a msg server registrar passing the msg
+// servers a module registers through wrap, so that a module wrapper can
+// enforce Kudora's limits on every message, whether it comes from a tx, authz,
+// x/group, an ICA host or a contract.
+type wrappingConfigurator struct {
+	module.Configurator
+
+	wrap func(impl interface{}) interface{}
+}
+
+// MsgServer implements module.Configurator.
+func (c wrappingConfigurator) MsgServer() gogogrpc.Server {
+	return wrappingMsgServerRegistrar{
+		Server: c.Configurator.MsgServer(),
+		wrap:   c.wrap,
+	}
+}
+
+type wrappingMsgServerRegistrar struct {
+	gogogrpc.Server
+
+	wrap func(impl interface{}) interface{}
+}
+
+// RegisterService implements gogogrpc.Server.
+func (r wrappingMsgServerRegistrar) RegisterService(sd *grpc.ServiceDesc, impl interface{}) {
+	r.Server.RegisterService(sd, r.wrap(impl))
+}
+
+// KudoraParamsReader reads the Kudora params. The KudoraParamsKeeper is only
+// created once the depinject modules are built, which read it through this.
+type KudoraParamsReader interface {
+	GetParams(ctx sdk.Context) kudoratypes.Params
+}
+
+// kudoraParamsInputs are the Kudora params read by the wrapped depinject
+// modules. The client-side app config doesn't supply them, the modules then
+// only serve as module basics.
+type kudoraParamsInputs struct {
+	depinject.In
+
+	Params KudoraParamsReader `optional:"true"`
+}
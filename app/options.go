@@ -0,0 +1,90 @@
+package app
+
+import (
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+// Kudora-specific application options. They are read from the [kudora]
+// section of app.toml (or the matching command line flags) when the app is
+// constructed, on top of the upstream SDK, EVM and wasm settings.
+const (
+	// FlagMaxTxSigners caps the number of distinct signers of a Cosmos
+	// transaction accepted into the node's mempool, below the max_tx_signers
+	// Kudora param enforced in blocks. Zero (the default) disables the cap.
+	FlagMaxTxSigners = "kudora.max-tx-signers"
+
+	// FlagIBCEscrowInvariant checks at the end of every block that the IBC
+	// transfer escrow accounts match the escrowed amounts tracked by the
+	// transfer module, logging an error when they don't.
+	FlagIBCEscrowInvariant = "kudora.ibc-escrow-invariant"
+
+	// FlagEVMReplacementPriceBump is the minimum gas price increase, in
+	// percent, for an EVM tx to replace a pending one with the same nonce.
+	// Zero (the default) disables the check.
+	FlagEVMReplacementPriceBump = "kudora.evm-replacement-price-bump"
+
+	// FlagEVMReadOnly makes the node reject EVM transactions submitted to its
+	// mempool while still serving eth_call and the other EVM queries, for
+	// dedicated query nodes. Transactions in blocks are executed as usual.
+	FlagEVMReadOnly = "kudora.evm-read-only"
+
+	// FlagMinWasmGasLimit keeps out of the node's mempool transactions
+	// executing or instantiating contracts with a gas limit below this value.
+	// Zero (the default) disables the check.
+	FlagMinWasmGasLimit = "kudora.min-wasm-gas-limit"
+
+	// FlagRejectUnfundedAccounts keeps out of the node's mempool Cosmos
+	// transactions signed by accounts that have never been funded nor signed
+	// a transaction before.
+	FlagRejectUnfundedAccounts = "kudora.reject-unfunded-accounts"
+
+	// FlagRejectSelfTransfers keeps out of the node's mempool MsgSend and
+	// MsgMultiSend transfers to their own sender, which change nothing but
+	// still use block space.
+	FlagRejectSelfTransfers = "kudora.reject-self-transfers"
+
+	// FlagSimulationGasAdjustment multiplies the gas used reported by tx
+	// simulations, so that clients can use it as gas limit without their own
+	// adjustment. Zero (the default) reports the simulated gas as is.
+	FlagSimulationGasAdjustment = "kudora.simulation-gas-adjustment"
+
+	// FlagRejectNoOpEVMTxs keeps out of the node's mempool EVM transactions
+	// that call an address without data nor value, as they only waste block
+	// space.
+	FlagRejectNoOpEVMTxs = "kudora.reject-noop-evm-txs"
+
+	// FlagMinEVMValueTransfer is the minimum value, in the EVM denom base
+	// unit, of EVM transactions sending value to an address without data
+	// accepted into the node's mempool. Empty (the default) disables the
+	// check.
+	FlagMinEVMValueTransfer = "kudora.min-evm-value-transfer"
+
+	// FlagFeeMarketPriorityTip is the tip per gas added to the base fee by
+	// SuggestedGasPrice, as a decimal amount of the base denom. Zero (the
+	// default) suggests the base fee alone.
+	FlagFeeMarketPriorityTip = "kudora.feemarket-priority-tip"
+
+	// FlagMinPeers rejects new transactions submitted to the node's mempool
+	// while the node has fewer CometBFT peers, as it would likely build on
+	// orphaned state. Blocks are executed as usual. Zero (the default) never
+	// rejects them.
+	FlagMinPeers = "kudora.min-peers"
+)
+
+// loadKudoraOptions reads the options used outside of module and ante
+// handler construction: by the block hooks, the node and by simulations.
+func (app *App) loadKudoraOptions(appOpts servertypes.AppOptions) error {
+	var err error
+
+	app.checkIBCEscrowInvariant = cast.ToBool(appOpts.Get(FlagIBCEscrowInvariant))
+	app.minPeers = cast.ToInt(appOpts.Get(FlagMinPeers))
+
+	if app.simulationGasAdjustment, err = simulationGasAdjustment(appOpts); err != nil {
+		return err
+	}
+	if app.feeMarketPriorityTip, err = feeMarketPriorityTip(appOpts); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// peerCountPollInterval is how often the peer count of the node is checked
+// against FlagMinPeers.
+const peerCountPollInterval = 10 * time.Second
+
+// netInfoClient is implemented by the CometBFT clients reporting the peers of
+// the node, such as the in-process client of a running node.
+type netInfoClient interface {
+	NetInfo(ctx context.Context) (*coretypes.ResultNetInfo, error)
+}
+
+// RegisterTendermintService implements the Application.RegisterTendermintService
+// method, also watching the peer count of the node when FlagMinPeers is set.
+// It is called once the CometBFT node is running.
+func (app *App) RegisterTendermintService(clientCtx client.Context) {
+	app.App.RegisterTendermintService(clientCtx)
+
+	if app.minPeers <= 0 {
+		return
+	}
+	netInfo, ok := clientCtx.Client.(netInfoClient)
+	if !ok {
+		app.Logger().Error("cannot watch the peer count, the CometBFT client does not report it", "flag", FlagMinPeers)
+		return
+	}
+	go app.watchPeerCount(netInfo, time.NewTicker(peerCountPollInterval).C)
+}
+
+// watchPeerCount checks the peer count of the node on every tick.
+func (app *App) watchPeerCount(netInfo netInfoClient, ticks <-chan time.Time) {
+	app.checkPeerCount(netInfo)
+	for range ticks {
+		app.checkPeerCount(netInfo)
+	}
+}
+
+// checkPeerCount puts the node in the mode rejecting all new transactions
+// while it has fewer peers than FlagMinPeers, and takes it out once it has
+// enough again. The state is left as is when the peer count is unknown.
+func (app *App) checkPeerCount(netInfo netInfoClient) {
+	info, err := netInfo.NetInfo(context.Background())
+	if err != nil {
+		app.Logger().Error("failed to get the peer count", "error", err)
+		return
+	}
+
+	rejectAll := info.NPeers < app.minPeers
+	if rejectAll != app.anteOptions.TxGate.RejectAll() {
+		app.Logger().Info("peer count crossed the minimum", "peers", info.NPeers, "min", app.minPeers, "reject_all_txs", rejectAll)
+	}
+	app.SetRejectAllTxs(rejectAll)
+}
@@ -0,0 +1,98 @@
+package app
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ratelimittypes "github.com/cosmos/ibc-apps/modules/rate-limiting/v10/types"
+
+	"kudora/app/middleware"
+)
+
+// rateLimitHistoryDepth is the number of past windows whose flows are kept
+// for each IBC rate limit.
+const rateLimitHistoryDepth = 24
+
+// recordRateLimitWindows records the flows of the rate limits that the rate
+// limit module is about to reset. It must run before the rate limit module
+// begin blocker, which starts the next hour epoch and resets the rate limits
+// whose window ends with it.
+func (app *App) recordRateLimitWindows(ctx sdk.Context) error {
+	// mirrors the epoch check of the rate limit module
+	epoch := app.RateLimitKeeper.GetHourEpoch(ctx)
+	end := epoch.EpochStartTime.Add(epoch.Duration)
+	if !ctx.BlockTime().After(end) {
+		return nil
+	}
+	epochNumber := epoch.EpochNumber + 1
+
+	for _, rateLimit := range app.RateLimitKeeper.GetAllRateLimits(ctx) {
+		duration := rateLimit.Quota.DurationHours
+		if duration == 0 || epochNumber%duration != 0 || rateLimit.Flow == nil {
+			continue
+		}
+
+		window := middleware.RateLimitWindow{
+			End:          end,
+			Inflow:       rateLimit.Flow.Inflow,
+			Outflow:      rateLimit.Flow.Outflow,
+			ChannelValue: rateLimit.Flow.ChannelValue,
+		}
+		path := rateLimit.Path
+		if err := app.IBCMiddlewareKeeper.AppendRateLimitWindow(
+			ctx, path.Denom, path.ChannelOrClientId, window, rateLimitHistoryDepth,
+		); err != nil {
+			return fmt.Errorf("failed to record rate limit window of %s on %s: %w", path.Denom, path.ChannelOrClientId, err)
+		}
+	}
+	return nil
+}
+
+// IBCTransferFlow returns the cumulative amounts of denom received and sent
+// over IBC since the flow tracking was added.
+func (app *App) IBCTransferFlow(ctx sdk.Context, denom string) middleware.TransferFlow {
+	return app.IBCMiddlewareKeeper.GetTransferFlow(ctx, denom)
+}
+
+// RateLimitStatus is an IBC rate limit, with its quota and the flow of its
+// current window, and the time left until that window resets.
+type RateLimitStatus struct {
+	ratelimittypes.RateLimit
+
+	// ResetsIn is the time until the first epoch of the next window starts,
+	// zero if it is due. The flow is reset in the first block after it.
+	ResetsIn time.Duration
+}
+
+// RateLimits returns the status of every configured IBC rate limit, ordered
+// by denom and channel or client ID.
+func (app *App) RateLimits(ctx sdk.Context) []RateLimitStatus {
+	epoch := app.RateLimitKeeper.GetHourEpoch(ctx)
+
+	var statuses []RateLimitStatus
+	for _, rateLimit := range app.RateLimitKeeper.GetAllRateLimits(ctx) {
+		status := RateLimitStatus{RateLimit: rateLimit}
+		if rateLimit.Quota != nil && rateLimit.Quota.DurationHours > 0 {
+			// mirrors the epoch check of the rate limit module, which resets
+			// the flow when an epoch number multiple of the duration starts
+			duration := rateLimit.Quota.DurationHours
+			next := (epoch.EpochNumber/duration + 1) * duration
+			reset := epoch.EpochStartTime.Add(time.Duration(next-epoch.EpochNumber) * epoch.Duration)
+			status.ResetsIn = max(reset.Sub(ctx.BlockTime()), 0)
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// RateLimitFlowHistory returns the flows of up to the last n windows of the
+// rate limit of denom on channelOrClientID, oldest first. The last
+// rateLimitHistoryDepth windows are kept.
+func (app *App) RateLimitFlowHistory(ctx sdk.Context, denom, channelOrClientID string, n int) []middleware.RateLimitWindow {
+	windows := app.IBCMiddlewareKeeper.GetRateLimitWindows(ctx, denom, channelOrClientID)
+	if n >= 0 && len(windows) > n {
+		windows = windows[len(windows)-n:]
+	}
+	return windows
+}
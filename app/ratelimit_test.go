@@ -1,15 +1,22 @@
 package app
 
 import (
+	"slices"
 	"testing"
+	"time"
 
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/std"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/stretchr/testify/require"
 
 	ratelimittypes "github.com/cosmos/ibc-apps/modules/rate-limiting/v10/types"
+
+	kudoratypes "kudora/x/kudora/types"
 )
 
 const msgAddRateLimitJSON = `{
@@ -72,3 +79,143 @@ func TestRegisterRateLimit_RegistersInterfacesForJSONDecoding(t *testing.T) {
 	require.True(t, ok)
 	require.Equal(t, "kud", unpacked.Denom)
 }
+
+func TestRateLimitFlowHistory(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).CacheContext()
+	setupTestTransferChannel(t, app, ctx)
+	fundTestAccount(t, app, ctx, sdk.AccAddress([]byte("ratelimit_history___")), sdk.NewCoins(sdk.NewCoin(BaseDenom, math.NewInt(1000))))
+
+	require.NoError(t, app.RateLimitKeeper.AddRateLimit(ctx, &ratelimittypes.MsgAddRateLimit{
+		Authority:         authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Denom:             BaseDenom,
+		ChannelOrClientId: testChannelID,
+		MaxPercentSend:    math.NewInt(10),
+		MaxPercentRecv:    math.NewInt(10),
+		DurationHours:     1,
+	}))
+
+	// runs a window with the given flows up to the start of the next epoch
+	runWindow := func(epochNumber uint64, inflow, outflow int64) time.Time {
+		rateLimit, found := app.RateLimitKeeper.GetRateLimit(ctx, BaseDenom, testChannelID)
+		require.True(t, found)
+		rateLimit.Flow.Inflow = math.NewInt(inflow)
+		rateLimit.Flow.Outflow = math.NewInt(outflow)
+		app.RateLimitKeeper.SetRateLimit(ctx, rateLimit)
+
+		start := testBlockTime.Add(time.Duration(epochNumber) * time.Hour)
+		app.RateLimitKeeper.SetHourEpoch(ctx, ratelimittypes.HourEpoch{
+			EpochNumber:    epochNumber,
+			Duration:       time.Hour,
+			EpochStartTime: start,
+		})
+		require.NoError(t, app.recordRateLimitWindows(ctx.WithBlockTime(start.Add(time.Hour+time.Second))))
+		return start.Add(time.Hour)
+	}
+
+	// the epoch has not ended yet
+	require.NoError(t, app.recordRateLimitWindows(ctx.WithBlockTime(testBlockTime)))
+	require.Empty(t, app.RateLimitFlowHistory(ctx, BaseDenom, testChannelID, 10))
+
+	runWindow(1, 30, 5)
+	secondEnd := runWindow(2, 0, 70)
+	for epochNumber := uint64(3); epochNumber < rateLimitHistoryDepth+1; epochNumber++ {
+		runWindow(epochNumber, 1, 1)
+	}
+	lastEnd := runWindow(rateLimitHistoryDepth+1, 12, 12)
+
+	// only the last rateLimitHistoryDepth windows are kept
+	history := app.RateLimitFlowHistory(ctx, BaseDenom, testChannelID, 2*rateLimitHistoryDepth)
+	require.Len(t, history, rateLimitHistoryDepth)
+	require.True(t, secondEnd.Equal(history[0].End))
+	require.Equal(t, math.NewInt(70), history[0].Outflow)
+	require.True(t, lastEnd.Equal(history[rateLimitHistoryDepth-1].End))
+	require.Equal(t, math.NewInt(12), history[rateLimitHistoryDepth-1].Inflow)
+
+	// the last n windows
+	res, err := newTestQueryClient(app, ctx).RateLimitFlowHistory(ctx, &kudoratypes.QueryRateLimitFlowHistoryRequest{
+		Denom:             BaseDenom,
+		ChannelOrClientId: testChannelID,
+		Limit:             1,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Windows, 1)
+	require.True(t, lastEnd.Equal(res.Windows[0].End))
+	require.Equal(t, math.NewInt(12), res.Windows[0].Outflow)
+}
+
+func TestRateLimits(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).CacheContext()
+	require.Empty(t, app.RateLimits(ctx))
+
+	paths := []ratelimittypes.Path{
+		{Denom: BaseDenom, ChannelOrClientId: "channel-0"},
+		{Denom: BaseDenom, ChannelOrClientId: "channel-1"},
+		{Denom: "uatom", ChannelOrClientId: "channel-0"},
+	}
+	for i, path := range paths {
+		app.RateLimitKeeper.SetRateLimit(ctx, ratelimittypes.RateLimit{
+			Path: &path,
+			Quota: &ratelimittypes.Quota{
+				MaxPercentSend: math.NewInt(10),
+				MaxPercentRecv: math.NewInt(20),
+				DurationHours:  24,
+			},
+			Flow: &ratelimittypes.Flow{
+				Inflow:       math.NewInt(int64(i)),
+				Outflow:      math.NewInt(int64(10 * i)),
+				ChannelValue: math.NewInt(1_000),
+			},
+		})
+	}
+
+	res, err := newTestQueryClient(app, ctx).RateLimits(ctx, &kudoratypes.QueryRateLimitsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.RateLimits, 3)
+	for _, rateLimit := range res.RateLimits {
+		i := slices.IndexFunc(paths, func(path ratelimittypes.Path) bool {
+			return path.Denom == rateLimit.Denom && path.ChannelOrClientId == rateLimit.ChannelOrClientId
+		})
+		require.GreaterOrEqual(t, i, 0)
+		require.Equal(t, math.NewInt(20), rateLimit.MaxPercentRecv)
+		require.Equal(t, uint64(24), rateLimit.DurationHours)
+		require.Equal(t, math.NewInt(int64(i)), rateLimit.Inflow)
+		require.Equal(t, math.NewInt(int64(10*i)), rateLimit.Outflow)
+	}
+}
+
+func TestRateLimitResetsIn(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).CacheContext()
+
+	app.RateLimitKeeper.SetRateLimit(ctx, ratelimittypes.RateLimit{
+		Path: &ratelimittypes.Path{Denom: BaseDenom, ChannelOrClientId: testChannelID},
+		Quota: &ratelimittypes.Quota{
+			MaxPercentSend: math.NewInt(10),
+			MaxPercentRecv: math.NewInt(10),
+			DurationHours:  24,
+		},
+		Flow: &ratelimittypes.Flow{
+			Inflow:       math.ZeroInt(),
+			Outflow:      math.ZeroInt(),
+			ChannelValue: math.NewInt(1_000),
+		},
+	})
+
+	// half an hour into the sixth hour of a 24 hour window
+	app.RateLimitKeeper.SetHourEpoch(ctx, ratelimittypes.HourEpoch{
+		EpochNumber:    5,
+		Duration:       time.Hour,
+		EpochStartTime: testBlockTime,
+	})
+	queryCtx := ctx.WithBlockTime(testBlockTime.Add(30 * time.Minute))
+	res, err := newTestQueryClient(app, queryCtx).RateLimits(queryCtx, &kudoratypes.QueryRateLimitsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.RateLimits, 1)
+	require.Equal(t, 18*time.Hour+30*time.Minute, res.RateLimits[0].ResetsIn)
+
+	// past the end of the window, before the reset block
+	rateLimits := app.RateLimits(ctx.WithBlockTime(testBlockTime.Add(20 * time.Hour)))
+	require.Zero(t, rateLimits[0].ResetsIn)
+}
@@ -0,0 +1,46 @@
+package app
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/spf13/cast"
+)
+
+// simulationGasAdjustment returns the adjustment set by
+// FlagSimulationGasAdjustment, zero if unset.
+func simulationGasAdjustment(appOpts servertypes.AppOptions) (float64, error) {
+	adjustment := cast.ToFloat64(appOpts.Get(FlagSimulationGasAdjustment))
+	if adjustment != 0 && adjustment < 1 {
+		return 0, fmt.Errorf("invalid %s: %v must be at least 1", FlagSimulationGasAdjustment, adjustment)
+	}
+	return adjustment, nil
+}
+
+// RegisterTxService implements the Application.RegisterTxService method,
+// applying the configured gas adjustment to the gas used of simulations.
+func (app *App) RegisterTxService(clientCtx client.Context) {
+	simulate := app.Simulate
+	if app.simulationGasAdjustment > 0 {
+		simulate = newAdjustedSimulateFn(simulate, app.simulationGasAdjustment)
+	}
+	authtx.RegisterTxService(app.GRPCQueryRouter(), clientCtx, simulate, app.interfaceRegistry)
+}
+
+// newAdjustedSimulateFn wraps simulate to multiply the reported gas used by
+// adjustment, so that clients can use it as gas limit as is.
+func newAdjustedSimulateFn(simulate authtx.BaseAppSimulateFn, adjustment float64) authtx.BaseAppSimulateFn {
+	return func(txBytes []byte) (sdk.GasInfo, *sdk.Result, error) {
+		gasInfo, res, err := simulate(txBytes)
+		if err != nil {
+			return gasInfo, res, err
+		}
+
+		gasInfo.GasUsed = uint64(math.Ceil(float64(gasInfo.GasUsed) * adjustment))
+		return gasInfo, res, nil
+	}
+}
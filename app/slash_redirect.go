@@ -0,0 +1,58 @@
+package app
+
+import (
+	"context"
+
+	"cosmossdk.io/depinject"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// StakingBankKeeper is the bank keeper of staking. It is an interface so that
+// depinject doesn't offer it to the other modules in place of the bank
+// keeper, only to staking through an explicit binding.
+type StakingBankKeeper interface {
+	stakingtypes.BankKeeper
+}
+
+// stakingBankKeeperConfig provides the bank keeper of staking, through which
+// slashes burn.
+func stakingBankKeeperConfig() depinject.Config {
+	return depinject.Configs(
+		depinject.Provide(ProvideStakingBankKeeper),
+		depinject.BindInterfaceInModule(
+			stakingtypes.ModuleName,
+			"github.com/cosmos/cosmos-sdk/x/staking/types/types.BankKeeper",
+			"kudora/app/app.StakingBankKeeper",
+		),
+	)
+}
+
+// slashRedirectBankKeeper is the bank keeper of staking. Staking only burns
+// the tokens it slashes, which it sends to the community pool instead when
+// the Kudora params say so.
+type slashRedirectBankKeeper struct {
+	stakingtypes.BankKeeper
+
+	app *App
+}
+
+// ProvideStakingBankKeeper wraps the bank keeper given to staking. The
+// distribution keeper depends on staking, so it is only read from app once
+// the app is built.
+func ProvideStakingBankKeeper(app *App, bankKeeper bankkeeper.BaseKeeper) StakingBankKeeper {
+	return slashRedirectBankKeeper{
+		BankKeeper: bankKeeper,
+		app:        app,
+	}
+}
+
+// BurnCoins implements stakingtypes.BankKeeper.
+func (k slashRedirectBankKeeper) BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error {
+	if !k.app.KudoraParamsKeeper.GetParams(sdk.UnwrapSDKContext(ctx)).SlashToCommunityPool {
+		return k.BankKeeper.BurnCoins(ctx, moduleName, amt)
+	}
+	return k.app.DistrKeeper.FundCommunityPool(ctx, amt, authtypes.NewModuleAddress(moduleName))
+}
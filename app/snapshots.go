@@ -0,0 +1,42 @@
+package app
+
+import (
+	"cosmossdk.io/store/snapshots"
+)
+
+// SnapshotConfig is the state sync snapshot configuration of the node.
+type SnapshotConfig struct {
+	// Interval is the number of blocks between two snapshots, zero when
+	// snapshots are disabled.
+	Interval uint64
+	// KeepRecent is the number of recent snapshots kept, zero to keep all.
+	KeepRecent uint32
+	// LatestHeight is the height of the latest snapshot, zero if none.
+	LatestHeight uint64
+}
+
+// SnapshotConfig returns the snapshot interval and retention of the node,
+// along with the height of its latest snapshot, as seen by the snapshot
+// manager the wasm snapshotter is registered with.
+func (app *App) SnapshotConfig() (SnapshotConfig, error) {
+	return snapshotConfig(app.SnapshotManager())
+}
+
+func snapshotConfig(manager *snapshots.Manager) (SnapshotConfig, error) {
+	if manager == nil {
+		return SnapshotConfig{}, nil
+	}
+
+	config := SnapshotConfig{
+		Interval:   manager.GetInterval(),
+		KeepRecent: manager.GetKeepRecent(),
+	}
+	latest, err := manager.GetLatest()
+	if err != nil {
+		return SnapshotConfig{}, err
+	}
+	if latest != nil {
+		config.LatestHeight = latest.Height
+	}
+	return config, nil
+}
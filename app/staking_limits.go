@@ -0,0 +1,122 @@
+package app
+
+import (
+	"context"
+
+	stakingmodulev1 "cosmossdk.io/api/cosmos/staking/module/v1"
+	"cosmossdk.io/depinject"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// stakingModuleConfig wires the staking module as its app config entry
+// would, with the module wrapped to enforce Kudora's limits on validators.
+func stakingModuleConfig() depinject.Config {
+	return depinject.Configs(
+		depinject.Supply(&stakingmodulev1.Module{}),
+		depinject.ProvideInModule(stakingtypes.ModuleName, ProvideStakingModule),
+		depinject.InvokeInModule(stakingtypes.ModuleName, staking.InvokeSetStakingHooks),
+	)
+}
+
+// ProvideStakingModule provides the upstream staking module, wrapped with the
+// limits set in the Kudora params.
+func ProvideStakingModule(in staking.ModuleInputs, params kudoraParamsInputs) staking.ModuleOutputs {
+	out := staking.ProvideModule(in)
+	out.Module = stakingModule{
+		AppModule: out.Module.(staking.AppModule),
+		params:    params.Params,
+	}
+	return out
+}
+
+// stakingModule wraps the staking module to enforce Kudora's limits on
+// validators in its msg server, which every validator creation and edit goes
+// through.
+type stakingModule struct {
+	staking.AppModule
+
+	params KudoraParamsReader
+}
+
+// RegisterServices registers the upstream services, wrapping the msg server
+// with the configured limits.
+func (am stakingModule) RegisterServices(cfg module.Configurator) {
+	am.AppModule.RegisterServices(wrappingConfigurator{
+		Configurator: cfg,
+		wrap: func(impl interface{}) interface{} {
+			if msgServer, ok := impl.(stakingtypes.MsgServer); ok {
+				return am.wrapMsgServer(msgServer)
+			}
+			return impl
+		},
+	})
+}
+
+// wrapMsgServer wraps the upstream msg server with the limits of the params.
+func (am stakingModule) wrapMsgServer(msgServer stakingtypes.MsgServer) stakingtypes.MsgServer {
+	if am.params == nil {
+		return msgServer
+	}
+	return newMinSelfDelegationMsgServer(msgServer, am.params)
+}
+
+// minSelfDelegationMsgServer rejects validators created or edited with a
+// minimum self delegation below the min_self_delegation param.
+type minSelfDelegationMsgServer struct {
+	stakingtypes.MsgServer
+
+	params KudoraParamsReader
+}
+
+func newMinSelfDelegationMsgServer(msgServer stakingtypes.MsgServer, params KudoraParamsReader) minSelfDelegationMsgServer {
+	return minSelfDelegationMsgServer{
+		MsgServer: msgServer,
+		params:    params,
+	}
+}
+
+// CreateValidator implements stakingtypes.MsgServer.
+func (s minSelfDelegationMsgServer) CreateValidator(
+	goCtx context.Context,
+	msg *stakingtypes.MsgCreateValidator,
+) (*stakingtypes.MsgCreateValidatorResponse, error) {
+	if err := s.checkMinSelfDelegation(goCtx, msg.MinSelfDelegation); err != nil {
+		return nil, err
+	}
+	return s.MsgServer.CreateValidator(goCtx, msg)
+}
+
+// EditValidator implements stakingtypes.MsgServer.
+func (s minSelfDelegationMsgServer) EditValidator(
+	goCtx context.Context,
+	msg *stakingtypes.MsgEditValidator,
+) (*stakingtypes.MsgEditValidatorResponse, error) {
+	// a nil minimum leaves the validator's current one unchanged
+	if msg.MinSelfDelegation != nil {
+		if err := s.checkMinSelfDelegation(goCtx, *msg.MinSelfDelegation); err != nil {
+			return nil, err
+		}
+	}
+	return s.MsgServer.EditValidator(goCtx, msg)
+}
+
+func (s minSelfDelegationMsgServer) checkMinSelfDelegation(goCtx context.Context, minSelfDelegation math.Int) error {
+	chainMin := s.params.GetParams(sdk.UnwrapSDKContext(goCtx)).MinSelfDelegation
+	if chainMin.IsNil() || !chainMin.IsPositive() {
+		return nil
+	}
+
+	if minSelfDelegation.LT(chainMin) {
+		return errorsmod.Wrapf(
+			errortypes.ErrInvalidRequest,
+			"minimum self delegation %s is below the chain minimum %s", minSelfDelegation, chainMin,
+		)
+	}
+	return nil
+}
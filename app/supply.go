@@ -0,0 +1,53 @@
+package app
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// NativeSupplyBreakdown splits the total supply of the base denom by holder.
+// Its parts add up to the total supply.
+type NativeSupplyBreakdown struct {
+	Total math.Int
+	// Bonded is held by the staking bonded pool.
+	Bonded math.Int
+	// CommunityPool is the community pool, held by the distribution module.
+	CommunityPool math.Int
+	// ModuleHeld is held by other module accounts, including the unbonding
+	// pool and the undistributed rewards.
+	ModuleHeld math.Int
+	// Circulating is everything else, held by regular accounts and contracts.
+	Circulating math.Int
+}
+
+// GetNativeSupplyBreakdown returns the breakdown of the base denom supply.
+func (app *App) GetNativeSupplyBreakdown(ctx sdk.Context) (NativeSupplyBreakdown, error) {
+	feePool, err := app.DistrKeeper.FeePool.Get(ctx)
+	if err != nil {
+		return NativeSupplyBreakdown{}, err
+	}
+
+	breakdown := NativeSupplyBreakdown{
+		Total:         app.BankKeeper.GetSupply(ctx, BaseDenom).Amount,
+		Bonded:        math.ZeroInt(),
+		CommunityPool: feePool.CommunityPool.AmountOf(BaseDenom).TruncateInt(),
+		ModuleHeld:    math.ZeroInt(),
+	}
+	for name := range GetMaccPerms() {
+		balance := app.BankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(name), BaseDenom).Amount
+		switch name {
+		case stakingtypes.BondedPoolName:
+			breakdown.Bonded = balance
+		case distrtypes.ModuleName:
+			breakdown.ModuleHeld = breakdown.ModuleHeld.Add(balance.Sub(breakdown.CommunityPool))
+		default:
+			breakdown.ModuleHeld = breakdown.ModuleHeld.Add(balance)
+		}
+	}
+
+	breakdown.Circulating = breakdown.Total.Sub(breakdown.Bonded).Sub(breakdown.CommunityPool).Sub(breakdown.ModuleHeld)
+	return breakdown, nil
+}
@@ -0,0 +1,60 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+
+	kudoratypes "kudora/x/kudora/types"
+)
+
+// setupTestApp returns the shared test app, skipping the test when it cannot
+// be created (see getTestApp).
+func setupTestApp(t *testing.T) *App {
+	t.Helper()
+
+	app, err := getTestApp()
+	if err != nil || app == nil {
+		t.Skipf("Skipping app tests: %v", err)
+	}
+	return app
+}
+
+// testBlockTime is the block time of the contexts returned by newTestContext.
+var testBlockTime = time.Unix(1_700_000_000, 0).UTC()
+
+// newTestContext returns a context writing straight to the app's commit multistore.
+func newTestContext(app *App) sdk.Context {
+	header := cmtproto.Header{
+		ChainID: testChainID,
+		Height:  1,
+		Time:    testBlockTime,
+	}
+	return sdk.NewContext(app.CommitMultiStore(), header, false, log.NewNopLogger())
+}
+
+// fundTestAccount creates the account if it doesn't exist yet and mints the given coins to it.
+func fundTestAccount(t *testing.T, app *App, ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coins) {
+	t.Helper()
+
+	if app.AuthKeeper.GetAccount(ctx, addr) == nil {
+		app.AuthKeeper.SetAccount(ctx, app.AuthKeeper.NewAccountWithAddress(ctx, addr))
+	}
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr, coins))
+}
+
+// newTestQueryClient returns a client of the Kudora Query service going
+// through the app's gRPC query router at ctx.
+func newTestQueryClient(app *App, ctx sdk.Context) kudoratypes.QueryClient {
+	return kudoratypes.NewQueryClient(&baseapp.QueryServiceTestHelper{
+		GRPCQueryRouter: app.GRPCQueryRouter(),
+		Ctx:             ctx,
+	})
+}
@@ -3,6 +3,7 @@ package app
 import (
 	"cosmossdk.io/core/appmodule"
 	storetypes "cosmossdk.io/store/types"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
@@ -25,10 +26,21 @@ var tokenFactoryCapabilities = []string{
 
 // registerTokenFactoryModule registers the Token Factory keeper and module.
 // This follows the same pattern as registerIBCModules and registerEVMModules.
+//
+// Admins mint straight to another account by setting MintToAddress on the
+// upstream MsgMint (tokenfactorytypes.NewMsgMintTo), which goes through the
+// same limits as any other mint.
 func (app *App) registerTokenFactoryModule(appOpts servertypes.AppOptions) error {
 	// Step 1: Register the store key for Token Factory
 	if err := app.RegisterStores(
 		storetypes.NewKVStoreKey(tokenfactorytypes.StoreKey),
+		storetypes.NewKVStoreKey(BeforeSendHooksStoreKey),
+		storetypes.NewKVStoreKey(DenomMetadataLocksStoreKey),
+		storetypes.NewKVStoreKey(DenomSymbolsStoreKey),
+		storetypes.NewKVStoreKey(DenomMintPausesStoreKey),
+		storetypes.NewKVStoreKey(DenomAdminHistoryStoreKey),
+		storetypes.NewKVStoreKey(DenomBurnsStoreKey),
+		storetypes.NewKVStoreKey(DenomSendDisablesStoreKey),
 	); err != nil {
 		return err
 	}
@@ -56,14 +68,65 @@ func (app *App) registerTokenFactoryModule(appOpts servertypes.AppOptions) error
 		govModuleAddr,
 	)
 
-	// Step 5: Register the module
+	// Step 5: Run the before-send hooks of every denom on bank transfers.
+	// The wasm keeper is set up later, hence the pointer.
+	app.BeforeSendHooksKeeper = NewBeforeSendHooksKeeper(
+		app.appCodec,
+		app.GetKey(BeforeSendHooksStoreKey),
+		&app.TokenFactoryKeeper,
+		wasmkeeper.NewDefaultPermissionKeeper(&app.WasmKeeper),
+	)
+	app.BankKeeper.AppendSendRestriction(app.BeforeSendHooksKeeper.BlockBeforeSend)
+
+	// Step 6: Let admins lock the metadata of their denoms
+	app.DenomMetadataLockKeeper = NewDenomMetadataLockKeeper(
+		app.GetKey(DenomMetadataLocksStoreKey),
+		&app.TokenFactoryKeeper,
+	)
+
+	// Step 7: Let admins pause the minting of their denoms
+	app.DenomMintPauseKeeper = NewDenomMintPauseKeeper(
+		app.GetKey(DenomMintPausesStoreKey),
+		&app.TokenFactoryKeeper,
+	)
+
+	// Step 8: Record the admin changes of denoms for auditing
+	app.DenomAdminHistoryKeeper = NewDenomAdminHistoryKeeper(
+		app.appCodec,
+		app.GetKey(DenomAdminHistoryStoreKey),
+		&app.TokenFactoryKeeper,
+	)
+
+	// Step 9: Let admins disable the sends of their denoms
+	app.DenomSendEnabledKeeper = NewDenomSendEnabledKeeper(
+		app.GetKey(DenomSendDisablesStoreKey),
+		&app.TokenFactoryKeeper,
+		app.BankKeeper,
+	)
+	app.BankKeeper.AppendSendRestriction(app.DenomSendEnabledKeeper.BlockDisabledSends)
+
+	// Step 10: Track the cumulative burns of denoms
+	app.DenomBurnKeeper = NewDenomBurnKeeper(app.GetKey(DenomBurnsStoreKey))
+
+	// Step 11: Register the module
 	if err := app.RegisterModules(
-		tokenfactory.NewAppModule(
-			app.TokenFactoryKeeper,
-			app.AuthKeeper,
-			app.BankKeeper,
-			tokenfactorysubspace,
-		),
+		tokenFactoryModule{
+			AppModule: tokenfactory.NewAppModule(
+				app.TokenFactoryKeeper,
+				app.AuthKeeper,
+				app.BankKeeper,
+				tokenfactorysubspace,
+			),
+			keeper:          &app.TokenFactoryKeeper,
+			bankKeeper:      app.BankKeeper,
+			erc20Keeper:     &app.Erc20Keeper,
+			metadataLocks:   app.DenomMetadataLockKeeper,
+			mintPauses:      app.DenomMintPauseKeeper,
+			adminHistory:    app.DenomAdminHistoryKeeper,
+			burns:           app.DenomBurnKeeper,
+			symbolsStoreKey: app.GetKey(DenomSymbolsStoreKey),
+			params:          app.KudoraParamsKeeper,
+		},
 	); err != nil {
 		return err
 	}
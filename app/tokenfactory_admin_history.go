@@ -0,0 +1,327 @@
+package app
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	tokenfactorykeeper "github.com/cosmos/tokenfactory/x/tokenfactory/keeper"
+	tokenfactorytypes "github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	kudoratypes "kudora/x/kudora/types"
+)
+
+// DenomAdminHistoryStoreKey is the store holding the admin changes of
+// tokenfactory denoms.
+const DenomAdminHistoryStoreKey = "tokenfactory_admin_history"
+
+// DenomAuthority is the current admin of a tokenfactory denom along with the
+// history of its admin changes, oldest first.
+type DenomAuthority struct {
+	Denom   string                         `json:"denom"`
+	Admin   string                         `json:"admin"`
+	History []kudoratypes.DenomAdminChange `json:"history"`
+}
+
+// DenomAdminHistoryKeeper records the admin changes of tokenfactory denoms for
+// auditing. Every change is its own entry, keyed by denom and sequence, so
+// that recording one doesn't rewrite the whole history of the denom.
+type DenomAdminHistoryKeeper struct {
+	cdc                codec.BinaryCodec
+	storeKey           storetypes.StoreKey
+	tokenFactoryKeeper *tokenfactorykeeper.Keeper
+}
+
+// NewDenomAdminHistoryKeeper creates a new DenomAdminHistoryKeeper.
+func NewDenomAdminHistoryKeeper(
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+	tokenFactoryKeeper *tokenfactorykeeper.Keeper,
+) DenomAdminHistoryKeeper {
+	return DenomAdminHistoryKeeper{
+		cdc:                cdc,
+		storeKey:           storeKey,
+		tokenFactoryKeeper: tokenFactoryKeeper,
+	}
+}
+
+// adminChangePrefix is the prefix of the admin changes of denom. Denoms
+// can't contain '|', so no denom prefix is the prefix of another denom.
+func adminChangePrefix(denom string) []byte {
+	return append([]byte(denom), '|')
+}
+
+// adminChangeKey is the key of the admin change of denom with sequence seq.
+func adminChangeKey(denom string, seq uint64) []byte {
+	return append(adminChangePrefix(denom), sdk.Uint64ToBigEndian(seq)...)
+}
+
+// GetDenomAuthority returns the current admin of denom and its admin change
+// history.
+func (k DenomAdminHistoryKeeper) GetDenomAuthority(ctx sdk.Context, denom string) (DenomAuthority, error) {
+	metadata, err := k.tokenFactoryKeeper.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return DenomAuthority{}, err
+	}
+
+	return DenomAuthority{Denom: denom, Admin: metadata.Admin, History: k.GetAdminHistory(ctx, denom)}, nil
+}
+
+// GetAdminHistory returns the admin changes of denom, oldest first.
+func (k DenomAdminHistoryKeeper) GetAdminHistory(ctx sdk.Context, denom string) []kudoratypes.DenomAdminChange {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), adminChangePrefix(denom))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var changes []kudoratypes.DenomAdminChange
+	for ; iterator.Valid(); iterator.Next() {
+		var change kudoratypes.DenomAdminChange
+		k.cdc.MustUnmarshal(iterator.Value(), &change)
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+// lastAdminChange returns the latest admin change of denom, if any.
+func (k DenomAdminHistoryKeeper) lastAdminChange(ctx sdk.Context, denom string) (kudoratypes.DenomAdminChange, uint64, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), adminChangePrefix(denom))
+	iterator := store.ReverseIterator(nil, nil)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return kudoratypes.DenomAdminChange{}, 0, false
+	}
+	var change kudoratypes.DenomAdminChange
+	k.cdc.MustUnmarshal(iterator.Value(), &change)
+	return change, sdk.BigEndianToUint64(iterator.Key()), true
+}
+
+// GetAllAdminHistories returns the admin change histories of all denoms, in
+// denom order.
+func (k DenomAdminHistoryKeeper) GetAllAdminHistories(ctx sdk.Context) []kudoratypes.DenomAdminHistory {
+	iterator := ctx.KVStore(k.storeKey).Iterator(nil, nil)
+	defer iterator.Close()
+
+	var histories []kudoratypes.DenomAdminHistory
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		denom := string(key[:len(key)-9])
+
+		var change kudoratypes.DenomAdminChange
+		k.cdc.MustUnmarshal(iterator.Value(), &change)
+		if n := len(histories); n > 0 && histories[n-1].Denom == denom {
+			histories[n-1].Changes = append(histories[n-1].Changes, change)
+		} else {
+			histories = append(histories, kudoratypes.DenomAdminHistory{Denom: denom, Changes: []kudoratypes.DenomAdminChange{change}})
+		}
+	}
+	return histories
+}
+
+func (k DenomAdminHistoryKeeper) setAdminHistory(ctx sdk.Context, denom string, changes []kudoratypes.DenomAdminChange) {
+	store := ctx.KVStore(k.storeKey)
+	for i := range changes {
+		store.Set(adminChangeKey(denom, uint64(i)), k.cdc.MustMarshal(&changes[i]))
+	}
+}
+
+func (k DenomAdminHistoryKeeper) recordAdminChange(ctx sdk.Context, denom, oldAdmin, newAdmin string) {
+	var seq uint64
+	if _, last, found := k.lastAdminChange(ctx, denom); found {
+		seq = last + 1
+	}
+
+	change := kudoratypes.DenomAdminChange{
+		Height:   ctx.BlockHeight(),
+		OldAdmin: oldAdmin,
+		NewAdmin: newAdmin,
+	}
+	ctx.KVStore(k.storeKey).Set(adminChangeKey(denom, seq), k.cdc.MustMarshal(&change))
+}
+
+// adminHistoryMsgServer records the admin changes of denoms.
+type adminHistoryMsgServer struct {
+	tokenfactorytypes.MsgServer
+
+	history DenomAdminHistoryKeeper
+}
+
+func newAdminHistoryMsgServer(
+	msgServer tokenfactorytypes.MsgServer,
+	history DenomAdminHistoryKeeper,
+) adminHistoryMsgServer {
+	return adminHistoryMsgServer{
+		MsgServer: msgServer,
+		history:   history,
+	}
+}
+
+// ChangeAdmin implements tokenfactorytypes.MsgServer.
+func (s adminHistoryMsgServer) ChangeAdmin(
+	goCtx context.Context,
+	msg *tokenfactorytypes.MsgChangeAdmin,
+) (*tokenfactorytypes.MsgChangeAdminResponse, error) {
+	resp, err := s.MsgServer.ChangeAdmin(goCtx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// only the admin may change it, so the sender was the previous admin
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	s.history.recordAdminChange(ctx, msg.Denom, msg.Sender, msg.NewAdmin)
+	return resp, nil
+}
+
+var _ wasmkeeper.Messenger = (*adminHistoryMessenger)(nil)
+
+// adminHistoryMessenger records the admin changes contracts make through the
+// tokenfactory custom bindings, which bypass adminHistoryMsgServer.
+type adminHistoryMessenger struct {
+	wasmkeeper.Messenger
+
+	history DenomAdminHistoryKeeper
+}
+
+// newAdminHistoryMessenger returns a message handler decorator to be passed
+// to wasmkeeper.WithMessageHandlerDecorator.
+func newAdminHistoryMessenger(history DenomAdminHistoryKeeper) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+	return func(nested wasmkeeper.Messenger) wasmkeeper.Messenger {
+		return &adminHistoryMessenger{
+			Messenger: nested,
+			history:   history,
+		}
+	}
+}
+
+// DispatchMsg implements wasmkeeper.Messenger.
+func (m *adminHistoryMessenger) DispatchMsg(
+	ctx sdk.Context,
+	contractAddr sdk.AccAddress,
+	contractIBCPortID string,
+	msg wasmvmtypes.CosmosMsg,
+) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	events, data, msgResponses, err := m.Messenger.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// only the admin may change it, so the contract was the previous admin
+	if tokenMsg, ok := parseTokenFactoryBindingMsg(msg); ok && tokenMsg.ChangeAdmin != nil {
+		change := tokenMsg.ChangeAdmin
+		m.history.recordAdminChange(ctx, change.Denom, contractAddr.String(), change.NewAdminAddress)
+	}
+	return events, data, msgResponses, nil
+}
+
+// adminCooldownMsgServer rejects admin changes of a denom made less than the
+// cooldown of the Kudora params after its previous one.
+type adminCooldownMsgServer struct {
+	tokenfactorytypes.MsgServer
+
+	history      DenomAdminHistoryKeeper
+	paramsKeeper KudoraParamsKeeper
+}
+
+func newAdminCooldownMsgServer(
+	msgServer tokenfactorytypes.MsgServer,
+	history DenomAdminHistoryKeeper,
+	paramsKeeper KudoraParamsKeeper,
+) adminCooldownMsgServer {
+	return adminCooldownMsgServer{
+		MsgServer:    msgServer,
+		history:      history,
+		paramsKeeper: paramsKeeper,
+	}
+}
+
+// ChangeAdmin implements tokenfactorytypes.MsgServer.
+func (s adminCooldownMsgServer) ChangeAdmin(
+	goCtx context.Context,
+	msg *tokenfactorytypes.MsgChangeAdmin,
+) (*tokenfactorytypes.MsgChangeAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := checkAdminChangeCooldown(ctx, s.history, s.paramsKeeper, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	return s.MsgServer.ChangeAdmin(goCtx, msg)
+}
+
+var _ wasmkeeper.Messenger = (*adminCooldownMessenger)(nil)
+
+// adminCooldownMessenger applies the cooldown of adminCooldownMsgServer to the
+// admin changes contracts make through the tokenfactory custom bindings, which
+// bypass the msg server.
+type adminCooldownMessenger struct {
+	wasmkeeper.Messenger
+
+	history      DenomAdminHistoryKeeper
+	paramsKeeper KudoraParamsKeeper
+}
+
+// newAdminCooldownMessenger returns a message handler decorator to be passed
+// to wasmkeeper.WithMessageHandlerDecorator.
+func newAdminCooldownMessenger(
+	history DenomAdminHistoryKeeper,
+	paramsKeeper KudoraParamsKeeper,
+) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+	return func(nested wasmkeeper.Messenger) wasmkeeper.Messenger {
+		return &adminCooldownMessenger{
+			Messenger:    nested,
+			history:      history,
+			paramsKeeper: paramsKeeper,
+		}
+	}
+}
+
+// DispatchMsg implements wasmkeeper.Messenger.
+func (m *adminCooldownMessenger) DispatchMsg(
+	ctx sdk.Context,
+	contractAddr sdk.AccAddress,
+	contractIBCPortID string,
+	msg wasmvmtypes.CosmosMsg,
+) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	if tokenMsg, ok := parseTokenFactoryBindingMsg(msg); ok && tokenMsg.ChangeAdmin != nil {
+		if err := checkAdminChangeCooldown(ctx, m.history, m.paramsKeeper, tokenMsg.ChangeAdmin.Denom); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	return m.Messenger.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+}
+
+// checkAdminChangeCooldown fails if the admin of denom changed less than the
+// cooldown of the Kudora params ago.
+func checkAdminChangeCooldown(
+	ctx sdk.Context,
+	history DenomAdminHistoryKeeper,
+	paramsKeeper KudoraParamsKeeper,
+	denom string,
+) error {
+	cooldownBlocks := paramsKeeper.GetParams(ctx).TokenfactoryAdminChangeCooldownBlocks
+	if cooldownBlocks == 0 {
+		return nil
+	}
+
+	change, _, found := history.lastAdminChange(ctx, denom)
+	if !found {
+		return nil
+	}
+
+	last := change.Height
+	if next := last + int64(cooldownBlocks); ctx.BlockHeight() < next {
+		return errorsmod.Wrapf(
+			errortypes.ErrUnauthorized,
+			"admin of %s changed at height %d, it can't change again before height %d",
+			denom, last, next,
+		)
+	}
+	return nil
+}
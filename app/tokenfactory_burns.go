@@ -0,0 +1,153 @@
+package app
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	tokenfactorytypes "github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	kudoratypes "kudora/x/kudora/types"
+)
+
+// DenomBurnsStoreKey is the store holding the cumulative burned amounts of
+// tokenfactory denoms.
+const DenomBurnsStoreKey = "tokenfactory_burns"
+
+// DenomBurnKeeper tracks the cumulative amount burned of every tokenfactory
+// denom, which its current supply doesn't tell.
+type DenomBurnKeeper struct {
+	storeKey storetypes.StoreKey
+}
+
+// NewDenomBurnKeeper creates a new DenomBurnKeeper.
+func NewDenomBurnKeeper(storeKey storetypes.StoreKey) DenomBurnKeeper {
+	return DenomBurnKeeper{storeKey: storeKey}
+}
+
+// GetBurned returns the cumulative amount of denom burned, zero if none.
+func (k DenomBurnKeeper) GetBurned(ctx sdk.Context, denom string) (math.Int, error) {
+	bz := ctx.KVStore(k.storeKey).Get([]byte(denom))
+	if bz == nil {
+		return math.ZeroInt(), nil
+	}
+
+	var burned math.Int
+	if err := burned.Unmarshal(bz); err != nil {
+		return math.Int{}, err
+	}
+	return burned, nil
+}
+
+// GetAllBurned returns the cumulative amounts burned of all denoms with
+// burns, in denom order.
+func (k DenomBurnKeeper) GetAllBurned(ctx sdk.Context) ([]kudoratypes.DenomBurned, error) {
+	iterator := ctx.KVStore(k.storeKey).Iterator(nil, nil)
+	defer iterator.Close()
+
+	var burns []kudoratypes.DenomBurned
+	for ; iterator.Valid(); iterator.Next() {
+		var burned math.Int
+		if err := burned.Unmarshal(iterator.Value()); err != nil {
+			return nil, err
+		}
+		burns = append(burns, kudoratypes.DenomBurned{Denom: string(iterator.Key()), Amount: burned})
+	}
+	return burns, nil
+}
+
+func (k DenomBurnKeeper) setBurned(ctx sdk.Context, denom string, burned math.Int) error {
+	bz, err := burned.Marshal()
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set([]byte(denom), bz)
+	return nil
+}
+
+func (k DenomBurnKeeper) recordBurn(ctx sdk.Context, burn sdk.Coin) error {
+	burned, err := k.GetBurned(ctx, burn.Denom)
+	if err != nil {
+		return err
+	}
+	return k.setBurned(ctx, burn.Denom, burned.Add(burn.Amount))
+}
+
+// burnTrackingMsgServer records the burns of denoms.
+type burnTrackingMsgServer struct {
+	tokenfactorytypes.MsgServer
+
+	burns DenomBurnKeeper
+}
+
+func newBurnTrackingMsgServer(
+	msgServer tokenfactorytypes.MsgServer,
+	burns DenomBurnKeeper,
+) burnTrackingMsgServer {
+	return burnTrackingMsgServer{
+		MsgServer: msgServer,
+		burns:     burns,
+	}
+}
+
+// Burn implements tokenfactorytypes.MsgServer.
+func (s burnTrackingMsgServer) Burn(
+	goCtx context.Context,
+	msg *tokenfactorytypes.MsgBurn,
+) (*tokenfactorytypes.MsgBurnResponse, error) {
+	resp, err := s.MsgServer.Burn(goCtx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.burns.recordBurn(sdk.UnwrapSDKContext(goCtx), msg.Amount); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+var _ wasmkeeper.Messenger = (*burnTrackingMessenger)(nil)
+
+// burnTrackingMessenger records the burns contracts make through the
+// tokenfactory custom bindings, which bypass burnTrackingMsgServer.
+type burnTrackingMessenger struct {
+	wasmkeeper.Messenger
+
+	burns DenomBurnKeeper
+}
+
+// newBurnTrackingMessenger returns a message handler decorator to be passed
+// to wasmkeeper.WithMessageHandlerDecorator.
+func newBurnTrackingMessenger(burns DenomBurnKeeper) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+	return func(nested wasmkeeper.Messenger) wasmkeeper.Messenger {
+		return &burnTrackingMessenger{
+			Messenger: nested,
+			burns:     burns,
+		}
+	}
+}
+
+// DispatchMsg implements wasmkeeper.Messenger.
+func (m *burnTrackingMessenger) DispatchMsg(
+	ctx sdk.Context,
+	contractAddr sdk.AccAddress,
+	contractIBCPortID string,
+	msg wasmvmtypes.CosmosMsg,
+) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	events, data, msgResponses, err := m.Messenger.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if tokenMsg, ok := parseTokenFactoryBindingMsg(msg); ok && tokenMsg.BurnTokens != nil {
+		burn := tokenMsg.BurnTokens
+		if err := m.burns.recordBurn(ctx, sdk.NewCoin(burn.Denom, burn.Amount)); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	return events, data, msgResponses, nil
+}
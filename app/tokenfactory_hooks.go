@@ -0,0 +1,198 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	tokenfactorykeeper "github.com/cosmos/tokenfactory/x/tokenfactory/keeper"
+
+	kudoratypes "kudora/x/kudora/types"
+)
+
+// BeforeSendHooksStoreKey is the store holding the before-send hook
+// contracts of tokenfactory denoms.
+const BeforeSendHooksStoreKey = "tokenfactory_hooks"
+
+// beforeSendHookGasLimit is the gas each before-send hook call may use, as
+// upstream tokenfactory caps its single hook.
+const beforeSendHookGasLimit storetypes.Gas = 500_000
+
+// ContractSudoer executes sudo calls on contracts, as the wasm permissioned
+// keeper does.
+type ContractSudoer interface {
+	Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
+}
+
+// BeforeSendHooksKeeper extends tokenfactory with an ordered list of
+// before-send hook contracts per denom, where upstream allows a single one.
+// Every hook of a denom is called on each transfer of that denom, in order,
+// and the transfer fails on the first hook rejecting it.
+type BeforeSendHooksKeeper struct {
+	cdc                codec.BinaryCodec
+	storeKey           storetypes.StoreKey
+	tokenFactoryKeeper *tokenfactorykeeper.Keeper
+	sudoer             ContractSudoer
+}
+
+// NewBeforeSendHooksKeeper creates a new BeforeSendHooksKeeper.
+func NewBeforeSendHooksKeeper(
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+	tokenFactoryKeeper *tokenfactorykeeper.Keeper,
+	sudoer ContractSudoer,
+) BeforeSendHooksKeeper {
+	return BeforeSendHooksKeeper{
+		cdc:                cdc,
+		storeKey:           storeKey,
+		tokenFactoryKeeper: tokenFactoryKeeper,
+		sudoer:             sudoer,
+	}
+}
+
+// SetBeforeSendHooks replaces the before-send hook contracts of denom, which
+// only its admin may do. An empty list removes all hooks.
+func (k BeforeSendHooksKeeper) SetBeforeSendHooks(ctx sdk.Context, admin, denom string, contracts []string) error {
+	metadata, err := k.tokenFactoryKeeper.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+	if metadata.Admin != admin {
+		return errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s is not the admin of %s", admin, denom)
+	}
+
+	return k.setBeforeSendHooks(ctx, denom, contracts)
+}
+
+// setBeforeSendHooks replaces the before-send hook contracts of denom.
+func (k BeforeSendHooksKeeper) setBeforeSendHooks(ctx sdk.Context, denom string, contracts []string) error {
+	store := ctx.KVStore(k.storeKey)
+	if len(contracts) == 0 {
+		store.Delete([]byte(denom))
+		return nil
+	}
+
+	for _, contract := range contracts {
+		if _, err := sdk.AccAddressFromBech32(contract); err != nil {
+			return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid hook contract %s: %s", contract, err)
+		}
+	}
+
+	store.Set([]byte(denom), k.cdc.MustMarshal(&kudoratypes.BeforeSendHooks{Contracts: contracts}))
+	return nil
+}
+
+// GetBeforeSendHooks returns the before-send hook contracts of denom, in
+// execution order.
+func (k BeforeSendHooksKeeper) GetBeforeSendHooks(ctx sdk.Context, denom string) ([]string, error) {
+	bz := ctx.KVStore(k.storeKey).Get([]byte(denom))
+	if bz == nil {
+		return nil, nil
+	}
+
+	var hooks kudoratypes.BeforeSendHooks
+	if err := k.cdc.Unmarshal(bz, &hooks); err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid before-send hooks of %s", denom)
+	}
+	return hooks.Contracts, nil
+}
+
+// GetAllBeforeSendHooks returns the before-send hook contracts of every denom
+// having some, ordered by denom.
+func (k BeforeSendHooksKeeper) GetAllBeforeSendHooks(ctx sdk.Context) ([]kudoratypes.DenomBeforeSendHooks, error) {
+	iterator := ctx.KVStore(k.storeKey).Iterator(nil, nil)
+	defer iterator.Close()
+
+	var all []kudoratypes.DenomBeforeSendHooks
+	for ; iterator.Valid(); iterator.Next() {
+		var hooks kudoratypes.BeforeSendHooks
+		if err := k.cdc.Unmarshal(iterator.Value(), &hooks); err != nil {
+			return nil, errorsmod.Wrapf(err, "invalid before-send hooks of %s", iterator.Key())
+		}
+		all = append(all, kudoratypes.DenomBeforeSendHooks{Denom: string(iterator.Key()), Contracts: hooks.Contracts})
+	}
+	return all, nil
+}
+
+// blockBeforeSendSudoMsg is the sudo message sent to before-send hook
+// contracts, in the format of upstream tokenfactory hooks.
+type blockBeforeSendSudoMsg struct {
+	BlockBeforeSend blockBeforeSendMsg `json:"block_before_send"`
+}
+
+type blockBeforeSendMsg struct {
+	From   string           `json:"from"`
+	To     string           `json:"to"`
+	Amount wasmvmtypes.Coin `json:"amount"`
+}
+
+// BlockBeforeSend is a bank send restriction calling the before-send hooks of
+// every sent denom.
+func (k BeforeSendHooksKeeper) BlockBeforeSend(
+	goCtx context.Context,
+	fromAddr, toAddr sdk.AccAddress,
+	amount sdk.Coins,
+) (sdk.AccAddress, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	for _, coin := range amount {
+		contracts, err := k.GetBeforeSendHooks(ctx, coin.Denom)
+		if err != nil {
+			return nil, err
+		}
+		if len(contracts) == 0 {
+			continue
+		}
+
+		msg, err := json.Marshal(blockBeforeSendSudoMsg{
+			BlockBeforeSend: blockBeforeSendMsg{
+				From:   fromAddr.String(),
+				To:     toAddr.String(),
+				Amount: wasmvmtypes.Coin{Denom: coin.Denom, Amount: coin.Amount.String()},
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, contract := range contracts {
+			contractAddr, err := sdk.AccAddressFromBech32(contract)
+			if err != nil {
+				return nil, err
+			}
+			if err := k.callHook(ctx, contractAddr, msg); err != nil {
+				return nil, errorsmod.Wrapf(err, "before-send hook %s rejected %s", contract, coin)
+			}
+		}
+	}
+
+	return toAddr, nil
+}
+
+// callHook calls a before-send hook contract with at most
+// beforeSendHookGasLimit gas, so that a hook can't stall every send of a
+// denom. The gas used is charged to ctx.
+func (k BeforeSendHooksKeeper) callHook(ctx sdk.Context, contract sdk.AccAddress, msg []byte) (err error) {
+	gasLimit := min(beforeSendHookGasLimit, ctx.GasMeter().GasRemaining())
+	hookCtx := ctx.WithGasMeter(storetypes.NewGasMeter(gasLimit))
+
+	defer func() {
+		ctx.GasMeter().ConsumeGas(hookCtx.GasMeter().GasConsumedToLimit(), "tokenfactory before-send hook")
+
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(storetypes.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			err = errorsmod.Wrapf(errortypes.ErrOutOfGas, "out of gas in location: %s", outOfGas.Descriptor)
+		}
+	}()
+
+	_, err = k.sudoer.Sudo(hookCtx, contract, msg)
+	return err
+}
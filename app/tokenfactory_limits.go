@@ -0,0 +1,194 @@
+package app
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	erc20keeper "github.com/cosmos/evm/x/erc20/keeper"
+	tokenfactory "github.com/cosmos/tokenfactory/x/tokenfactory"
+	tokenfactorykeeper "github.com/cosmos/tokenfactory/x/tokenfactory/keeper"
+	tokenfactorytypes "github.com/cosmos/tokenfactory/x/tokenfactory/types"
+)
+
+// tokenFactoryModule wraps the tokenfactory module to enforce Kudora-specific
+// limits and behaviors on its msg server.
+type tokenFactoryModule struct {
+	tokenfactory.AppModule
+
+	keeper          *tokenfactorykeeper.Keeper
+	bankKeeper      bankkeeper.Keeper
+	erc20Keeper     *erc20keeper.Keeper
+	metadataLocks   DenomMetadataLockKeeper
+	mintPauses      DenomMintPauseKeeper
+	adminHistory    DenomAdminHistoryKeeper
+	burns           DenomBurnKeeper
+	symbolsStoreKey storetypes.StoreKey
+	params          KudoraParamsKeeper
+}
+
+// RegisterServices registers the upstream services, wrapping the msg server
+// with the configured limits and behaviors.
+func (am tokenFactoryModule) RegisterServices(cfg module.Configurator) {
+	am.AppModule.RegisterServices(wrappingConfigurator{
+		Configurator: cfg,
+		wrap: func(impl interface{}) interface{} {
+			if msgServer, ok := impl.(tokenfactorytypes.MsgServer); ok {
+				return am.wrapMsgServer(msgServer)
+			}
+			return impl
+		},
+	})
+}
+
+// wrapMsgServer wraps the upstream msg server with the configured limits and
+// behaviors.
+func (am tokenFactoryModule) wrapMsgServer(msgServer tokenfactorytypes.MsgServer) tokenfactorytypes.MsgServer {
+	msgServer = newMetadataLockMsgServer(msgServer, am.metadataLocks)
+	msgServer = newMintPauseMsgServer(msgServer, am.mintPauses)
+	msgServer = newAdminHistoryMsgServer(msgServer, am.adminHistory)
+	msgServer = newBurnTrackingMsgServer(msgServer, am.burns)
+	msgServer = newDenomCapMsgServer(msgServer, am.keeper, am.params)
+	msgServer = newPairCleanupMsgServer(msgServer, am.bankKeeper, am.erc20Keeper, am.params)
+	msgServer = newUniqueSymbolMsgServer(msgServer, am.symbolsStoreKey, am.bankKeeper, am.params)
+	msgServer = newAdminCooldownMsgServer(msgServer, am.adminHistory, am.params)
+	return msgServer
+}
+
+// tokenFactoryMessengerDecorators returns the wasm options enforcing on the
+// tokenfactory custom bindings, which call the keeper directly, the limits
+// and behaviors wrapMsgServer adds to the msg server.
+func (app *App) tokenFactoryMessengerDecorators() []wasmkeeper.Option {
+	return []wasmkeeper.Option{
+		wasmkeeper.WithMessageHandlerDecorator(newMetadataLockMessenger(app.DenomMetadataLockKeeper)),
+		wasmkeeper.WithMessageHandlerDecorator(newMintPauseMessenger(app.DenomMintPauseKeeper)),
+		wasmkeeper.WithMessageHandlerDecorator(newAdminHistoryMessenger(app.DenomAdminHistoryKeeper)),
+		wasmkeeper.WithMessageHandlerDecorator(newAdminCooldownMessenger(app.DenomAdminHistoryKeeper, app.KudoraParamsKeeper)),
+		wasmkeeper.WithMessageHandlerDecorator(newBurnTrackingMessenger(app.DenomBurnKeeper)),
+		wasmkeeper.WithMessageHandlerDecorator(newDenomCapMessenger(&app.TokenFactoryKeeper, app.KudoraParamsKeeper)),
+		wasmkeeper.WithMessageHandlerDecorator(
+			newUniqueSymbolMessenger(app.GetKey(DenomSymbolsStoreKey), app.BankKeeper, app.KudoraParamsKeeper),
+		),
+		wasmkeeper.WithMessageHandlerDecorator(newPairCleanupMessenger(app.BankKeeper, &app.Erc20Keeper, app.KudoraParamsKeeper)),
+	}
+}
+
+// denomCapMsgServer rejects denom creations once the creator owns the
+// tokenfactory_max_denoms_per_creator param of denoms.
+type denomCapMsgServer struct {
+	tokenfactorytypes.MsgServer
+
+	keeper       *tokenfactorykeeper.Keeper
+	paramsKeeper KudoraParamsKeeper
+}
+
+func newDenomCapMsgServer(
+	msgServer tokenfactorytypes.MsgServer,
+	keeper *tokenfactorykeeper.Keeper,
+	paramsKeeper KudoraParamsKeeper,
+) denomCapMsgServer {
+	return denomCapMsgServer{
+		MsgServer:    msgServer,
+		keeper:       keeper,
+		paramsKeeper: paramsKeeper,
+	}
+}
+
+// CreateDenom implements tokenfactorytypes.MsgServer.
+func (s denomCapMsgServer) CreateDenom(
+	goCtx context.Context,
+	msg *tokenfactorytypes.MsgCreateDenom,
+) (*tokenfactorytypes.MsgCreateDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	maxDenoms := s.paramsKeeper.GetParams(ctx).TokenfactoryMaxDenomsPerCreator
+	if err := checkDenomsPerCreator(ctx, s.keeper, msg.Sender, maxDenoms); err != nil {
+		return nil, err
+	}
+
+	return s.MsgServer.CreateDenom(goCtx, msg)
+}
+
+var _ wasmkeeper.Messenger = (*denomCapMessenger)(nil)
+
+// denomCapMessenger enforces the denoms per creator cap on contracts, whose
+// tokenfactory custom messages don't go through the msg service router.
+type denomCapMessenger struct {
+	wasmkeeper.Messenger
+
+	tokenFactoryKeeper *tokenfactorykeeper.Keeper
+	paramsKeeper       KudoraParamsKeeper
+}
+
+// newDenomCapMessenger returns a message handler decorator to be passed to
+// wasmkeeper.WithMessageHandlerDecorator.
+func newDenomCapMessenger(
+	tokenFactoryKeeper *tokenfactorykeeper.Keeper,
+	paramsKeeper KudoraParamsKeeper,
+) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+	return func(nested wasmkeeper.Messenger) wasmkeeper.Messenger {
+		return &denomCapMessenger{
+			Messenger:          nested,
+			tokenFactoryKeeper: tokenFactoryKeeper,
+			paramsKeeper:       paramsKeeper,
+		}
+	}
+}
+
+// DispatchMsg dispatches the message to the wrapped handler and fails if the
+// contract created denoms beyond the cap while handling it.
+func (m *denomCapMessenger) DispatchMsg(
+	ctx sdk.Context,
+	contractAddr sdk.AccAddress,
+	contractIBCPortID string,
+	msg wasmvmtypes.CosmosMsg,
+) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	// only custom messages can reach the tokenfactory bindings
+	if msg.Custom == nil {
+		return m.Messenger.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+	}
+	maxDenoms := m.paramsKeeper.GetParams(ctx).TokenfactoryMaxDenomsPerCreator
+	if maxDenoms == 0 {
+		return m.Messenger.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+	}
+
+	creator := contractAddr.String()
+	before := uint64(len(m.tokenFactoryKeeper.GetDenomsFromCreator(ctx, creator)))
+
+	events, data, msgResponses, err := m.Messenger.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	after := uint64(len(m.tokenFactoryKeeper.GetDenomsFromCreator(ctx, creator)))
+	if after > before && after > maxDenoms {
+		return nil, nil, nil, errDenomsPerCreator(creator, after, maxDenoms)
+	}
+
+	return events, data, msgResponses, nil
+}
+
+// checkDenomsPerCreator fails if creator would own more than maxDenoms denoms
+// after creating one more. Zero maxDenoms disables the cap.
+func checkDenomsPerCreator(ctx sdk.Context, keeper *tokenfactorykeeper.Keeper, creator string, maxDenoms uint64) error {
+	if maxDenoms == 0 {
+		return nil
+	}
+	if count := uint64(len(keeper.GetDenomsFromCreator(ctx, creator))) + 1; count > maxDenoms {
+		return errDenomsPerCreator(creator, count, maxDenoms)
+	}
+	return nil
+}
+
+func errDenomsPerCreator(creator string, count, maxDenoms uint64) error {
+	return errorsmod.Wrapf(
+		errortypes.ErrInvalidRequest,
+		"creator %s would own %d denoms, maximum allowed is %d", creator, count, maxDenoms,
+	)
+}
@@ -0,0 +1,147 @@
+package app
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	tokenfactorykeeper "github.com/cosmos/tokenfactory/x/tokenfactory/keeper"
+	tokenfactorytypes "github.com/cosmos/tokenfactory/x/tokenfactory/types"
+)
+
+// DenomMetadataLocksStoreKey is the store holding the tokenfactory denoms
+// whose metadata is locked.
+const DenomMetadataLocksStoreKey = "tokenfactory_metadata_locks"
+
+// DenomMetadataLockKeeper lets the admin of a tokenfactory denom lock its
+// metadata for good. Once locked, the metadata can't be changed anymore, not
+// even by a later admin.
+type DenomMetadataLockKeeper struct {
+	storeKey           storetypes.StoreKey
+	tokenFactoryKeeper *tokenfactorykeeper.Keeper
+}
+
+// NewDenomMetadataLockKeeper creates a new DenomMetadataLockKeeper.
+func NewDenomMetadataLockKeeper(
+	storeKey storetypes.StoreKey,
+	tokenFactoryKeeper *tokenfactorykeeper.Keeper,
+) DenomMetadataLockKeeper {
+	return DenomMetadataLockKeeper{
+		storeKey:           storeKey,
+		tokenFactoryKeeper: tokenFactoryKeeper,
+	}
+}
+
+// LockDenomMetadata locks the metadata of denom, which only its admin may do.
+// Locking can't be undone.
+func (k DenomMetadataLockKeeper) LockDenomMetadata(ctx sdk.Context, admin, denom string) error {
+	metadata, err := k.tokenFactoryKeeper.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+	if metadata.Admin != admin {
+		return errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s is not the admin of %s", admin, denom)
+	}
+
+	k.lockDenomMetadata(ctx, denom)
+	return nil
+}
+
+func (k DenomMetadataLockKeeper) lockDenomMetadata(ctx sdk.Context, denom string) {
+	ctx.KVStore(k.storeKey).Set([]byte(denom), []byte{1})
+}
+
+// IsDenomMetadataLocked reports whether the metadata of denom is locked.
+func (k DenomMetadataLockKeeper) IsDenomMetadataLocked(ctx sdk.Context, denom string) bool {
+	return ctx.KVStore(k.storeKey).Has([]byte(denom))
+}
+
+// GetLockedDenoms returns the denoms whose metadata is locked, in order.
+func (k DenomMetadataLockKeeper) GetLockedDenoms(ctx sdk.Context) []string {
+	iterator := ctx.KVStore(k.storeKey).Iterator(nil, nil)
+	defer iterator.Close()
+
+	var denoms []string
+	for ; iterator.Valid(); iterator.Next() {
+		denoms = append(denoms, string(iterator.Key()))
+	}
+	return denoms
+}
+
+// metadataLockMsgServer rejects metadata updates of denoms whose metadata is
+// locked.
+type metadataLockMsgServer struct {
+	tokenfactorytypes.MsgServer
+
+	locks DenomMetadataLockKeeper
+}
+
+func newMetadataLockMsgServer(
+	msgServer tokenfactorytypes.MsgServer,
+	locks DenomMetadataLockKeeper,
+) metadataLockMsgServer {
+	return metadataLockMsgServer{
+		MsgServer: msgServer,
+		locks:     locks,
+	}
+}
+
+// SetDenomMetadata implements tokenfactorytypes.MsgServer.
+func (s metadataLockMsgServer) SetDenomMetadata(
+	goCtx context.Context,
+	msg *tokenfactorytypes.MsgSetDenomMetadata,
+) (*tokenfactorytypes.MsgSetDenomMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if s.locks.IsDenomMetadataLocked(ctx, msg.Metadata.Base) {
+		return nil, errMetadataLocked(msg.Metadata.Base)
+	}
+
+	return s.MsgServer.SetDenomMetadata(goCtx, msg)
+}
+
+var _ wasmkeeper.Messenger = (*metadataLockMessenger)(nil)
+
+// metadataLockMessenger rejects the metadata updates of contracts of denoms
+// whose metadata is locked, since the tokenfactory custom bindings bypass
+// metadataLockMsgServer.
+type metadataLockMessenger struct {
+	wasmkeeper.Messenger
+
+	locks DenomMetadataLockKeeper
+}
+
+// newMetadataLockMessenger returns a message handler decorator to be passed
+// to wasmkeeper.WithMessageHandlerDecorator.
+func newMetadataLockMessenger(locks DenomMetadataLockKeeper) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+	return func(nested wasmkeeper.Messenger) wasmkeeper.Messenger {
+		return &metadataLockMessenger{
+			Messenger: nested,
+			locks:     locks,
+		}
+	}
+}
+
+// DispatchMsg implements wasmkeeper.Messenger.
+func (m *metadataLockMessenger) DispatchMsg(
+	ctx sdk.Context,
+	contractAddr sdk.AccAddress,
+	contractIBCPortID string,
+	msg wasmvmtypes.CosmosMsg,
+) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	if tokenMsg, ok := parseTokenFactoryBindingMsg(msg); ok && tokenMsg.SetMetadata != nil {
+		if m.locks.IsDenomMetadataLocked(ctx, tokenMsg.SetMetadata.Denom) {
+			return nil, nil, nil, errMetadataLocked(tokenMsg.SetMetadata.Denom)
+		}
+	}
+
+	return m.Messenger.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+}
+
+func errMetadataLocked(denom string) error {
+	return errorsmod.Wrapf(errortypes.ErrUnauthorized, "metadata of %s is locked", denom)
+}
@@ -0,0 +1,150 @@
+package app
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	tokenfactorykeeper "github.com/cosmos/tokenfactory/x/tokenfactory/keeper"
+	tokenfactorytypes "github.com/cosmos/tokenfactory/x/tokenfactory/types"
+)
+
+// DenomMintPausesStoreKey is the store holding the tokenfactory denoms whose
+// minting is paused.
+const DenomMintPausesStoreKey = "tokenfactory_mint_pauses"
+
+// DenomMintPauseKeeper lets the admin of a tokenfactory denom pause and
+// resume its minting. Transfers and burns of a paused denom are unaffected.
+type DenomMintPauseKeeper struct {
+	storeKey           storetypes.StoreKey
+	tokenFactoryKeeper *tokenfactorykeeper.Keeper
+}
+
+// NewDenomMintPauseKeeper creates a new DenomMintPauseKeeper.
+func NewDenomMintPauseKeeper(
+	storeKey storetypes.StoreKey,
+	tokenFactoryKeeper *tokenfactorykeeper.Keeper,
+) DenomMintPauseKeeper {
+	return DenomMintPauseKeeper{
+		storeKey:           storeKey,
+		tokenFactoryKeeper: tokenFactoryKeeper,
+	}
+}
+
+// SetMintPaused pauses or resumes the minting of denom, which only its admin
+// may do.
+func (k DenomMintPauseKeeper) SetMintPaused(ctx sdk.Context, admin, denom string, paused bool) error {
+	metadata, err := k.tokenFactoryKeeper.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+	if metadata.Admin != admin {
+		return errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s is not the admin of %s", admin, denom)
+	}
+
+	k.setMintPaused(ctx, denom, paused)
+	return nil
+}
+
+func (k DenomMintPauseKeeper) setMintPaused(ctx sdk.Context, denom string, paused bool) {
+	store := ctx.KVStore(k.storeKey)
+	if paused {
+		store.Set([]byte(denom), []byte{1})
+	} else {
+		store.Delete([]byte(denom))
+	}
+}
+
+// IsMintPaused reports whether the minting of denom is paused.
+func (k DenomMintPauseKeeper) IsMintPaused(ctx sdk.Context, denom string) bool {
+	return ctx.KVStore(k.storeKey).Has([]byte(denom))
+}
+
+// GetMintPausedDenoms returns the denoms whose minting is paused, in order.
+func (k DenomMintPauseKeeper) GetMintPausedDenoms(ctx sdk.Context) []string {
+	iterator := ctx.KVStore(k.storeKey).Iterator(nil, nil)
+	defer iterator.Close()
+
+	var denoms []string
+	for ; iterator.Valid(); iterator.Next() {
+		denoms = append(denoms, string(iterator.Key()))
+	}
+	return denoms
+}
+
+// mintPauseMsgServer rejects mints of denoms whose minting is paused.
+type mintPauseMsgServer struct {
+	tokenfactorytypes.MsgServer
+
+	pauses DenomMintPauseKeeper
+}
+
+func newMintPauseMsgServer(
+	msgServer tokenfactorytypes.MsgServer,
+	pauses DenomMintPauseKeeper,
+) mintPauseMsgServer {
+	return mintPauseMsgServer{
+		MsgServer: msgServer,
+		pauses:    pauses,
+	}
+}
+
+// Mint implements tokenfactorytypes.MsgServer.
+func (s mintPauseMsgServer) Mint(
+	goCtx context.Context,
+	msg *tokenfactorytypes.MsgMint,
+) (*tokenfactorytypes.MsgMintResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if s.pauses.IsMintPaused(ctx, msg.Amount.Denom) {
+		return nil, errMintPaused(msg.Amount.Denom)
+	}
+
+	return s.MsgServer.Mint(goCtx, msg)
+}
+
+var _ wasmkeeper.Messenger = (*mintPauseMessenger)(nil)
+
+// mintPauseMessenger rejects the mints of contracts of denoms whose minting
+// is paused, since the tokenfactory custom bindings bypass
+// mintPauseMsgServer.
+type mintPauseMessenger struct {
+	wasmkeeper.Messenger
+
+	pauses DenomMintPauseKeeper
+}
+
+// newMintPauseMessenger returns a message handler decorator to be passed to
+// wasmkeeper.WithMessageHandlerDecorator.
+func newMintPauseMessenger(pauses DenomMintPauseKeeper) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+	return func(nested wasmkeeper.Messenger) wasmkeeper.Messenger {
+		return &mintPauseMessenger{
+			Messenger: nested,
+			pauses:    pauses,
+		}
+	}
+}
+
+// DispatchMsg implements wasmkeeper.Messenger.
+func (m *mintPauseMessenger) DispatchMsg(
+	ctx sdk.Context,
+	contractAddr sdk.AccAddress,
+	contractIBCPortID string,
+	msg wasmvmtypes.CosmosMsg,
+) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	if tokenMsg, ok := parseTokenFactoryBindingMsg(msg); ok && tokenMsg.MintTokens != nil {
+		if m.pauses.IsMintPaused(ctx, tokenMsg.MintTokens.Denom) {
+			return nil, nil, nil, errMintPaused(tokenMsg.MintTokens.Denom)
+		}
+	}
+
+	return m.Messenger.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+}
+
+func errMintPaused(denom string) error {
+	return errorsmod.Wrapf(errortypes.ErrUnauthorized, "minting of %s is paused", denom)
+}
@@ -0,0 +1,141 @@
+package app
+
+import (
+	"context"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	erc20keeper "github.com/cosmos/evm/x/erc20/keeper"
+	tokenfactorytypes "github.com/cosmos/tokenfactory/x/tokenfactory/types"
+)
+
+// pairCleanupMsgServer unregisters the ERC20 token pair of a denom once a
+// burn brings its total supply down to zero, while the
+// tokenfactory_unregister_burned_pairs param is set.
+type pairCleanupMsgServer struct {
+	tokenfactorytypes.MsgServer
+
+	bankKeeper   bankkeeper.Keeper
+	erc20Keeper  *erc20keeper.Keeper
+	paramsKeeper KudoraParamsKeeper
+}
+
+func newPairCleanupMsgServer(
+	msgServer tokenfactorytypes.MsgServer,
+	bankKeeper bankkeeper.Keeper,
+	erc20Keeper *erc20keeper.Keeper,
+	paramsKeeper KudoraParamsKeeper,
+) pairCleanupMsgServer {
+	return pairCleanupMsgServer{
+		MsgServer:    msgServer,
+		bankKeeper:   bankKeeper,
+		erc20Keeper:  erc20Keeper,
+		paramsKeeper: paramsKeeper,
+	}
+}
+
+// Burn implements tokenfactorytypes.MsgServer.
+func (s pairCleanupMsgServer) Burn(
+	goCtx context.Context,
+	msg *tokenfactorytypes.MsgBurn,
+) (*tokenfactorytypes.MsgBurnResponse, error) {
+	res, err := s.MsgServer.Burn(goCtx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !s.paramsKeeper.GetParams(ctx).TokenfactoryUnregisterBurnedPairs {
+		return res, nil
+	}
+	if err := unregisterBurnedPair(ctx, s.bankKeeper, s.erc20Keeper, msg.Amount.Denom); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+var _ wasmkeeper.Messenger = (*pairCleanupMessenger)(nil)
+
+// pairCleanupMessenger unregisters the token pairs of the denoms contracts
+// burn through the tokenfactory custom bindings, which bypass
+// pairCleanupMsgServer.
+type pairCleanupMessenger struct {
+	wasmkeeper.Messenger
+
+	bankKeeper   bankkeeper.Keeper
+	erc20Keeper  *erc20keeper.Keeper
+	paramsKeeper KudoraParamsKeeper
+}
+
+// newPairCleanupMessenger returns a message handler decorator to be passed
+// to wasmkeeper.WithMessageHandlerDecorator.
+func newPairCleanupMessenger(
+	bankKeeper bankkeeper.Keeper,
+	erc20Keeper *erc20keeper.Keeper,
+	paramsKeeper KudoraParamsKeeper,
+) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+	return func(nested wasmkeeper.Messenger) wasmkeeper.Messenger {
+		return &pairCleanupMessenger{
+			Messenger:    nested,
+			bankKeeper:   bankKeeper,
+			erc20Keeper:  erc20Keeper,
+			paramsKeeper: paramsKeeper,
+		}
+	}
+}
+
+// DispatchMsg implements wasmkeeper.Messenger.
+func (m *pairCleanupMessenger) DispatchMsg(
+	ctx sdk.Context,
+	contractAddr sdk.AccAddress,
+	contractIBCPortID string,
+	msg wasmvmtypes.CosmosMsg,
+) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	events, data, msgResponses, err := m.Messenger.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	tokenMsg, ok := parseTokenFactoryBindingMsg(msg)
+	if ok && tokenMsg.BurnTokens != nil && m.paramsKeeper.GetParams(ctx).TokenfactoryUnregisterBurnedPairs {
+		if err := unregisterBurnedPair(ctx, m.bankKeeper, m.erc20Keeper, tokenMsg.BurnTokens.Denom); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	return events, data, msgResponses, nil
+}
+
+// unregisterBurnedPair deletes the token pair of denom if its supply is zero,
+// along with the ERC20 precompile serving it, so that the contract address no
+// longer answers for the denom.
+func unregisterBurnedPair(
+	ctx sdk.Context,
+	bankKeeper bankkeeper.Keeper,
+	erc20Keeper *erc20keeper.Keeper,
+	denom string,
+) error {
+	if !bankKeeper.GetSupply(ctx, denom).IsZero() {
+		return nil
+	}
+
+	pair, found := erc20Keeper.GetTokenPair(ctx, erc20Keeper.GetTokenPairID(ctx, denom))
+	if !found {
+		return nil
+	}
+
+	erc20Keeper.DeleteTokenPair(ctx, pair)
+
+	contract := pair.GetERC20Contract()
+	if erc20Keeper.IsDynamicPrecompileAvailable(ctx, contract) {
+		erc20Keeper.DeleteDynamicPrecompile(ctx, contract)
+		return erc20Keeper.UnRegisterERC20CodeHash(ctx, contract)
+	}
+	if erc20Keeper.IsNativePrecompileAvailable(ctx, contract) {
+		erc20Keeper.DeleteNativePrecompile(ctx, contract)
+		return erc20Keeper.UnRegisterERC20CodeHash(ctx, contract)
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package app
+
+import (
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	tokenfactorytypes "github.com/cosmos/tokenfactory/x/tokenfactory/types"
+)
+
+// GetDenomsByBeforeSendHook returns the tokenfactory denoms having the given
+// contract as before-send hook, either as their upstream tokenfactory hook or
+// in their list of BeforeSendHooksKeeper hooks.
+func (app *App) GetDenomsByBeforeSendHook(ctx sdk.Context, contractAddr string) ([]string, error) {
+	iterator := app.TokenFactoryKeeper.GetAllDenomsIterator(ctx)
+	defer iterator.Close()
+
+	var denoms []string
+	for ; iterator.Valid(); iterator.Next() {
+		denom := string(iterator.Value())
+		if app.TokenFactoryKeeper.GetBeforeSendHook(ctx, denom) == contractAddr {
+			denoms = append(denoms, denom)
+		}
+	}
+
+	hooks, err := app.BeforeSendHooksKeeper.GetAllBeforeSendHooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, hook := range hooks {
+		if slices.Contains(hook.Contracts, contractAddr) && !slices.Contains(denoms, hook.Denom) {
+			denoms = append(denoms, hook.Denom)
+		}
+	}
+	return denoms, nil
+}
+
+// GetDenomsByAdmin returns the tokenfactory denoms currently administered by
+// admin, whoever created them.
+func (app *App) GetDenomsByAdmin(ctx sdk.Context, admin string) []string {
+	iterator := app.TokenFactoryKeeper.GetAllDenomsIterator(ctx)
+	defer iterator.Close()
+
+	var denoms []string
+	for ; iterator.Valid(); iterator.Next() {
+		denom := string(iterator.Value())
+		metadata, err := app.TokenFactoryKeeper.GetAuthorityMetadata(ctx, denom)
+		if err == nil && metadata.Admin == admin {
+			denoms = append(denoms, denom)
+		}
+	}
+	return denoms
+}
+
+// AdminSupply is the supply of the tokenfactory denoms of a single admin.
+type AdminSupply struct {
+	Admin  string
+	Denoms []string
+	Supply sdk.Coins
+}
+
+// GetAdminSupply returns the denoms administered by admin and their total
+// supply, to assess how much a single controller can mint or burn.
+func (app *App) GetAdminSupply(ctx sdk.Context, admin string) AdminSupply {
+	denoms := app.GetDenomsByAdmin(ctx, admin)
+
+	supply := sdk.NewCoins()
+	for _, denom := range denoms {
+		supply = supply.Add(app.BankKeeper.GetSupply(ctx, denom))
+	}
+	return AdminSupply{
+		Admin:  admin,
+		Denoms: denoms,
+		Supply: supply,
+	}
+}
+
+// IsTokenFactoryDenom reports whether denom is a well-formed factory/ denom
+// that has been created, as opposed to a native or IBC denom.
+func (app *App) IsTokenFactoryDenom(ctx sdk.Context, denom string) bool {
+	if _, _, err := tokenfactorytypes.DeconstructDenom(denom); err != nil {
+		return false
+	}
+
+	// tokenfactory registers the bank metadata of every denom it creates
+	return app.BankKeeper.HasDenomMetaData(ctx, denom)
+}
+
+// GetDenomCreator returns the account or contract that created the
+// tokenfactory denom, which stays the same when its admin changes.
+func (app *App) GetDenomCreator(ctx sdk.Context, denom string) (string, error) {
+	creator, _, err := tokenfactorytypes.DeconstructDenom(denom)
+	if err != nil {
+		return "", err
+	}
+
+	// the denom must have been created, not just be well-formed
+	if !app.BankKeeper.HasDenomMetaData(ctx, denom) {
+		return "", errorsmod.Wrapf(tokenfactorytypes.ErrDenomDoesNotExist, "denom: %s", denom)
+	}
+	return creator, nil
+}
+
+// GetDenomBalances returns the balances of addr in each of denoms, in the
+// same order and including zero ones, so that wallets can fetch all the
+// tokenfactory denoms they display at once. Other denoms work too.
+func (app *App) GetDenomBalances(ctx sdk.Context, addr sdk.AccAddress, denoms []string) []sdk.Coin {
+	balances := make([]sdk.Coin, 0, len(denoms))
+	for _, denom := range denoms {
+		balances = append(balances, app.BankKeeper.GetBalance(ctx, addr, denom))
+	}
+	return balances
+}
+
+// DenomSummary gathers what explorers display about a tokenfactory denom.
+type DenomSummary struct {
+	Denom          string
+	Creator        string
+	Admin          string
+	Supply         sdk.Coin
+	Metadata       banktypes.Metadata
+	MetadataLocked bool
+	MintPaused     bool
+	// BeforeSendHooks lists the upstream tokenfactory hook, if any, followed
+	// by the Kudora before-send hooks in execution order.
+	BeforeSendHooks []string
+}
+
+// GetDenomSummary returns the creator, admin, supply, metadata, lock and
+// pause flags and before-send hooks of a tokenfactory denom at once.
+func (app *App) GetDenomSummary(ctx sdk.Context, denom string) (DenomSummary, error) {
+	creator, err := app.GetDenomCreator(ctx, denom)
+	if err != nil {
+		return DenomSummary{}, err
+	}
+
+	authority, err := app.TokenFactoryKeeper.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return DenomSummary{}, err
+	}
+	metadata, _ := app.BankKeeper.GetDenomMetaData(ctx, denom)
+
+	var hooks []string
+	if hook := app.TokenFactoryKeeper.GetBeforeSendHook(ctx, denom); hook != "" {
+		hooks = append(hooks, hook)
+	}
+	contracts, err := app.BeforeSendHooksKeeper.GetBeforeSendHooks(ctx, denom)
+	if err != nil {
+		return DenomSummary{}, err
+	}
+	hooks = append(hooks, contracts...)
+
+	return DenomSummary{
+		Denom:           denom,
+		Creator:         creator,
+		Admin:           authority.Admin,
+		Supply:          app.BankKeeper.GetSupply(ctx, denom),
+		Metadata:        metadata,
+		MetadataLocked:  app.DenomMetadataLockKeeper.IsDenomMetadataLocked(ctx, denom),
+		MintPaused:      app.DenomMintPauseKeeper.IsMintPaused(ctx, denom),
+		BeforeSendHooks: hooks,
+	}, nil
+}
+
+// DenomERC20Pair tells whether a tokenfactory denom has an ERC20
+// representation, and if so its contract address and whether conversions
+// are enabled.
+type DenomERC20Pair struct {
+	Denom           string
+	Registered      bool
+	ContractAddress string
+	Enabled         bool
+}
+
+// GetDenomERC20Pair looks up the ERC20 token pair of a tokenfactory denom.
+// Denoms without a pair are reported as not registered.
+func (app *App) GetDenomERC20Pair(ctx sdk.Context, denom string) (DenomERC20Pair, error) {
+	if _, err := app.GetDenomCreator(ctx, denom); err != nil {
+		return DenomERC20Pair{}, err
+	}
+
+	pair, found := app.Erc20Keeper.GetTokenPair(ctx, app.Erc20Keeper.GetTokenPairID(ctx, denom))
+	if !found {
+		return DenomERC20Pair{Denom: denom}, nil
+	}
+	return DenomERC20Pair{
+		Denom:           denom,
+		Registered:      true,
+		ContractAddress: pair.Erc20Address,
+		Enabled:         pair.Enabled,
+	}, nil
+}
@@ -0,0 +1,114 @@
+package app
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	tokenfactorykeeper "github.com/cosmos/tokenfactory/x/tokenfactory/keeper"
+	tokenfactorytypes "github.com/cosmos/tokenfactory/x/tokenfactory/types"
+)
+
+// DenomSendDisablesStoreKey is the store holding the tokenfactory denoms
+// whose sends their admin disabled.
+const DenomSendDisablesStoreKey = "tokenfactory_send_disables"
+
+// DenomSendEnabledKeeper lets the admin of a tokenfactory denom disable and
+// re-enable all its sends. While disabled, bank sends and IBC transfers of the
+// denom fail; mints and burns are unaffected.
+//
+// The admin toggle is kept apart from the bank send-enabled entries, which
+// are left to governance: the denom can only be sent while both allow it.
+type DenomSendEnabledKeeper struct {
+	storeKey           storetypes.StoreKey
+	tokenFactoryKeeper *tokenfactorykeeper.Keeper
+	bankKeeper         bankkeeper.Keeper
+}
+
+// NewDenomSendEnabledKeeper creates a new DenomSendEnabledKeeper.
+func NewDenomSendEnabledKeeper(
+	storeKey storetypes.StoreKey,
+	tokenFactoryKeeper *tokenfactorykeeper.Keeper,
+	bankKeeper bankkeeper.Keeper,
+) DenomSendEnabledKeeper {
+	return DenomSendEnabledKeeper{
+		storeKey:           storeKey,
+		tokenFactoryKeeper: tokenFactoryKeeper,
+		bankKeeper:         bankKeeper,
+	}
+}
+
+// SetSendEnabled disables or re-enables the sends of denom, which only its
+// admin may do.
+func (k DenomSendEnabledKeeper) SetSendEnabled(ctx sdk.Context, admin, denom string, enabled bool) error {
+	metadata, err := k.tokenFactoryKeeper.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+	if metadata.Admin != admin {
+		return errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s is not the admin of %s", admin, denom)
+	}
+
+	k.setDisabledByAdmin(ctx, denom, !enabled)
+	return nil
+}
+
+func (k DenomSendEnabledKeeper) setDisabledByAdmin(ctx sdk.Context, denom string, disabled bool) {
+	store := ctx.KVStore(k.storeKey)
+	if disabled {
+		store.Set([]byte(denom), []byte{1})
+	} else {
+		store.Delete([]byte(denom))
+	}
+}
+
+// IsSendEnabled reports whether denom can be sent, neither governance nor
+// its admin having disabled it.
+func (k DenomSendEnabledKeeper) IsSendEnabled(ctx sdk.Context, denom string) bool {
+	return k.bankKeeper.IsSendEnabledDenom(ctx, denom) && !k.IsDisabledByAdmin(ctx, denom)
+}
+
+// IsDisabledByAdmin reports whether the admin of denom disabled its sends.
+func (k DenomSendEnabledKeeper) IsDisabledByAdmin(ctx sdk.Context, denom string) bool {
+	return ctx.KVStore(k.storeKey).Has([]byte(denom))
+}
+
+// GetDisabledByAdminDenoms returns the denoms whose sends their admin
+// disabled, in order.
+func (k DenomSendEnabledKeeper) GetDisabledByAdminDenoms(ctx sdk.Context) []string {
+	iterator := ctx.KVStore(k.storeKey).Iterator(nil, nil)
+	defer iterator.Close()
+
+	var denoms []string
+	for ; iterator.Valid(); iterator.Next() {
+		denoms = append(denoms, string(iterator.Key()))
+	}
+	return denoms
+}
+
+// BlockDisabledSends is a bank send restriction failing the transfers of
+// denoms whose sends their admin disabled. Mints and burns, which go through
+// the tokenfactory module account, are let through.
+func (k DenomSendEnabledKeeper) BlockDisabledSends(
+	goCtx context.Context,
+	fromAddr, toAddr sdk.AccAddress,
+	amount sdk.Coins,
+) (sdk.AccAddress, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	moduleAddr := authtypes.NewModuleAddress(tokenfactorytypes.ModuleName)
+	if fromAddr.Equals(moduleAddr) || toAddr.Equals(moduleAddr) {
+		return toAddr, nil
+	}
+	for _, coin := range amount {
+		if k.IsDisabledByAdmin(ctx, coin.Denom) {
+			return nil, errorsmod.Wrapf(banktypes.ErrSendDisabled, "%s transfers are disabled by its admin", coin.Denom)
+		}
+	}
+	return toAddr, nil
+}
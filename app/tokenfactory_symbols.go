@@ -0,0 +1,160 @@
+package app
+
+import (
+	"context"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	tokenfactorytypes "github.com/cosmos/tokenfactory/x/tokenfactory/types"
+)
+
+// DenomSymbolsStoreKey is the store indexing the tokenfactory denoms by the
+// symbol of their metadata.
+const DenomSymbolsStoreKey = "tokenfactory_symbols"
+
+// denomSymbols indexes the tokenfactory denoms by the symbol of their
+// metadata. Symbols are compared case-insensitively.
+type denomSymbols struct {
+	storeKey     storetypes.StoreKey
+	bankKeeper   bankkeeper.Keeper
+	paramsKeeper KudoraParamsKeeper
+}
+
+// checkSymbol fails if symbol is indexed for another denom than denom while
+// the tokenfactory_unique_symbols param is set.
+func (d denomSymbols) checkSymbol(ctx sdk.Context, denom, symbol string) error {
+	key := symbolKey(symbol)
+	if len(key) == 0 || !d.paramsKeeper.GetParams(ctx).TokenfactoryUniqueSymbols {
+		return nil
+	}
+
+	if owner := ctx.KVStore(d.storeKey).Get(key); owner != nil && string(owner) != denom {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "symbol %s is already used by %s", symbol, owner)
+	}
+	return nil
+}
+
+// indexSymbol indexes denom under symbol, in place of its previous symbol.
+func (d denomSymbols) indexSymbol(ctx sdk.Context, denom, previous, symbol string) {
+	store := ctx.KVStore(d.storeKey)
+	if previousKey := symbolKey(previous); len(previousKey) > 0 && string(store.Get(previousKey)) == denom {
+		store.Delete(previousKey)
+	}
+	if key := symbolKey(symbol); len(key) > 0 {
+		store.Set(key, []byte(denom))
+	}
+}
+
+// getSymbol returns the current symbol of denom.
+func (d denomSymbols) getSymbol(ctx sdk.Context, denom string) string {
+	metadata, _ := d.bankKeeper.GetDenomMetaData(ctx, denom)
+	return metadata.Symbol
+}
+
+// uniqueSymbolMsgServer rejects metadata updates giving a denom the symbol of
+// another one. Symbols are indexed as they are set, including while the check
+// is disabled, so only the symbols set before the index existed aren't
+// unique.
+type uniqueSymbolMsgServer struct {
+	tokenfactorytypes.MsgServer
+
+	symbols denomSymbols
+}
+
+func newUniqueSymbolMsgServer(
+	msgServer tokenfactorytypes.MsgServer,
+	storeKey storetypes.StoreKey,
+	bankKeeper bankkeeper.Keeper,
+	paramsKeeper KudoraParamsKeeper,
+) uniqueSymbolMsgServer {
+	return uniqueSymbolMsgServer{
+		MsgServer: msgServer,
+		symbols:   denomSymbols{storeKey: storeKey, bankKeeper: bankKeeper, paramsKeeper: paramsKeeper},
+	}
+}
+
+// SetDenomMetadata implements tokenfactorytypes.MsgServer.
+func (s uniqueSymbolMsgServer) SetDenomMetadata(
+	goCtx context.Context,
+	msg *tokenfactorytypes.MsgSetDenomMetadata,
+) (*tokenfactorytypes.MsgSetDenomMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	denom := msg.Metadata.Base
+	if err := s.symbols.checkSymbol(ctx, denom, msg.Metadata.Symbol); err != nil {
+		return nil, err
+	}
+
+	previous := s.symbols.getSymbol(ctx, denom)
+	res, err := s.MsgServer.SetDenomMetadata(goCtx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	s.symbols.indexSymbol(ctx, denom, previous, msg.Metadata.Symbol)
+	return res, nil
+}
+
+var _ wasmkeeper.Messenger = (*uniqueSymbolMessenger)(nil)
+
+// uniqueSymbolMessenger applies the checks of uniqueSymbolMsgServer to the
+// metadata updates contracts send through the tokenfactory custom bindings,
+// which bypass the msg server.
+type uniqueSymbolMessenger struct {
+	wasmkeeper.Messenger
+
+	symbols denomSymbols
+}
+
+// newUniqueSymbolMessenger returns a message handler decorator to be passed
+// to wasmkeeper.WithMessageHandlerDecorator.
+func newUniqueSymbolMessenger(
+	storeKey storetypes.StoreKey,
+	bankKeeper bankkeeper.Keeper,
+	paramsKeeper KudoraParamsKeeper,
+) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+	return func(nested wasmkeeper.Messenger) wasmkeeper.Messenger {
+		return &uniqueSymbolMessenger{
+			Messenger: nested,
+			symbols:   denomSymbols{storeKey: storeKey, bankKeeper: bankKeeper, paramsKeeper: paramsKeeper},
+		}
+	}
+}
+
+// DispatchMsg implements wasmkeeper.Messenger.
+func (m *uniqueSymbolMessenger) DispatchMsg(
+	ctx sdk.Context,
+	contractAddr sdk.AccAddress,
+	contractIBCPortID string,
+	msg wasmvmtypes.CosmosMsg,
+) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	tokenMsg, ok := parseTokenFactoryBindingMsg(msg)
+	if !ok || tokenMsg.SetMetadata == nil {
+		return m.Messenger.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+	}
+
+	denom, symbol := tokenMsg.SetMetadata.Denom, tokenMsg.SetMetadata.Metadata.Symbol
+	if err := m.symbols.checkSymbol(ctx, denom, symbol); err != nil {
+		return nil, nil, nil, err
+	}
+
+	previous := m.symbols.getSymbol(ctx, denom)
+	events, data, msgResponses, err := m.Messenger.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	m.symbols.indexSymbol(ctx, denom, previous, symbol)
+	return events, data, msgResponses, nil
+}
+
+// symbolKey returns the index key of a symbol.
+func symbolKey(symbol string) []byte {
+	return []byte(strings.ToUpper(symbol))
+}
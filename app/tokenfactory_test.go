@@ -16,6 +16,7 @@ package app
 // All 8 sub-tests will pass when run individually.
 
 import (
+	"encoding/json"
 	"fmt"
 	"sync"
 	"testing"
@@ -27,12 +28,25 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	packetforwardtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v10/packetforward/types"
+	ratelimittypes "github.com/cosmos/ibc-apps/modules/rate-limiting/v10/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/suite"
 
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	bindings "github.com/cosmos/tokenfactory/x/tokenfactory/bindings"
+	bindingstypes "github.com/cosmos/tokenfactory/x/tokenfactory/bindings/types"
 	tokenfactorykeeper "github.com/cosmos/tokenfactory/x/tokenfactory/keeper"
 	tokenfactorytypes "github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	kudoratypes "kudora/x/kudora/types"
 )
 
 var (
@@ -404,3 +418,1039 @@ func (s *TokenFactoryTestSuite) TestTokenFactoryDenomMetadata() {
 	require.Equal(metadata.Name, storedMetadata.Name)
 	require.Equal(metadata.Symbol, storedMetadata.Symbol)
 }
+
+// TestTokenFactoryMaxDenomsPerCreator tests the cap on denoms created by a single account
+func (s *TokenFactoryTestSuite) TestTokenFactoryMaxDenomsPerCreator() {
+	require := s.Require()
+	ctx, _ := s.ctx.CacheContext()
+
+	// Create a test account
+	addr := sdk.AccAddress([]byte("addrcap_____________"))
+	acc := s.app.AuthKeeper.NewAccountWithAddress(ctx, addr)
+	s.app.AuthKeeper.SetAccount(ctx, acc)
+
+	// Fund the account for fees
+	coins := sdk.NewCoins(sdk.NewCoin("kud", math.NewInt(1000000000000000000)))
+	require.NoError(s.app.BankKeeper.MintCoins(ctx, "mint", coins))
+	require.NoError(s.app.BankKeeper.SendCoinsFromModuleToAccount(ctx, "mint", addr, coins))
+
+	params := kudoratypes.DefaultParams()
+	params.TokenfactoryMaxDenomsPerCreator = 2
+	require.NoError(s.app.KudoraParamsKeeper.SetParams(ctx, params))
+
+	msgServer := newDenomCapMsgServer(s.msgServer, &s.app.TokenFactoryKeeper, s.app.KudoraParamsKeeper)
+
+	// Create denoms up to the cap
+	for _, subdenom := range []string{"capone", "captwo"} {
+		_, err := msgServer.CreateDenom(ctx, tokenfactorytypes.NewMsgCreateDenom(addr.String(), subdenom))
+		require.NoError(err, "failed to create denom %s", subdenom)
+	}
+
+	// One more is rejected
+	_, err := msgServer.CreateDenom(ctx, tokenfactorytypes.NewMsgCreateDenom(addr.String(), "capthree"))
+	require.ErrorIs(err, errortypes.ErrInvalidRequest)
+	require.Len(s.app.TokenFactoryKeeper.GetDenomsFromCreator(ctx, addr.String()), 2)
+
+	// Unless the cap is lifted
+	require.NoError(s.app.KudoraParamsKeeper.SetParams(ctx, kudoratypes.DefaultParams()))
+	_, err = msgServer.CreateDenom(ctx, tokenfactorytypes.NewMsgCreateDenom(addr.String(), "capthree"))
+	require.NoError(err)
+}
+
+// TestTokenFactoryGetDenomsByBeforeSendHook tests the reverse lookup of before-send hooks
+func (s *TokenFactoryTestSuite) TestTokenFactoryGetDenomsByBeforeSendHook() {
+	require := s.Require()
+
+	// Create a test account
+	addr := sdk.AccAddress([]byte("addrhook____________"))
+	acc := s.app.AuthKeeper.NewAccountWithAddress(s.ctx, addr)
+	s.app.AuthKeeper.SetAccount(s.ctx, acc)
+
+	// Fund the account for fees
+	coins := sdk.NewCoins(sdk.NewCoin("kud", math.NewInt(1000000000000000000)))
+	require.NoError(s.app.BankKeeper.MintCoins(s.ctx, "mint", coins))
+	require.NoError(s.app.BankKeeper.SendCoinsFromModuleToAccount(s.ctx, "mint", addr, coins))
+
+	contract := sdk.AccAddress([]byte("hook_contract_address___________")).String()
+
+	// Use the contract as hook for two denoms out of three
+	var hooked []string
+	for _, subdenom := range []string{"hookone", "hooktwo", "nohook"} {
+		denom, err := s.app.TokenFactoryKeeper.CreateDenom(s.ctx, addr.String(), subdenom)
+		require.NoError(err)
+		if subdenom == "nohook" {
+			continue
+		}
+
+		_, err = s.msgServer.SetBeforeSendHook(s.ctx, tokenfactorytypes.NewMsgSetBeforeSendHook(addr.String(), denom, contract))
+		require.NoError(err, "failed to set before send hook")
+		hooked = append(hooked, denom)
+	}
+
+	// and as one of the ordered hooks of a third one, and of one already hooked
+	listed, err := s.app.TokenFactoryKeeper.CreateDenom(s.ctx, addr.String(), "hooklist")
+	require.NoError(err)
+	other := sdk.AccAddress([]byte("other_hook_contract_address_____")).String()
+	for _, denom := range []string{listed, hooked[0]} {
+		require.NoError(s.app.BeforeSendHooksKeeper.SetBeforeSendHooks(s.ctx, addr.String(), denom, []string{other, contract}))
+	}
+	hooked = append(hooked, listed)
+
+	denoms, err := s.app.GetDenomsByBeforeSendHook(s.ctx, contract)
+	require.NoError(err)
+	require.ElementsMatch(hooked, denoms)
+	denoms, err = s.app.GetDenomsByBeforeSendHook(s.ctx, addr.String())
+	require.NoError(err)
+	require.Empty(denoms)
+}
+
+// TestTokenFactoryMintTo tests minting straight to an account other than the admin with MsgMint.MintToAddress
+func (s *TokenFactoryTestSuite) TestTokenFactoryMintTo() {
+	require := s.Require()
+
+	// Create admin account
+	adminAddr := sdk.AccAddress([]byte("adminmintto_________"))
+	adminAcc := s.app.AuthKeeper.NewAccountWithAddress(s.ctx, adminAddr)
+	s.app.AuthKeeper.SetAccount(s.ctx, adminAcc)
+
+	// Fund admin for fees
+	coins := sdk.NewCoins(sdk.NewCoin("kud", math.NewInt(1000000000000000000)))
+	require.NoError(s.app.BankKeeper.MintCoins(s.ctx, "mint", coins))
+	require.NoError(s.app.BankKeeper.SendCoinsFromModuleToAccount(s.ctx, "mint", adminAddr, coins))
+
+	denom, err := s.app.TokenFactoryKeeper.CreateDenom(s.ctx, adminAddr.String(), "airdrop")
+	require.NoError(err)
+
+	recipient := sdk.AccAddress([]byte("airdroprecipient____"))
+	amount := sdk.NewCoin(denom, math.NewInt(3000000000000000000))
+	mint := tokenfactorytypes.NewMsgMintTo(adminAddr.String(), amount, recipient.String())
+	handler := s.app.MsgServiceRouter().Handler(mint)
+	require.NotNil(handler)
+	_, err = handler(s.ctx, mint)
+	require.NoError(err)
+
+	// The recipient is credited directly, the admin holds nothing
+	require.Equal(amount, s.app.BankKeeper.GetBalance(s.ctx, recipient, denom))
+	require.True(s.app.BankKeeper.GetBalance(s.ctx, adminAddr, denom).IsZero())
+
+	// Only the admin may mint
+	mint = tokenfactorytypes.NewMsgMintTo(recipient.String(), amount, recipient.String())
+	_, err = handler(s.ctx, mint)
+	require.ErrorIs(err, tokenfactorytypes.ErrUnauthorized)
+}
+
+// TestTokenFactoryIsTokenFactoryDenom tests telling tokenfactory denoms from native and IBC ones
+func (s *TokenFactoryTestSuite) TestTokenFactoryIsTokenFactoryDenom() {
+	require := s.Require()
+
+	// Create a test account
+	addr := sdk.AccAddress([]byte("addrisdenom_________"))
+	acc := s.app.AuthKeeper.NewAccountWithAddress(s.ctx, addr)
+	s.app.AuthKeeper.SetAccount(s.ctx, acc)
+
+	// Fund the account for fees
+	coins := sdk.NewCoins(sdk.NewCoin("kud", math.NewInt(1000000000000000000)))
+	require.NoError(s.app.BankKeeper.MintCoins(s.ctx, "mint", coins))
+	require.NoError(s.app.BankKeeper.SendCoinsFromModuleToAccount(s.ctx, "mint", addr, coins))
+
+	denom, err := s.app.TokenFactoryKeeper.CreateDenom(s.ctx, addr.String(), "isdenom")
+	require.NoError(err)
+
+	require.True(s.app.IsTokenFactoryDenom(s.ctx, denom))
+	require.False(s.app.IsTokenFactoryDenom(s.ctx, "kud"))
+	require.False(s.app.IsTokenFactoryDenom(s.ctx, "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"))
+
+	// well-formed but never created
+	require.False(s.app.IsTokenFactoryDenom(s.ctx, fmt.Sprintf("factory/%s/missing", addr.String())))
+}
+
+// recordingSudoer records the contracts it is called on and rejects the
+// configured ones.
+type recordingSudoer struct {
+	calls  []string
+	reject map[string]bool
+}
+
+func (r *recordingSudoer) Sudo(_ sdk.Context, contractAddress sdk.AccAddress, _ []byte) ([]byte, error) {
+	r.calls = append(r.calls, contractAddress.String())
+	if r.reject[contractAddress.String()] {
+		return nil, fmt.Errorf("transfer rejected by %s", contractAddress)
+	}
+	return nil, nil
+}
+
+// TestTokenFactoryMultipleBeforeSendHooks tests running several before-send hooks in order
+func (s *TokenFactoryTestSuite) TestTokenFactoryMultipleBeforeSendHooks() {
+	require := s.Require()
+
+	// Create a test account
+	addr := sdk.AccAddress([]byte("addrmultihook_______"))
+	acc := s.app.AuthKeeper.NewAccountWithAddress(s.ctx, addr)
+	s.app.AuthKeeper.SetAccount(s.ctx, acc)
+
+	// Fund the account for fees
+	coins := sdk.NewCoins(sdk.NewCoin("kud", math.NewInt(1000000000000000000)))
+	require.NoError(s.app.BankKeeper.MintCoins(s.ctx, "mint", coins))
+	require.NoError(s.app.BankKeeper.SendCoinsFromModuleToAccount(s.ctx, "mint", addr, coins))
+
+	denom, err := s.app.TokenFactoryKeeper.CreateDenom(s.ctx, addr.String(), "regulated")
+	require.NoError(err)
+
+	sanctions := sdk.AccAddress([]byte("sanctions_hook_contract_________")).String()
+	transferLimit := sdk.AccAddress([]byte("transfer_limit_hook_contract____")).String()
+	sudoer := &recordingSudoer{reject: map[string]bool{transferLimit: true}}
+	keeper := NewBeforeSendHooksKeeper(s.app.AppCodec(), s.app.GetKey(BeforeSendHooksStoreKey), &s.app.TokenFactoryKeeper, sudoer)
+
+	// Only the admin may set the hooks
+	err = keeper.SetBeforeSendHooks(s.ctx, sanctions, denom, []string{sanctions})
+	require.ErrorIs(err, errortypes.ErrUnauthorized)
+
+	require.NoError(keeper.SetBeforeSendHooks(s.ctx, addr.String(), denom, []string{sanctions, transferLimit}))
+	contracts, err := keeper.GetBeforeSendHooks(s.ctx, denom)
+	require.NoError(err)
+	require.Equal([]string{sanctions, transferLimit}, contracts)
+
+	// The second hook rejects the transfer after the first one ran
+	recipient := sdk.AccAddress([]byte("hookrecipient_______"))
+	_, err = keeper.BlockBeforeSend(s.ctx, addr, recipient, sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(1))))
+	require.ErrorContains(err, transferLimit)
+	require.Equal([]string{sanctions, transferLimit}, sudoer.calls)
+
+	// Transfers of other denoms don't reach the hooks
+	sudoer.calls = nil
+	_, err = keeper.BlockBeforeSend(s.ctx, addr, recipient, sdk.NewCoins(sdk.NewCoin("kud", math.NewInt(1))))
+	require.NoError(err)
+	require.Empty(sudoer.calls)
+
+	// Removing the hooks lets the transfer through
+	require.NoError(keeper.SetBeforeSendHooks(s.ctx, addr.String(), denom, nil))
+	_, err = keeper.BlockBeforeSend(s.ctx, addr, recipient, sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(1))))
+	require.NoError(err)
+	require.Empty(sudoer.calls)
+
+	// Corrupt hooks fail the transfer rather than skipping the hooks
+	s.ctx.KVStore(s.app.GetKey(BeforeSendHooksStoreKey)).Set([]byte(denom), []byte{0xff})
+	_, err = keeper.GetBeforeSendHooks(s.ctx, denom)
+	require.Error(err)
+	_, err = keeper.BlockBeforeSend(s.ctx, addr, recipient, sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(1))))
+	require.Error(err)
+}
+
+// TestTokenFactoryUnregisterBurnedPair tests deleting the ERC20 pair of a denom burned down to zero supply
+func (s *TokenFactoryTestSuite) TestTokenFactoryUnregisterBurnedPair() {
+	require := s.Require()
+	ctx, _ := s.ctx.CacheContext()
+
+	// Create a test account
+	addr := sdk.AccAddress([]byte("addrburnpair________"))
+	acc := s.app.AuthKeeper.NewAccountWithAddress(ctx, addr)
+	s.app.AuthKeeper.SetAccount(ctx, acc)
+
+	// Fund the account for fees
+	coins := sdk.NewCoins(sdk.NewCoin("kud", math.NewInt(1000000000000000000)))
+	require.NoError(s.app.BankKeeper.MintCoins(ctx, "mint", coins))
+	require.NoError(s.app.BankKeeper.SendCoinsFromModuleToAccount(ctx, "mint", addr, coins))
+
+	denom, err := s.app.TokenFactoryKeeper.CreateDenom(ctx, addr.String(), "burnpair")
+	require.NoError(err)
+
+	params := kudoratypes.DefaultParams()
+	params.TokenfactoryUnregisterBurnedPairs = true
+	require.NoError(s.app.KudoraParamsKeeper.SetParams(ctx, params))
+
+	pair, err := s.app.Erc20Keeper.RegisterERC20Extension(ctx, denom)
+	require.NoError(err)
+	contract := pair.GetERC20Contract()
+	require.True(s.app.Erc20Keeper.IsDynamicPrecompileAvailable(ctx, contract))
+
+	mint := tokenfactorytypes.NewMsgMint(addr.String(), sdk.NewCoin(denom, math.NewInt(1000)))
+	_, err = s.app.MsgServiceRouter().Handler(mint)(ctx, mint)
+	require.NoError(err)
+
+	// Burning part of the supply keeps the pair
+	burn := tokenfactorytypes.NewMsgBurn(addr.String(), sdk.NewCoin(denom, math.NewInt(400)))
+	_, err = s.app.MsgServiceRouter().Handler(burn)(ctx, burn)
+	require.NoError(err)
+	require.True(s.app.Erc20Keeper.IsDenomRegistered(ctx, denom))
+
+	// Burning the rest unregisters it along with its precompile
+	burn = tokenfactorytypes.NewMsgBurn(addr.String(), sdk.NewCoin(denom, math.NewInt(600)))
+	_, err = s.app.MsgServiceRouter().Handler(burn)(ctx, burn)
+	require.NoError(err)
+	require.False(s.app.Erc20Keeper.IsDenomRegistered(ctx, denom))
+	_, found := s.app.Erc20Keeper.GetTokenPair(ctx, pair.GetID())
+	require.False(found)
+	require.False(s.app.Erc20Keeper.IsDynamicPrecompileAvailable(ctx, contract))
+
+	// Contracts burning the supply through the bindings unregister it too
+	_, err = s.app.MsgServiceRouter().Handler(mint)(ctx, mint)
+	require.NoError(err)
+	pair, err = s.app.Erc20Keeper.RegisterERC20Extension(ctx, denom)
+	require.NoError(err)
+
+	messenger := s.bindingsMessenger(newPairCleanupMessenger(s.app.BankKeeper, &s.app.Erc20Keeper, s.app.KudoraParamsKeeper))
+	_, _, _, err = messenger.DispatchMsg(ctx, addr, "", s.tokenFactoryBindingMsg(bindingstypes.TokenMsg{
+		BurnTokens: &bindingstypes.BurnTokens{Denom: denom, Amount: math.NewInt(1000)},
+	}))
+	require.NoError(err)
+	require.False(s.app.Erc20Keeper.IsDenomRegistered(ctx, denom))
+	require.False(s.app.Erc20Keeper.IsDynamicPrecompileAvailable(ctx, pair.GetERC20Contract()))
+}
+
+// TestTokenFactoryGetDenomERC20Pair tests looking up the ERC20 representation of a denom
+func (s *TokenFactoryTestSuite) TestTokenFactoryGetDenomERC20Pair() {
+	require := s.Require()
+	ctx, _ := s.ctx.CacheContext()
+
+	// Create a test account
+	addr := sdk.AccAddress([]byte("addrdenomerc20pair__"))
+	acc := s.app.AuthKeeper.NewAccountWithAddress(ctx, addr)
+	s.app.AuthKeeper.SetAccount(ctx, acc)
+
+	// Fund the account for fees
+	coins := sdk.NewCoins(sdk.NewCoin("kud", math.NewInt(1000000000000000000)))
+	require.NoError(s.app.BankKeeper.MintCoins(ctx, "mint", coins))
+	require.NoError(s.app.BankKeeper.SendCoinsFromModuleToAccount(ctx, "mint", addr, coins))
+
+	denom, err := s.app.TokenFactoryKeeper.CreateDenom(ctx, addr.String(), "erc20pair")
+	require.NoError(err)
+
+	// No pair registered yet
+	queryClient := newTestQueryClient(s.app, ctx)
+	status, err := queryClient.DenomERC20Pair(ctx, &kudoratypes.QueryDenomERC20PairRequest{Denom: denom})
+	require.NoError(err)
+	require.Equal(&kudoratypes.QueryDenomERC20PairResponse{}, status)
+
+	contract := common.HexToAddress("0x00000000000000000000000000000000000c0c0c")
+	pair := erc20types.NewTokenPair(contract, denom, erc20types.OWNER_MODULE)
+	require.NoError(s.app.Erc20Keeper.SetToken(ctx, pair))
+
+	status, err = queryClient.DenomERC20Pair(ctx, &kudoratypes.QueryDenomERC20PairRequest{Denom: denom})
+	require.NoError(err)
+	require.Equal(&kudoratypes.QueryDenomERC20PairResponse{
+		Registered:      true,
+		ContractAddress: contract.Hex(),
+		Enabled:         true,
+	}, status)
+
+	// Denoms that were never created are rejected
+	_, err = queryClient.DenomERC20Pair(ctx, &kudoratypes.QueryDenomERC20PairRequest{Denom: "factory/" + addr.String() + "/missing"})
+	require.Error(err)
+}
+
+// TestTokenFactoryGetDenomCreator tests looking up the creator of a denom after an admin change
+func (s *TokenFactoryTestSuite) TestTokenFactoryGetDenomCreator() {
+	require := s.Require()
+
+	// Create a test account
+	creator := sdk.AccAddress([]byte("addrdenomcreator____"))
+	acc := s.app.AuthKeeper.NewAccountWithAddress(s.ctx, creator)
+	s.app.AuthKeeper.SetAccount(s.ctx, acc)
+
+	// Fund the account for fees
+	coins := sdk.NewCoins(sdk.NewCoin("kud", math.NewInt(1000000000000000000)))
+	require.NoError(s.app.BankKeeper.MintCoins(s.ctx, "mint", coins))
+	require.NoError(s.app.BankKeeper.SendCoinsFromModuleToAccount(s.ctx, "mint", creator, coins))
+
+	denom, err := s.app.TokenFactoryKeeper.CreateDenom(s.ctx, creator.String(), "handedover")
+	require.NoError(err)
+
+	// Hand the denom over to a new admin
+	newAdmin := sdk.AccAddress([]byte("newdenomadmin_______"))
+	_, err = s.msgServer.ChangeAdmin(s.ctx, tokenfactorytypes.NewMsgChangeAdmin(creator.String(), denom, newAdmin.String()))
+	require.NoError(err)
+
+	authority, err := s.app.TokenFactoryKeeper.GetAuthorityMetadata(s.ctx, denom)
+	require.NoError(err)
+	require.Equal(newAdmin.String(), authority.Admin)
+
+	got, err := s.app.GetDenomCreator(s.ctx, denom)
+	require.NoError(err)
+	require.Equal(creator.String(), got)
+
+	// Native and never created denoms have no creator
+	_, err = s.app.GetDenomCreator(s.ctx, "kud")
+	require.Error(err)
+	_, err = s.app.GetDenomCreator(s.ctx, fmt.Sprintf("factory/%s/missing", creator.String()))
+	require.ErrorIs(err, tokenfactorytypes.ErrDenomDoesNotExist)
+}
+
+// TestTokenFactoryExportGenesis tests that exporting genesis includes the
+// tokenfactory denoms and the state of the other manually registered modules
+func (s *TokenFactoryTestSuite) TestTokenFactoryExportGenesis() {
+	require := s.Require()
+	ctx, _ := s.ctx.CacheContext()
+
+	// Create a test account
+	addr := sdk.AccAddress([]byte("addrexportgenesis___"))
+	acc := s.app.AuthKeeper.NewAccountWithAddress(ctx, addr)
+	s.app.AuthKeeper.SetAccount(ctx, acc)
+
+	// Fund the account for fees
+	coins := sdk.NewCoins(sdk.NewCoin("kud", math.NewInt(1000000000000000000)))
+	require.NoError(s.app.BankKeeper.MintCoins(ctx, "mint", coins))
+	require.NoError(s.app.BankKeeper.SendCoinsFromModuleToAccount(ctx, "mint", addr, coins))
+
+	first, err := s.app.TokenFactoryKeeper.CreateDenom(ctx, addr.String(), "exportone")
+	require.NoError(err)
+	second, err := s.app.TokenFactoryKeeper.CreateDenom(ctx, addr.String(), "exporttwo")
+	require.NoError(err)
+
+	hook := sdk.AccAddress([]byte("export_hook_contract____________")).String()
+	require.NoError(s.app.BeforeSendHooksKeeper.SetBeforeSendHooks(ctx, addr.String(), first, []string{hook}))
+
+	exported, err := s.app.ModuleManager.ExportGenesisForModules(ctx, s.app.AppCodec(), nil)
+	require.NoError(err)
+	for _, name := range []string{
+		tokenfactorytypes.ModuleName,
+		ratelimittypes.ModuleName,
+		packetforwardtypes.ModuleName,
+		KudoraModuleName,
+	} {
+		require.Contains(exported, name)
+	}
+
+	var tokenFactoryGenesis tokenfactorytypes.GenesisState
+	s.app.AppCodec().MustUnmarshalJSON(exported[tokenfactorytypes.ModuleName], &tokenFactoryGenesis)
+	admins := make(map[string]string)
+	for _, denom := range tokenFactoryGenesis.FactoryDenoms {
+		admins[denom.Denom] = denom.AuthorityMetadata.Admin
+	}
+	require.Equal(addr.String(), admins[first])
+	require.Equal(addr.String(), admins[second])
+
+	var kudoraGenesis kudoratypes.GenesisState
+	require.NoError(s.app.AppCodec().UnmarshalJSON(exported[KudoraModuleName], &kudoraGenesis))
+	require.Contains(kudoraGenesis.BeforeSendHooks, kudoratypes.DenomBeforeSendHooks{Denom: first, Contracts: []string{hook}})
+
+	// The exported hooks are restored on import
+	importCtx, _ := s.ctx.CacheContext()
+	s.app.ModuleManager.Modules[KudoraModuleName].(module.HasGenesis).InitGenesis(importCtx, s.app.AppCodec(), exported[KudoraModuleName])
+	importedHooks, err := s.app.BeforeSendHooksKeeper.GetBeforeSendHooks(importCtx, first)
+	require.NoError(err)
+	require.Equal([]string{hook}, importedHooks)
+}
+
+// TestTokenFactoryLockDenomMetadata tests that locked denom metadata can't be updated anymore
+func (s *TokenFactoryTestSuite) TestTokenFactoryLockDenomMetadata() {
+	require := s.Require()
+	ctx, _ := s.ctx.CacheContext()
+
+	// Create a test account
+	addr := sdk.AccAddress([]byte("addrmetadatalock____"))
+	acc := s.app.AuthKeeper.NewAccountWithAddress(ctx, addr)
+	s.app.AuthKeeper.SetAccount(ctx, acc)
+
+	// Fund the account for fees
+	coins := sdk.NewCoins(sdk.NewCoin("kud", math.NewInt(1000000000000000000)))
+	require.NoError(s.app.BankKeeper.MintCoins(ctx, "mint", coins))
+	require.NoError(s.app.BankKeeper.SendCoinsFromModuleToAccount(ctx, "mint", addr, coins))
+
+	denom, err := s.app.TokenFactoryKeeper.CreateDenom(ctx, addr.String(), "finalized")
+	require.NoError(err)
+
+	metadata := banktypes.Metadata{
+		Description: "Finalized Token",
+		DenomUnits:  []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+		Base:        denom,
+		Display:     denom,
+		Name:        "Finalized Token",
+		Symbol:      "FIN",
+	}
+	msgServer := newMetadataLockMsgServer(s.msgServer, s.app.DenomMetadataLockKeeper)
+
+	// Metadata can be updated until it is locked
+	_, err = msgServer.SetDenomMetadata(ctx, tokenfactorytypes.NewMsgSetDenomMetadata(addr.String(), metadata))
+	require.NoError(err)
+	require.False(s.app.DenomMetadataLockKeeper.IsDenomMetadataLocked(ctx, denom))
+
+	// Only the admin may lock the metadata
+	other := sdk.AccAddress([]byte("notthedenomadmin____"))
+	lock := &kudoratypes.MsgLockDenomMetadata{Sender: other.String(), Denom: denom}
+	handler := s.app.MsgServiceRouter().Handler(lock)
+	require.NotNil(handler)
+	_, err = handler(ctx, lock)
+	require.ErrorIs(err, errortypes.ErrUnauthorized)
+
+	lock.Sender = addr.String()
+	_, err = handler(ctx, lock)
+	require.NoError(err)
+	require.True(s.app.DenomMetadataLockKeeper.IsDenomMetadataLocked(ctx, denom))
+
+	// Later updates are rejected, even from the admin
+	updated := metadata
+	updated.Description = "Changed Token"
+	_, err = msgServer.SetDenomMetadata(ctx, tokenfactorytypes.NewMsgSetDenomMetadata(addr.String(), updated))
+	require.ErrorIs(err, errortypes.ErrUnauthorized)
+
+	// including for the admin contracts updating it through the bindings
+	messenger := s.bindingsMessenger(newMetadataLockMessenger(s.app.DenomMetadataLockKeeper))
+	_, _, _, err = messenger.DispatchMsg(ctx, addr, "", s.tokenFactoryBindingMsg(bindingstypes.TokenMsg{
+		SetMetadata: &bindingstypes.SetMetadata{
+			Denom: denom,
+			Metadata: bindingstypes.Metadata{
+				Description: updated.Description,
+				DenomUnits:  []bindingstypes.DenomUnit{{Denom: denom, Exponent: 0}},
+				Base:        denom,
+				Display:     denom,
+				Name:        updated.Name,
+				Symbol:      updated.Symbol,
+			},
+		},
+	}))
+	require.ErrorIs(err, errortypes.ErrUnauthorized)
+
+	stored, found := s.app.BankKeeper.GetDenomMetaData(ctx, denom)
+	require.True(found)
+	require.Equal(metadata.Description, stored.Description)
+}
+
+// TestTokenFactoryUniqueSymbols tests rejecting metadata reusing the symbol of another denom
+func (s *TokenFactoryTestSuite) TestTokenFactoryUniqueSymbols() {
+	require := s.Require()
+	ctx, _ := s.ctx.CacheContext()
+
+	// Create a test account
+	addr := sdk.AccAddress([]byte("addruniquesymbols___"))
+	acc := s.app.AuthKeeper.NewAccountWithAddress(ctx, addr)
+	s.app.AuthKeeper.SetAccount(ctx, acc)
+
+	// Fund the account for fees
+	coins := sdk.NewCoins(sdk.NewCoin("kud", math.NewInt(1000000000000000000)))
+	require.NoError(s.app.BankKeeper.MintCoins(ctx, "mint", coins))
+	require.NoError(s.app.BankKeeper.SendCoinsFromModuleToAccount(ctx, "mint", addr, coins))
+
+	original, err := s.app.TokenFactoryKeeper.CreateDenom(ctx, addr.String(), "original")
+	require.NoError(err)
+	copycat, err := s.app.TokenFactoryKeeper.CreateDenom(ctx, addr.String(), "copycat")
+	require.NoError(err)
+
+	metadata := func(denom, symbol string) *tokenfactorytypes.MsgSetDenomMetadata {
+		return tokenfactorytypes.NewMsgSetDenomMetadata(addr.String(), banktypes.Metadata{
+			DenomUnits: []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+			Base:       denom,
+			Display:    denom,
+			Name:       symbol + " Token",
+			Symbol:     symbol,
+		})
+	}
+	msgServer := newUniqueSymbolMsgServer(s.msgServer, s.app.GetKey(DenomSymbolsStoreKey), s.app.BankKeeper, s.app.KudoraParamsKeeper)
+
+	// Symbols are indexed while the check is disabled
+	_, err = msgServer.SetDenomMetadata(ctx, metadata(original, "USDK"))
+	require.NoError(err)
+
+	params := kudoratypes.DefaultParams()
+	params.TokenfactoryUniqueSymbols = true
+	require.NoError(s.app.KudoraParamsKeeper.SetParams(ctx, params))
+
+	// The same symbol, whatever its case, is rejected for another denom
+	_, err = msgServer.SetDenomMetadata(ctx, metadata(copycat, "USDK"))
+	require.ErrorIs(err, errortypes.ErrInvalidRequest)
+	_, err = msgServer.SetDenomMetadata(ctx, metadata(copycat, "usdk"))
+	require.ErrorIs(err, errortypes.ErrInvalidRequest)
+
+	// The owner of a symbol may keep it, and frees it by changing it
+	_, err = msgServer.SetDenomMetadata(ctx, metadata(original, "USDK"))
+	require.NoError(err)
+	_, err = msgServer.SetDenomMetadata(ctx, metadata(original, "ORIG"))
+	require.NoError(err)
+	_, err = msgServer.SetDenomMetadata(ctx, metadata(copycat, "USDK"))
+	require.NoError(err)
+
+	// Contracts setting metadata through the bindings are checked the same way
+	messenger := s.bindingsMessenger(newUniqueSymbolMessenger(s.app.GetKey(DenomSymbolsStoreKey), s.app.BankKeeper, s.app.KudoraParamsKeeper))
+	bindingMetadata := func(denom, symbol string) wasmvmtypes.CosmosMsg {
+		return s.tokenFactoryBindingMsg(bindingstypes.TokenMsg{
+			SetMetadata: &bindingstypes.SetMetadata{
+				Denom: denom,
+				Metadata: bindingstypes.Metadata{
+					DenomUnits: []bindingstypes.DenomUnit{{Denom: denom, Exponent: 0}},
+					Base:       denom,
+					Display:    denom,
+					Name:       symbol + " Token",
+					Symbol:     symbol,
+				},
+			},
+		})
+	}
+	_, _, _, err = messenger.DispatchMsg(ctx, addr, "", bindingMetadata(original, "usdk"))
+	require.ErrorIs(err, errortypes.ErrInvalidRequest)
+	_, _, _, err = messenger.DispatchMsg(ctx, addr, "", bindingMetadata(original, "WASM"))
+	require.NoError(err)
+	_, err = msgServer.SetDenomMetadata(ctx, metadata(copycat, "WASM"))
+	require.ErrorIs(err, errortypes.ErrInvalidRequest)
+}
+
+// TestTokenFactoryMintPause tests pausing and resuming the minting of a denom
+func (s *TokenFactoryTestSuite) TestTokenFactoryMintPause() {
+	require := s.Require()
+	ctx, _ := s.ctx.CacheContext()
+
+	// Create a test account
+	addr := sdk.AccAddress([]byte("addrmintpause_______"))
+	acc := s.app.AuthKeeper.NewAccountWithAddress(ctx, addr)
+	s.app.AuthKeeper.SetAccount(ctx, acc)
+
+	// Fund the account for fees
+	coins := sdk.NewCoins(sdk.NewCoin("kud", math.NewInt(1000000000000000000)))
+	require.NoError(s.app.BankKeeper.MintCoins(ctx, "mint", coins))
+	require.NoError(s.app.BankKeeper.SendCoinsFromModuleToAccount(ctx, "mint", addr, coins))
+
+	denom, err := s.app.TokenFactoryKeeper.CreateDenom(ctx, addr.String(), "pausable")
+	require.NoError(err)
+
+	msgServer := newMintPauseMsgServer(s.msgServer, s.app.DenomMintPauseKeeper)
+	mint := tokenfactorytypes.NewMsgMint(addr.String(), sdk.NewCoin(denom, math.NewInt(1000)))
+	_, err = msgServer.Mint(ctx, mint)
+	require.NoError(err)
+
+	// Only the admin may pause minting
+	other := sdk.AccAddress([]byte("notthemintadmin_____"))
+	pause := &kudoratypes.MsgSetMintPaused{Sender: other.String(), Denom: denom, Paused: true}
+	handler := s.app.MsgServiceRouter().Handler(pause)
+	require.NotNil(handler)
+	_, err = handler(ctx, pause)
+	require.ErrorIs(err, errortypes.ErrUnauthorized)
+	pause.Sender = addr.String()
+	_, err = handler(ctx, pause)
+	require.NoError(err)
+
+	// Minting fails while transfers still go through
+	_, err = msgServer.Mint(ctx, mint)
+	require.ErrorIs(err, errortypes.ErrUnauthorized)
+
+	// including for the admin contracts minting through the bindings
+	messenger := s.bindingsMessenger(newMintPauseMessenger(s.app.DenomMintPauseKeeper))
+	_, _, _, err = messenger.DispatchMsg(ctx, addr, "", s.tokenFactoryBindingMsg(bindingstypes.TokenMsg{
+		MintTokens: &bindingstypes.MintTokens{Denom: denom, Amount: math.NewInt(1000), MintToAddress: addr.String()},
+	}))
+	require.ErrorIs(err, errortypes.ErrUnauthorized)
+
+	recipient := sdk.AccAddress([]byte("mintpauserecipient__"))
+	require.NoError(s.app.BankKeeper.SendCoins(ctx, addr, recipient, sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(400)))))
+	require.Equal(math.NewInt(400), s.app.BankKeeper.GetBalance(ctx, recipient, denom).Amount)
+
+	// Minting resumes once unpaused
+	pause.Paused = false
+	_, err = handler(ctx, pause)
+	require.NoError(err)
+	_, err = msgServer.Mint(ctx, mint)
+	require.NoError(err)
+	require.Equal(math.NewInt(1600), s.app.BankKeeper.GetBalance(ctx, addr, denom).Amount)
+}
+
+// bindingsMessenger returns the messenger of the tokenfactory custom bindings
+// contracts dispatch their messages to, wrapped by decorators.
+func (s *TokenFactoryTestSuite) bindingsMessenger(decorators ...func(wasmkeeper.Messenger) wasmkeeper.Messenger) wasmkeeper.Messenger {
+	messenger := bindings.CustomMessageDecorator(s.app.BankKeeper, &s.app.TokenFactoryKeeper)(nil)
+	for _, decorate := range decorators {
+		messenger = decorate(messenger)
+	}
+	return messenger
+}
+
+// tokenFactoryBindingMsg returns the custom message a contract sends to the
+// tokenfactory bindings.
+func (s *TokenFactoryTestSuite) tokenFactoryBindingMsg(tokenMsg bindingstypes.TokenMsg) wasmvmtypes.CosmosMsg {
+	bz, err := json.Marshal(bindingstypes.TokenFactoryMsg{Token: &tokenMsg})
+	s.Require().NoError(err)
+	return wasmvmtypes.CosmosMsg{Custom: bz}
+}
+
+func (s *TokenFactoryTestSuite) TestTokenFactoryAdminHistory() {
+	require := s.Require()
+	ctx, _ := s.ctx.CacheContext()
+	ctx = ctx.WithBlockHeight(42)
+
+	// Create a test account
+	addr := sdk.AccAddress([]byte("addradminhistory____"))
+	acc := s.app.AuthKeeper.NewAccountWithAddress(ctx, addr)
+	s.app.AuthKeeper.SetAccount(ctx, acc)
+
+	// Fund the account for fees
+	coins := sdk.NewCoins(sdk.NewCoin("kud", math.NewInt(1000000000000000000)))
+	require.NoError(s.app.BankKeeper.MintCoins(ctx, "mint", coins))
+	require.NoError(s.app.BankKeeper.SendCoinsFromModuleToAccount(ctx, "mint", addr, coins))
+
+	denom, err := s.app.TokenFactoryKeeper.CreateDenom(ctx, addr.String(), "audited")
+	require.NoError(err)
+
+	// No history before any change
+	authority, err := s.app.DenomAdminHistoryKeeper.GetDenomAuthority(ctx, denom)
+	require.NoError(err)
+	require.Equal(addr.String(), authority.Admin)
+	require.Empty(authority.History)
+
+	newAdmin := sdk.AccAddress([]byte("newdenomadmin_______"))
+	msgServer := newAdminHistoryMsgServer(s.msgServer, s.app.DenomAdminHistoryKeeper)
+	_, err = msgServer.ChangeAdmin(ctx, tokenfactorytypes.NewMsgChangeAdmin(addr.String(), denom, newAdmin.String()))
+	require.NoError(err)
+
+	// Failed changes are not recorded
+	_, err = msgServer.ChangeAdmin(ctx, tokenfactorytypes.NewMsgChangeAdmin(addr.String(), denom, addr.String()))
+	require.Error(err)
+
+	// Changes made by contracts through the bindings are recorded too
+	messenger := s.bindingsMessenger(newAdminHistoryMessenger(s.app.DenomAdminHistoryKeeper))
+	_, _, _, err = messenger.DispatchMsg(ctx.WithBlockHeight(43), newAdmin, "", s.tokenFactoryBindingMsg(bindingstypes.TokenMsg{
+		ChangeAdmin: &bindingstypes.ChangeAdmin{Denom: denom, NewAdminAddress: addr.String()},
+	}))
+	require.NoError(err)
+
+	authority, err = s.app.DenomAdminHistoryKeeper.GetDenomAuthority(ctx, denom)
+	require.NoError(err)
+	require.Equal(DenomAuthority{
+		Denom: denom,
+		Admin: addr.String(),
+		History: []kudoratypes.DenomAdminChange{
+			{Height: 42, OldAdmin: addr.String(), NewAdmin: newAdmin.String()},
+			{Height: 43, OldAdmin: newAdmin.String(), NewAdmin: addr.String()},
+		},
+	}, authority)
+
+	// Every change is stored as its own entry
+	store := ctx.KVStore(s.app.GetKey(DenomAdminHistoryStoreKey))
+	require.True(store.Has(adminChangeKey(denom, 0)))
+	require.True(store.Has(adminChangeKey(denom, 1)))
+	require.Contains(s.app.DenomAdminHistoryKeeper.GetAllAdminHistories(ctx), kudoratypes.DenomAdminHistory{
+		Denom:   denom,
+		Changes: authority.History,
+	})
+}
+
+// TestTokenFactoryCumulativeBurns tests that burns accumulate per denom
+func (s *TokenFactoryTestSuite) TestTokenFactoryCumulativeBurns() {
+	require := s.Require()
+	ctx, _ := s.ctx.CacheContext()
+
+	// Create a test account
+	addr := sdk.AccAddress([]byte("addrcumulativeburns_"))
+	acc := s.app.AuthKeeper.NewAccountWithAddress(ctx, addr)
+	s.app.AuthKeeper.SetAccount(ctx, acc)
+
+	// Fund the account for fees
+	coins := sdk.NewCoins(sdk.NewCoin("kud", math.NewInt(1000000000000000000)))
+	require.NoError(s.app.BankKeeper.MintCoins(ctx, "mint", coins))
+	require.NoError(s.app.BankKeeper.SendCoinsFromModuleToAccount(ctx, "mint", addr, coins))
+
+	denom, err := s.app.TokenFactoryKeeper.CreateDenom(ctx, addr.String(), "burnable")
+	require.NoError(err)
+
+	// Nothing burned before any burn
+	burned, err := s.app.DenomBurnKeeper.GetBurned(ctx, denom)
+	require.NoError(err)
+	require.True(burned.IsZero())
+
+	_, err = s.msgServer.Mint(ctx, tokenfactorytypes.NewMsgMint(addr.String(), sdk.NewCoin(denom, math.NewInt(1000))))
+	require.NoError(err)
+
+	msgServer := newBurnTrackingMsgServer(s.msgServer, s.app.DenomBurnKeeper)
+	_, err = msgServer.Burn(ctx, tokenfactorytypes.NewMsgBurn(addr.String(), sdk.NewCoin(denom, math.NewInt(100))))
+	require.NoError(err)
+	_, err = msgServer.Burn(ctx, tokenfactorytypes.NewMsgBurn(addr.String(), sdk.NewCoin(denom, math.NewInt(250))))
+	require.NoError(err)
+
+	// Failed burns are not recorded
+	_, err = msgServer.Burn(ctx, tokenfactorytypes.NewMsgBurn(addr.String(), sdk.NewCoin(denom, math.NewInt(5000))))
+	require.Error(err)
+
+	// Burns of contracts through the bindings are recorded too
+	messenger := s.bindingsMessenger(newBurnTrackingMessenger(s.app.DenomBurnKeeper))
+	_, _, _, err = messenger.DispatchMsg(ctx, addr, "", s.tokenFactoryBindingMsg(bindingstypes.TokenMsg{
+		BurnTokens: &bindingstypes.BurnTokens{Denom: denom, Amount: math.NewInt(50)},
+	}))
+	require.NoError(err)
+
+	burned, err = s.app.DenomBurnKeeper.GetBurned(ctx, denom)
+	require.NoError(err)
+	require.Equal(math.NewInt(400), burned)
+	require.Equal(math.NewInt(600), s.app.BankKeeper.GetSupply(ctx, denom).Amount)
+
+	all, err := s.app.DenomBurnKeeper.GetAllBurned(ctx)
+	require.NoError(err)
+	require.Contains(all, kudoratypes.DenomBurned{Denom: denom, Amount: math.NewInt(400)})
+}
+
+// TestTokenFactoryGetDenomBalances tests fetching the balances of several denoms at once
+func (s *TokenFactoryTestSuite) TestTokenFactoryGetDenomBalances() {
+	require := s.Require()
+	ctx, _ := s.ctx.CacheContext()
+
+	// Create a test account
+	addr := sdk.AccAddress([]byte("addrbatchbalances___"))
+	acc := s.app.AuthKeeper.NewAccountWithAddress(ctx, addr)
+	s.app.AuthKeeper.SetAccount(ctx, acc)
+
+	// Fund the account for fees
+	coins := sdk.NewCoins(sdk.NewCoin("kud", math.NewInt(1000000000000000000)))
+	require.NoError(s.app.BankKeeper.MintCoins(ctx, "mint", coins))
+	require.NoError(s.app.BankKeeper.SendCoinsFromModuleToAccount(ctx, "mint", addr, coins))
+
+	// Mint a different amount of several denoms
+	var denoms []string
+	for i, subdenom := range []string{"batcha", "batchb", "batchc"} {
+		denom, err := s.app.TokenFactoryKeeper.CreateDenom(ctx, addr.String(), subdenom)
+		require.NoError(err)
+		denoms = append(denoms, denom)
+
+		_, err = s.msgServer.Mint(ctx, tokenfactorytypes.NewMsgMint(addr.String(), sdk.NewCoin(denom, math.NewInt(int64(100*(i+1))))))
+		require.NoError(err)
+	}
+
+	// Balances come in the requested order, zero ones included
+	missing := fmt.Sprintf("factory/%s/missing", addr.String())
+	balances := s.app.GetDenomBalances(ctx, addr, []string{denoms[2], missing, denoms[0], denoms[1]})
+	require.Equal([]sdk.Coin{
+		sdk.NewCoin(denoms[2], math.NewInt(300)),
+		sdk.NewCoin(missing, math.ZeroInt()),
+		sdk.NewCoin(denoms[0], math.NewInt(100)),
+		sdk.NewCoin(denoms[1], math.NewInt(200)),
+	}, balances)
+}
+
+func (s *TokenFactoryTestSuite) TestTokenFactoryGetDenomSummary() {
+	require := s.Require()
+	ctx, _ := s.ctx.CacheContext()
+
+	// Create test accounts
+	creator := sdk.AccAddress([]byte("addrsummarycreator__"))
+	admin := sdk.AccAddress([]byte("addrsummaryadmin____"))
+	for _, addr := range []sdk.AccAddress{creator, admin} {
+		acc := s.app.AuthKeeper.NewAccountWithAddress(ctx, addr)
+		s.app.AuthKeeper.SetAccount(ctx, acc)
+	}
+
+	// Fund the creator for fees
+	coins := sdk.NewCoins(sdk.NewCoin("kud", math.NewInt(1000000000000000000)))
+	require.NoError(s.app.BankKeeper.MintCoins(ctx, "mint", coins))
+	require.NoError(s.app.BankKeeper.SendCoinsFromModuleToAccount(ctx, "mint", creator, coins))
+
+	// Create, mint and hand the denom over to another admin
+	denom, err := s.app.TokenFactoryKeeper.CreateDenom(ctx, creator.String(), "summary")
+	require.NoError(err)
+	_, err = s.msgServer.Mint(ctx, tokenfactorytypes.NewMsgMint(creator.String(), sdk.NewCoin(denom, math.NewInt(500))))
+	require.NoError(err)
+	_, err = s.msgServer.ChangeAdmin(ctx, tokenfactorytypes.NewMsgChangeAdmin(creator.String(), denom, admin.String()))
+	require.NoError(err)
+
+	// Freeze its metadata, pause minting and add a hook
+	hook := sdk.AccAddress([]byte("addrsummaryhook_____")).String()
+	require.NoError(s.app.DenomMetadataLockKeeper.LockDenomMetadata(ctx, admin.String(), denom))
+	require.NoError(s.app.DenomMintPauseKeeper.SetMintPaused(ctx, admin.String(), denom, true))
+	require.NoError(s.app.BeforeSendHooksKeeper.SetBeforeSendHooks(ctx, admin.String(), denom, []string{hook}))
+
+	queryClient := newTestQueryClient(s.app, ctx)
+	res, err := queryClient.DenomSummary(ctx, &kudoratypes.QueryDenomSummaryRequest{Denom: denom})
+	require.NoError(err)
+	summary := res.Summary
+	require.Equal(denom, summary.Denom)
+	require.Equal(creator.String(), summary.Creator)
+	require.Equal(admin.String(), summary.Admin)
+	require.Equal(sdk.NewCoin(denom, math.NewInt(500)), summary.Supply)
+	require.Equal(denom, summary.Metadata.Base)
+	require.True(summary.MetadataLocked)
+	require.True(summary.MintPaused)
+	require.Equal([]string{hook}, summary.BeforeSendHooks)
+
+	// Unknown denoms have no summary
+	_, err = queryClient.DenomSummary(ctx, &kudoratypes.QueryDenomSummaryRequest{
+		Denom: fmt.Sprintf("factory/%s/missing", creator.String()),
+	})
+	require.ErrorIs(err, tokenfactorytypes.ErrDenomDoesNotExist)
+}
+
+func (s *TokenFactoryTestSuite) TestTokenFactoryGetAdminSupply() {
+	require := s.Require()
+	ctx, _ := s.ctx.CacheContext()
+
+	// Create test accounts
+	admin := sdk.AccAddress([]byte("addradminsupply_____"))
+	other := sdk.AccAddress([]byte("addradminsupplyother"))
+	coins := sdk.NewCoins(sdk.NewCoin("kud", math.NewInt(1000000000000000000)))
+	for _, addr := range []sdk.AccAddress{admin, other} {
+		acc := s.app.AuthKeeper.NewAccountWithAddress(ctx, addr)
+		s.app.AuthKeeper.SetAccount(ctx, acc)
+
+		// Fund the account for fees
+		require.NoError(s.app.BankKeeper.MintCoins(ctx, "mint", coins))
+		require.NoError(s.app.BankKeeper.SendCoinsFromModuleToAccount(ctx, "mint", addr, coins))
+	}
+
+	// The admin mints two denoms, the other account one which it hands over
+	// to the admin, and keeps one for itself
+	mint := func(creator sdk.AccAddress, subdenom string, amount int64) string {
+		denom, err := s.app.TokenFactoryKeeper.CreateDenom(ctx, creator.String(), subdenom)
+		require.NoError(err)
+		_, err = s.msgServer.Mint(ctx, tokenfactorytypes.NewMsgMint(creator.String(), sdk.NewCoin(denom, math.NewInt(amount))))
+		require.NoError(err)
+		return denom
+	}
+	first := mint(admin, "supplya", 100)
+	second := mint(admin, "supplyb", 200)
+	handedOver := mint(other, "supplyc", 300)
+	kept := mint(other, "supplyd", 400)
+	_, err := s.msgServer.ChangeAdmin(ctx, tokenfactorytypes.NewMsgChangeAdmin(other.String(), handedOver, admin.String()))
+	require.NoError(err)
+
+	queryClient := newTestQueryClient(s.app, ctx)
+	denoms, err := queryClient.DenomsByAdmin(ctx, &kudoratypes.QueryDenomsByAdminRequest{Admin: admin.String()})
+	require.NoError(err)
+	require.ElementsMatch([]string{first, second, handedOver}, denoms.Denoms)
+
+	supply, err := queryClient.AdminSupply(ctx, &kudoratypes.QueryAdminSupplyRequest{Admin: admin.String()})
+	require.NoError(err)
+	require.ElementsMatch([]string{first, second, handedOver}, supply.Denoms)
+	require.Equal(sdk.NewCoins(
+		sdk.NewCoin(first, math.NewInt(100)),
+		sdk.NewCoin(second, math.NewInt(200)),
+		sdk.NewCoin(handedOver, math.NewInt(300)),
+	), supply.Supply)
+	require.NotContains(supply.Denoms, kept)
+
+	// Accounts without denoms control no supply
+	empty, err := queryClient.AdminSupply(ctx, &kudoratypes.QueryAdminSupplyRequest{
+		Admin: sdk.AccAddress([]byte("addradminsupplynone_")).String(),
+	})
+	require.NoError(err)
+	require.Empty(empty.Denoms)
+	require.True(empty.Supply.IsZero())
+}
+
+func (s *TokenFactoryTestSuite) TestTokenFactorySendEnabled() {
+	require := s.Require()
+	ctx, _ := s.ctx.CacheContext()
+	require.NoError(s.app.BankKeeper.SetParams(ctx, banktypes.DefaultParams()))
+
+	// Create test accounts
+	addr := sdk.AccAddress([]byte("addrsendenabled_____"))
+	recipient := sdk.AccAddress([]byte("addrsendenabledrecv_"))
+	acc := s.app.AuthKeeper.NewAccountWithAddress(ctx, addr)
+	s.app.AuthKeeper.SetAccount(ctx, acc)
+
+	// Fund the account for fees
+	coins := sdk.NewCoins(sdk.NewCoin("kud", math.NewInt(1000000000000000000)))
+	require.NoError(s.app.BankKeeper.MintCoins(ctx, "mint", coins))
+	require.NoError(s.app.BankKeeper.SendCoinsFromModuleToAccount(ctx, "mint", addr, coins))
+
+	// Create and mint a denom
+	denom, err := s.app.TokenFactoryKeeper.CreateDenom(ctx, addr.String(), "sendtoggle")
+	require.NoError(err)
+	_, err = s.msgServer.Mint(ctx, tokenfactorytypes.NewMsgMint(addr.String(), sdk.NewCoin(denom, math.NewInt(1000))))
+	require.NoError(err)
+
+	bankMsgServer := bankkeeper.NewMsgServerImpl(s.app.BankKeeper)
+	send := banktypes.NewMsgSend(addr, recipient, sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(100))))
+
+	// Only the admin may disable sends
+	disable := &kudoratypes.MsgSetDenomSendEnabled{Sender: recipient.String(), Denom: denom, Enabled: false}
+	handler := s.app.MsgServiceRouter().Handler(disable)
+	require.NotNil(handler)
+	_, err = handler(ctx, disable)
+	require.ErrorIs(err, errortypes.ErrUnauthorized)
+	require.True(s.app.DenomSendEnabledKeeper.IsSendEnabled(ctx, denom))
+
+	// Sends fail while disabled
+	disable.Sender = addr.String()
+	_, err = handler(ctx, disable)
+	require.NoError(err)
+	require.False(s.app.DenomSendEnabledKeeper.IsSendEnabled(ctx, denom))
+	_, err = bankMsgServer.Send(ctx, send)
+	require.ErrorIs(err, banktypes.ErrSendDisabled)
+
+	// And go through again once re-enabled
+	enable := &kudoratypes.MsgSetDenomSendEnabled{Sender: addr.String(), Denom: denom, Enabled: true}
+	_, err = handler(ctx, enable)
+	require.NoError(err)
+	require.True(s.app.DenomSendEnabledKeeper.IsSendEnabled(ctx, denom))
+	_, err = bankMsgServer.Send(ctx, send)
+	require.NoError(err)
+	require.Equal(math.NewInt(100), s.app.BankKeeper.GetBalance(ctx, recipient, denom).Amount)
+
+	// The admin toggle leaves the bank send-enabled entries to governance
+	_, err = handler(ctx, disable)
+	require.NoError(err)
+	_, found := s.app.BankKeeper.GetSendEnabledEntry(ctx, denom)
+	require.False(found)
+
+	// Mints and burns still go through while disabled
+	_, err = s.msgServer.Mint(ctx, tokenfactorytypes.NewMsgMint(addr.String(), sdk.NewCoin(denom, math.NewInt(10))))
+	require.NoError(err)
+	_, err = s.msgServer.Burn(ctx, tokenfactorytypes.NewMsgBurn(addr.String(), sdk.NewCoin(denom, math.NewInt(10))))
+	require.NoError(err)
+
+	// A governance freeze holds whatever the admin does
+	s.app.BankKeeper.SetSendEnabled(ctx, denom, false)
+	_, err = handler(ctx, enable)
+	require.NoError(err)
+	require.False(s.app.DenomSendEnabledKeeper.IsDisabledByAdmin(ctx, denom))
+	require.False(s.app.DenomSendEnabledKeeper.IsSendEnabled(ctx, denom))
+	_, err = bankMsgServer.Send(ctx, send)
+	require.ErrorIs(err, banktypes.ErrSendDisabled)
+
+	// And once governance lifts it, the admin toggle still applies
+	_, err = handler(ctx, disable)
+	require.NoError(err)
+	s.app.BankKeeper.SetSendEnabled(ctx, denom, true)
+	require.False(s.app.DenomSendEnabledKeeper.IsSendEnabled(ctx, denom))
+	_, err = bankMsgServer.Send(ctx, send)
+	require.ErrorIs(err, banktypes.ErrSendDisabled)
+}
+
+func (s *TokenFactoryTestSuite) TestTokenFactoryAdminChangeCooldown() {
+	require := s.Require()
+	ctx, _ := s.ctx.CacheContext()
+	ctx = ctx.WithBlockHeight(42)
+
+	// Create a test account
+	addr := sdk.AccAddress([]byte("addradmincooldown___"))
+	acc := s.app.AuthKeeper.NewAccountWithAddress(ctx, addr)
+	s.app.AuthKeeper.SetAccount(ctx, acc)
+
+	// Fund the account for fees
+	coins := sdk.NewCoins(sdk.NewCoin("kud", math.NewInt(1000000000000000000)))
+	require.NoError(s.app.BankKeeper.MintCoins(ctx, "mint", coins))
+	require.NoError(s.app.BankKeeper.SendCoinsFromModuleToAccount(ctx, "mint", addr, coins))
+
+	denom, err := s.app.TokenFactoryKeeper.CreateDenom(ctx, addr.String(), "cooldown")
+	require.NoError(err)
+
+	params := kudoratypes.DefaultParams()
+	params.TokenfactoryAdminChangeCooldownBlocks = 10
+	require.NoError(s.app.KudoraParamsKeeper.SetParams(ctx, params))
+
+	history := s.app.DenomAdminHistoryKeeper
+	msgServer := newAdminCooldownMsgServer(newAdminHistoryMsgServer(s.msgServer, history), history, s.app.KudoraParamsKeeper)
+
+	// The first change is not limited
+	newAdmin := sdk.AccAddress([]byte("cooldownnewadmin____"))
+	_, err = msgServer.ChangeAdmin(ctx, tokenfactorytypes.NewMsgChangeAdmin(addr.String(), denom, newAdmin.String()))
+	require.NoError(err)
+
+	// Changing it back within the cooldown fails
+	changeBack := tokenfactorytypes.NewMsgChangeAdmin(newAdmin.String(), denom, addr.String())
+	_, err = msgServer.ChangeAdmin(ctx.WithBlockHeight(51), changeBack)
+	require.ErrorIs(err, errortypes.ErrUnauthorized)
+
+	// And succeeds once it is over
+	_, err = msgServer.ChangeAdmin(ctx.WithBlockHeight(52), changeBack)
+	require.NoError(err)
+
+	authority, err := history.GetDenomAuthority(ctx, denom)
+	require.NoError(err)
+	require.Equal(addr.String(), authority.Admin)
+	require.Len(authority.History, 2)
+
+	// Contracts changing the admin through the bindings are limited too
+	messenger := s.bindingsMessenger(
+		newAdminHistoryMessenger(history),
+		newAdminCooldownMessenger(history, s.app.KudoraParamsKeeper),
+	)
+	bindingChange := s.tokenFactoryBindingMsg(bindingstypes.TokenMsg{
+		ChangeAdmin: &bindingstypes.ChangeAdmin{Denom: denom, NewAdminAddress: newAdmin.String()},
+	})
+	_, _, _, err = messenger.DispatchMsg(ctx.WithBlockHeight(61), addr, "", bindingChange)
+	require.ErrorIs(err, errortypes.ErrUnauthorized)
+	_, _, _, err = messenger.DispatchMsg(ctx.WithBlockHeight(62), addr, "", bindingChange)
+	require.NoError(err)
+}
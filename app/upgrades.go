@@ -0,0 +1,52 @@
+package app
+
+import (
+	"context"
+
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"kudora/app/middleware"
+)
+
+// UpgradeName is the software upgrade adding the stores of Kudora's app-local
+// extensions to a running chain.
+const UpgradeName = "v2.1"
+
+// upgradeAddedStores are the stores created by UpgradeName. Their keepers
+// fall back to their defaults on empty stores, so nothing is migrated.
+var upgradeAddedStores = []string{
+	KudoraStoreKey,
+	middleware.StoreKey,
+	BeforeSendHooksStoreKey,
+	DenomMetadataLocksStoreKey,
+	DenomSymbolsStoreKey,
+	DenomMintPausesStoreKey,
+	DenomAdminHistoryStoreKey,
+	DenomBurnsStoreKey,
+	DenomSendDisablesStoreKey,
+}
+
+// setUpgradeHandlers registers the upgrade handlers and, when the node is
+// restarted at an upgrade height, the store loader adding its stores. It must
+// run before the stores are loaded.
+func (app *App) setUpgradeHandlers() error {
+	app.UpgradeKeeper.SetUpgradeHandler(
+		UpgradeName,
+		func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			return app.ModuleManager.RunMigrations(ctx, app.Configurator(), fromVM)
+		},
+	)
+
+	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		return err
+	}
+	if upgradeInfo.Name == UpgradeName && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storetypes.StoreUpgrades{
+			Added: upgradeAddedStores,
+		}))
+	}
+	return nil
+}
@@ -3,15 +3,18 @@ package app
 import (
 	"fmt"
 
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/CosmWasm/wasmd/x/wasm"
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/server"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
-	"github.com/cosmos/cosmos-sdk/x/auth/posthandler"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -23,6 +26,8 @@ import (
 	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/spf13/cast"
+
+	antehandlers "kudora/app/ante"
 )
 
 // registerWasmModules register CosmWasm keepers and non dependency inject modules.
@@ -53,11 +58,20 @@ func (app *App) registerWasmModules(
 		wasmConfig.SimulationGasLimit = &defaultSimGasLimit
 	}
 
+	// both store services read the params through a single cache, as the
+	// wasm store is opened for every contract storage access
+	params := newWasmParamsCache(app.KudoraParamsKeeper)
+	var storeService corestore.KVStoreService = gasConfigKVStoreService{
+		key:    app.GetKey(wasmtypes.StoreKey),
+		params: params,
+	}
+	storeService = app.newContractStorageCapKVStoreService(storeService, params)
+
 	// The last arguments can contain custom message handlers, and custom query handlers,
 	// if we want to allow any custom callbacks
 	app.WasmKeeper = wasmkeeper.NewKeeper(
 		app.AppCodec(),
-		runtime.NewKVStoreService(app.GetKey(wasmtypes.StoreKey)),
+		storeService,
 		app.AuthKeeper,
 		app.BankKeeper,
 		app.StakingKeeper,
@@ -102,7 +116,7 @@ func (app *App) registerWasmModules(
 		}
 	}
 
-	if err := app.setPostHandler(); err != nil {
+	if err := app.setPostHandler(appOpts); err != nil {
 		return nil, err
 	}
 
@@ -123,49 +137,93 @@ func (app *App) registerWasmModules(
 	return wasmStack, nil
 }
 
-func (app *App) setPostHandler() error {
-	postHandler, err := posthandler.NewPostHandler(
-		posthandler.HandlerOptions{},
-	)
-	if err != nil {
-		return err
+func (app *App) setPostHandler(appOpts servertypes.AppOptions) error {
+	// The SDK post handler has no decorators, only Kudora's are chained.
+	// GasUsageDecorator counts the gas used per block by EVM and Cosmos txs.
+	decorators := []sdk.PostDecorator{
+		antehandlers.NewGasUsageDecorator(app.anteOptions.TransientStoreService),
 	}
-	app.SetPostHandler(postHandler)
+
+	// EVMGasRefundBurnDecorator burns the refunds of unused EVM gas if the
+	// Kudora params ask for it.
+	decorators = append(decorators, antehandlers.NewEVMGasRefundBurnDecorator(
+		app.BankKeeper,
+		app.FeeMarketKeeper,
+		app.KudoraParamsKeeper,
+	))
+
+	app.SetPostHandler(sdk.ChainPostDecorators(decorators...))
 	return nil
 }
 
 func (app *App) setAnteHandler(appOpts servertypes.AppOptions, txConfig client.TxConfig, wasmConfig wasmtypes.NodeConfig, txCounterStoreKey *storetypes.KVStoreKey) error {
 	maxGasWanted := cast.ToUint64(appOpts.Get(srvflags.EVMMaxTxGasWanted))
 
-	anteHandler, err := NewAnteHandler(
-		HandlerOptions{
-			AccountKeeper:          app.AuthKeeper,
-			BankKeeper:             app.BankKeeper,
-			SignModeHandler:        txConfig.SignModeHandler(),
-			FeegrantKeeper:         app.FeeGrantKeeper,
-			ExtensionOptionChecker: evmtypes.HasDynamicFeeExtensionOption,
-			SignatureGasConsumer:   evmante.SigVerificationGasConsumer,
-			Cdc:                    app.appCodec,
-			EvmKeeper:              app.EVMKeeper,
-			FeeMarketKeeper:        app.FeeMarketKeeper,
-			MaxTxGasWanted:         maxGasWanted,
-			TxFeeChecker:           evmdecorators.NewDynamicFeeChecker(app.FeeMarketKeeper),
-			PendingTxListener: func(hash common.Hash) {
-				for _, listener := range app.pendingTxListeners {
-					listener(hash)
-				}
-			},
-			IBCKeeper:             app.IBCKeeper,
-			NodeConfig:            &wasmConfig,
-			WasmKeeper:            &app.WasmKeeper,
-			TXCounterStoreService: runtime.NewKVStoreService(txCounterStoreKey),
-			CircuitKeeper:         &app.CircuitBreakerKeeper,
+	minEVMValueTransfer := math.ZeroInt()
+	if value := cast.ToString(appOpts.Get(FlagMinEVMValueTransfer)); value != "" {
+		var ok bool
+		if minEVMValueTransfer, ok = math.NewIntFromString(value); !ok {
+			return fmt.Errorf("invalid %s: %s", FlagMinEVMValueTransfer, value)
+		}
+	}
+
+	transientKey := storetypes.NewTransientStoreKey(KudoraTransientStoreKey)
+	if err := app.RegisterStores(transientKey); err != nil {
+		return err
+	}
+
+	options := HandlerOptions{
+		AccountKeeper:           app.AuthKeeper,
+		BankKeeper:              app.BankKeeper,
+		SignModeHandler:         txConfig.SignModeHandler(),
+		FeegrantKeeper:          app.FeeGrantKeeper,
+		ExtensionOptionChecker:  evmtypes.HasDynamicFeeExtensionOption,
+		MaxTxSigners:            cast.ToUint64(appOpts.Get(FlagMaxTxSigners)),
+		BootstrapBlocksKeeper:   app.KudoraParamsKeeper,
+		MaxSignersKeeper:        app.KudoraParamsKeeper,
+		DeadlineKeeper:          app.KudoraParamsKeeper,
+		RejectUnfundedAccounts:  cast.ToBool(appOpts.Get(FlagRejectUnfundedAccounts)),
+		RejectSelfTransfers:     cast.ToBool(appOpts.Get(FlagRejectSelfTransfers)),
+		DistrKeeper:             app.DistrKeeper,
+		MsgFeeKeeper:            app.KudoraParamsKeeper,
+		TxGate:                  antehandlers.NewTxGate(),
+		SignatureGasConsumer:    evmante.SigVerificationGasConsumer,
+		Cdc:                     app.appCodec,
+		EvmKeeper:               app.EVMKeeper,
+		FeeMarketKeeper:         app.FeeMarketKeeper,
+		MaxTxGasWanted:          maxGasWanted,
+		EVMReplacementPriceBump: cast.ToUint64(appOpts.Get(FlagEVMReplacementPriceBump)),
+		EVMReadOnly:             cast.ToBool(appOpts.Get(FlagEVMReadOnly)),
+		RejectNoOpEVMTxs:        cast.ToBool(appOpts.Get(FlagRejectNoOpEVMTxs)),
+		MinEVMValueTransfer:     minEVMValueTransfer,
+		EVMGasPriceFloors:       app.EVMGasPriceFloorKeeper,
+		TxFeeChecker:            evmdecorators.NewDynamicFeeChecker(app.FeeMarketKeeper),
+		PendingTxListener: func(hash common.Hash) {
+			for _, listener := range app.pendingTxListeners {
+				listener(hash)
+			}
 		},
-	)
+		IBCKeeper:             app.IBCKeeper,
+		NodeConfig:            &wasmConfig,
+		WasmKeeper:            &app.WasmKeeper,
+		TXCounterStoreService: runtime.NewKVStoreService(txCounterStoreKey),
+		CircuitKeeper:         &app.CircuitBreakerKeeper,
+
+		TransientStoreService:        runtime.NewTransientStoreService(transientKey),
+		WasmInstantiationLimitKeeper: app.KudoraParamsKeeper,
+		MinWasmGasLimit:              cast.ToUint64(appOpts.Get(FlagMinWasmGasLimit)),
+	}
+
+	anteHandler, err := NewAnteHandler(options)
 	if err != nil {
 		return fmt.Errorf("failed to create AnteHandler: %s", err)
 	}
 
+	// Keep what the handler was built with for AnteConfigSnapshot. Invalid
+	// min gas prices are already rejected by baseapp.
+	app.anteOptions = options
+	app.minGasPrices, _ = sdk.ParseDecCoins(cast.ToString(appOpts.Get(server.FlagMinGasPrices)))
+
 	// Set the AnteHandler for the app
 	app.SetAnteHandler(anteHandler)
 	return nil
@@ -0,0 +1,152 @@
+package app
+
+import (
+	"encoding/json"
+
+	storetypes "cosmossdk.io/store/types"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	bindingstypes "github.com/cosmos/tokenfactory/x/tokenfactory/bindings/types"
+	tokenfactorykeeper "github.com/cosmos/tokenfactory/x/tokenfactory/keeper"
+	tokenfactorytypes "github.com/cosmos/tokenfactory/x/tokenfactory/types"
+)
+
+var _ wasmkeeper.Messenger = (*denomMetadataMessenger)(nil)
+
+// denomMetadataMessenger wraps the wasm message handler so that denoms created
+// by a contract through the tokenfactory custom bindings end up with bank
+// metadata while the wasm_denom_metadata param is set.
+type denomMetadataMessenger struct {
+	wasmkeeper.Messenger
+
+	bankKeeper         bankkeeper.Keeper
+	tokenFactoryKeeper *tokenfactorykeeper.Keeper
+	paramsKeeper       KudoraParamsKeeper
+	symbols            denomSymbols
+}
+
+// newDenomMetadataMessenger returns a message handler decorator to be passed to
+// wasmkeeper.WithMessageHandlerDecorator.
+func newDenomMetadataMessenger(
+	symbolsStoreKey storetypes.StoreKey,
+	bankKeeper bankkeeper.Keeper,
+	tokenFactoryKeeper *tokenfactorykeeper.Keeper,
+	paramsKeeper KudoraParamsKeeper,
+) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+	return func(nested wasmkeeper.Messenger) wasmkeeper.Messenger {
+		return &denomMetadataMessenger{
+			Messenger:          nested,
+			bankKeeper:         bankKeeper,
+			tokenFactoryKeeper: tokenFactoryKeeper,
+			paramsKeeper:       paramsKeeper,
+			symbols:            denomSymbols{storeKey: symbolsStoreKey, bankKeeper: bankKeeper, paramsKeeper: paramsKeeper},
+		}
+	}
+}
+
+// DispatchMsg dispatches the message to the wrapped handler and registers
+// metadata for every denom the contract created while handling it. The
+// tokenfactory module may already have stored metadata for the new denom, in
+// which case only its missing fields are filled in. The symbol goes through
+// the symbol index, and is left empty when another denom already uses it
+// while the tokenfactory_unique_symbols param is set.
+func (m *denomMetadataMessenger) DispatchMsg(
+	ctx sdk.Context,
+	contractAddr sdk.AccAddress,
+	contractIBCPortID string,
+	msg wasmvmtypes.CosmosMsg,
+) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	// only custom messages can reach the tokenfactory bindings
+	if msg.Custom == nil || !m.paramsKeeper.GetParams(ctx).WasmDenomMetadata {
+		return m.Messenger.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+	}
+
+	creator := contractAddr.String()
+	existing := make(map[string]struct{})
+	for _, denom := range m.tokenFactoryKeeper.GetDenomsFromCreator(ctx, creator) {
+		existing[denom] = struct{}{}
+	}
+
+	events, data, msgResponses, err := m.Messenger.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	for _, denom := range m.tokenFactoryKeeper.GetDenomsFromCreator(ctx, creator) {
+		if _, ok := existing[denom]; ok {
+			continue
+		}
+		metadata, found := m.bankKeeper.GetDenomMetaData(ctx, denom)
+		if !found {
+			metadata = banktypes.Metadata{Base: denom}
+		}
+		previous := metadata.Symbol
+		metadata = completeDenomMetadata(metadata)
+
+		// the subdenom is only used as symbol if no other denom has it
+		if metadata.Symbol != previous {
+			if err := m.symbols.checkSymbol(ctx, denom, metadata.Symbol); err != nil {
+				metadata.Symbol = previous
+			} else {
+				m.symbols.indexSymbol(ctx, denom, previous, metadata.Symbol)
+			}
+		}
+		m.bankKeeper.SetDenomMetaData(ctx, metadata)
+	}
+
+	return events, data, msgResponses, nil
+}
+
+// minimalDenomMetadata builds the smallest metadata accepted by x/bank for a
+// tokenfactory denom, using the subdenom as name and symbol.
+func minimalDenomMetadata(denom string) banktypes.Metadata {
+	name := denom
+	if _, subdenom, err := tokenfactorytypes.DeconstructDenom(denom); err == nil {
+		name = subdenom
+	}
+
+	return banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+		Base:       denom,
+		Display:    denom,
+		Name:       name,
+		Symbol:     name,
+	}
+}
+
+// completeDenomMetadata fills the fields of metadata left empty with those of
+// minimalDenomMetadata, keeping the ones already set.
+func completeDenomMetadata(metadata banktypes.Metadata) banktypes.Metadata {
+	minimal := minimalDenomMetadata(metadata.Base)
+	if len(metadata.DenomUnits) == 0 {
+		metadata.DenomUnits = minimal.DenomUnits
+	}
+	if metadata.Display == "" {
+		metadata.Display = minimal.Display
+	}
+	if metadata.Name == "" {
+		metadata.Name = minimal.Name
+	}
+	if metadata.Symbol == "" {
+		metadata.Symbol = minimal.Symbol
+	}
+	return metadata
+}
+
+// parseTokenFactoryBindingMsg returns the tokenfactory message a contract
+// sends through the tokenfactory custom bindings, if msg is one.
+func parseTokenFactoryBindingMsg(msg wasmvmtypes.CosmosMsg) (*bindingstypes.TokenMsg, bool) {
+	if msg.Custom == nil {
+		return nil, false
+	}
+
+	var customMsg bindingstypes.TokenFactoryMsg
+	if err := json.Unmarshal(msg.Custom, &customMsg); err != nil || customMsg.Token == nil {
+		return nil, false
+	}
+	return customMsg.Token, true
+}
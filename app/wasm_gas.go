@@ -0,0 +1,83 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"sync/atomic"
+
+	corestore "cosmossdk.io/core/store"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	kudoratypes "kudora/x/kudora/types"
+)
+
+// wasmStorageGasConfig returns the KV gas config charged for wasm store
+// accesses: the SDK default with the write costs of the params applied.
+func wasmStorageGasConfig(params kudoratypes.Params) storetypes.GasConfig {
+	cfg := storetypes.KVGasConfig()
+	if params.WasmStorageWriteCostFlat > 0 {
+		cfg.WriteCostFlat = params.WasmStorageWriteCostFlat
+	}
+	if params.WasmStorageWriteCostPerByte > 0 {
+		cfg.WriteCostPerByte = params.WasmStorageWriteCostPerByte
+	}
+	return cfg
+}
+
+// wasmParamsCache decodes the Kudora params for the wasm store services only
+// when their encoding changes, rather than every time a contract opens its
+// store. It is shared by the services and safe for concurrent use.
+type wasmParamsCache struct {
+	paramsKeeper KudoraParamsKeeper
+	last         atomic.Pointer[cachedWasmParams]
+}
+
+// cachedWasmParams are params decoded from bz.
+type cachedWasmParams struct {
+	bz     []byte
+	params kudoratypes.Params
+}
+
+// newWasmParamsCache creates a wasmParamsCache reading the params of keeper.
+func newWasmParamsCache(paramsKeeper KudoraParamsKeeper) *wasmParamsCache {
+	return &wasmParamsCache{paramsKeeper: paramsKeeper}
+}
+
+// GetParams returns the Kudora params of ctx, read for free so that the wasm
+// store services don't change the gas of contracts. The params returned are
+// shared and must not be modified.
+func (c *wasmParamsCache) GetParams(ctx sdk.Context) kudoratypes.Params {
+	bz := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()).KVStore(c.paramsKeeper.storeKey).Get(kudoraParamsKey)
+	if last := c.last.Load(); last != nil && bytes.Equal(last.bz, bz) {
+		return last.params
+	}
+
+	params := kudoratypes.DefaultParams()
+	if bz != nil {
+		params = kudoratypes.Params{}
+		c.paramsKeeper.cdc.MustUnmarshal(bz, &params)
+	}
+	c.last.Store(&cachedWasmParams{bz: bytes.Clone(bz), params: params})
+	return params
+}
+
+var _ corestore.KVStoreService = gasConfigKVStoreService{}
+
+// gasConfigKVStoreService opens its store charging gas with the wasm storage
+// gas config of the Kudora params instead of the KV gas config of the context.
+type gasConfigKVStoreService struct {
+	key    *storetypes.KVStoreKey
+	params *wasmParamsCache
+}
+
+// OpenKVStore implements corestore.KVStoreService.
+func (s gasConfigKVStoreService) OpenKVStore(ctx context.Context) corestore.KVStore {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	params := s.params.GetParams(sdkCtx)
+	if params.WasmStorageWriteCostFlat > 0 || params.WasmStorageWriteCostPerByte > 0 {
+		sdkCtx = sdkCtx.WithKVGasConfig(wasmStorageGasConfig(params))
+	}
+	return runtime.NewKVStoreService(s.key).OpenKVStore(sdkCtx)
+}
@@ -0,0 +1,31 @@
+package app
+
+import (
+	"encoding/hex"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// WasmCode describes a wasm code uploaded to the chain.
+type WasmCode struct {
+	CodeID  uint64
+	Creator string
+	// Checksum is the hex encoded sha256 checksum of the wasm byte code.
+	Checksum string
+}
+
+// WasmCodes returns every wasm code stored on the chain with its creator, by
+// ascending code ID.
+func (app *App) WasmCodes(ctx sdk.Context) []WasmCode {
+	var codes []WasmCode
+	app.WasmKeeper.IterateCodeInfos(ctx, func(codeID uint64, info wasmtypes.CodeInfo) bool {
+		codes = append(codes, WasmCode{
+			CodeID:   codeID,
+			Creator:  info.Creator,
+			Checksum: hex.EncodeToString(info.CodeHash),
+		})
+		return false
+	})
+	return codes
+}
@@ -0,0 +1,173 @@
+package app
+
+import (
+	"bytes"
+	"context"
+
+	corestore "cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// contractStorageEntriesPrefix prefixes the number of storage entries the
+// wasm contracts added since the contract storage cap was enabled, net of
+// those they deleted, keyed by contract address. They are pruned once the cap
+// is disabled.
+var contractStorageEntriesPrefix = []byte{0x06}
+
+var _ corestore.KVStoreService = contractStorageCapKVStoreService{}
+
+// contractStorageCapKVStoreService opens the wasm store capping the number of
+// storage entries of each contract to the one of the Kudora params. Every
+// contract storage write goes through it, whether it comes from a tx, another
+// contract, a sudo call or genesis.
+type contractStorageCapKVStoreService struct {
+	corestore.KVStoreService
+
+	storeKey storetypes.StoreKey
+	params   *wasmParamsCache
+}
+
+// OpenKVStore implements corestore.KVStoreService.
+func (s contractStorageCapKVStoreService) OpenKVStore(ctx context.Context) corestore.KVStore {
+	store := s.KVStoreService.OpenKVStore(ctx)
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	maxEntries := s.params.GetParams(sdkCtx).WasmMaxContractStorageEntries
+	if maxEntries == 0 {
+		return store
+	}
+
+	return contractStorageCapKVStore{
+		KVStore:    store,
+		entries:    prefix.NewStore(sdkCtx.KVStore(s.storeKey), contractStorageEntriesPrefix),
+		maxEntries: maxEntries,
+	}
+}
+
+// contractStorageCapKVStore counts the storage entries the contracts it
+// writes add, and fails writes of new entries past maxEntries. Entries
+// written before the cap was enabled are not counted, as counting them would
+// iterate over the whole storage of a contract within its call; deleting
+// them still makes room for new ones, down to a count of zero. wasmd panics
+// on store errors, which wasmvm turns into an error of the contract call.
+type contractStorageCapKVStore struct {
+	corestore.KVStore
+
+	entries    prefix.Store
+	maxEntries uint64
+}
+
+// Set implements corestore.KVStore.
+func (s contractStorageCapKVStore) Set(key, value []byte) error {
+	contract, ok := contractStorageAddress(key)
+	if !ok {
+		return s.KVStore.Set(key, value)
+	}
+
+	has, err := s.KVStore.Has(key)
+	if err != nil {
+		return err
+	}
+	if !has {
+		entries := s.getEntries(contract)
+		if entries >= s.maxEntries {
+			return errorsmod.Wrapf(
+				wasmtypes.ErrLimit,
+				"contract %s exceeds the maximum of %d storage entries",
+				contract,
+				s.maxEntries,
+			)
+		}
+		s.entries.Set(contract, sdk.Uint64ToBigEndian(entries+1))
+	}
+	return s.KVStore.Set(key, value)
+}
+
+// Delete implements corestore.KVStore.
+func (s contractStorageCapKVStore) Delete(key []byte) error {
+	contract, ok := contractStorageAddress(key)
+	if !ok {
+		return s.KVStore.Delete(key)
+	}
+
+	has, err := s.KVStore.Has(key)
+	if err != nil {
+		return err
+	}
+	if has {
+		switch entries := s.getEntries(contract); {
+		case entries > 1:
+			s.entries.Set(contract, sdk.Uint64ToBigEndian(entries-1))
+		case entries == 1:
+			s.entries.Delete(contract)
+		}
+	}
+	return s.KVStore.Delete(key)
+}
+
+// getEntries returns the number of storage entries contract added since the
+// cap was enabled, net of those it deleted.
+func (s contractStorageCapKVStore) getEntries(contract sdk.AccAddress) uint64 {
+	bz := s.entries.Get(contract)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// contractStorageAddress returns the contract whose storage key is in, if it
+// is a contract storage key.
+func contractStorageAddress(key []byte) (sdk.AccAddress, bool) {
+	if !bytes.HasPrefix(key, wasmtypes.ContractStorePrefix) || len(key) < len(wasmtypes.ContractStorePrefix)+wasmtypes.ContractAddrLen {
+		return nil, false
+	}
+	return sdk.AccAddress(key[len(wasmtypes.ContractStorePrefix) : len(wasmtypes.ContractStorePrefix)+wasmtypes.ContractAddrLen]), true
+}
+
+// maxContractStorageEntriesPrunedPerBlock caps the contract storage entry
+// counts pruned per block once the cap is disabled.
+const maxContractStorageEntriesPrunedPerBlock = 100
+
+// pruneContractStorageEntries forgets up to
+// maxContractStorageEntriesPrunedPerBlock counted contract storage entries
+// while the cap is disabled, as they are no longer kept up to date.
+func (app *App) pruneContractStorageEntries(ctx sdk.Context) {
+	if app.KudoraParamsKeeper.GetParams(ctx).WasmMaxContractStorageEntries != 0 {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(app.GetKey(KudoraStoreKey)), contractStorageEntriesPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid() && len(keys) < maxContractStorageEntriesPrunedPerBlock; iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// hasContractStorageEntries reports whether contract storage entry counts
+// remain from a cap since disabled, which pruneContractStorageEntries has not
+// forgotten yet.
+func hasContractStorageEntries(ctx sdk.Context, storeKey storetypes.StoreKey) bool {
+	iterator := prefix.NewStore(ctx.KVStore(storeKey), contractStorageEntriesPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+	return iterator.Valid()
+}
+
+// newContractStorageCapKVStoreService wraps storeService with the contract
+// storage cap read from params.
+func (app *App) newContractStorageCapKVStoreService(storeService corestore.KVStoreService, params *wasmParamsCache) corestore.KVStoreService {
+	return contractStorageCapKVStoreService{
+		KVStoreService: storeService,
+		storeKey:       app.GetKey(KudoraStoreKey),
+		params:         params,
+	}
+}
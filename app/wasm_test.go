@@ -0,0 +1,261 @@
+package app
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtestdata "github.com/CosmWasm/wasmd/x/wasm/keeper/testdata"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	kudoratypes "kudora/x/kudora/types"
+)
+
+// createDenomMessenger mimics the tokenfactory custom plugin handling a
+// create_denom message on behalf of a contract.
+type createDenomMessenger struct {
+	app      *App
+	subdenom string
+}
+
+func (m createDenomMessenger) DispatchMsg(
+	ctx sdk.Context,
+	contractAddr sdk.AccAddress,
+	_ string,
+	_ wasmvmtypes.CosmosMsg,
+) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	_, err := m.app.TokenFactoryKeeper.CreateDenom(ctx, contractAddr.String(), m.subdenom)
+	return nil, nil, nil, err
+}
+
+func TestDenomMetadataMessengerRegistersContractDenoms(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).CacheContext()
+
+	decorate := newDenomMetadataMessenger(app.GetKey(DenomSymbolsStoreKey), app.BankKeeper, &app.TokenFactoryKeeper, app.KudoraParamsKeeper)
+	createDenom := func(contract sdk.AccAddress, subdenom string) banktypes.Metadata {
+		t.Helper()
+
+		fundTestAccount(t, app, ctx, contract, sdk.NewCoins(sdk.NewCoin(BaseDenom, math.NewInt(1000000000000000000))))
+		messenger := decorate(createDenomMessenger{app: app, subdenom: subdenom})
+		_, _, _, err := messenger.DispatchMsg(ctx, contract, "", wasmvmtypes.CosmosMsg{
+			Custom: []byte(`{"create_denom":{"subdenom":"` + subdenom + `"}}`),
+		})
+		require.NoError(t, err)
+
+		denoms := app.TokenFactoryKeeper.GetDenomsFromCreator(ctx, contract.String())
+		require.Len(t, denoms, 1)
+		metadata, _ := app.BankKeeper.GetDenomMetaData(ctx, denoms[0])
+		return metadata
+	}
+
+	// left to the tokenfactory module while the param is unset
+	createDenom(sdk.AccAddress([]byte("wasm_contract_nometa")), "plaintoken")
+	require.False(t, ctx.KVStore(app.GetKey(DenomSymbolsStoreKey)).Has(symbolKey("plaintoken")))
+
+	params := kudoratypes.DefaultParams()
+	params.WasmDenomMetadata = true
+	params.TokenfactoryUniqueSymbols = true
+	require.NoError(t, app.KudoraParamsKeeper.SetParams(ctx, params))
+
+	first := createDenom(sdk.AccAddress([]byte("wasm_contract_denom_")), "contracttoken")
+	require.Equal(t, first.Base, first.Display)
+	require.NotEmpty(t, first.Name)
+	require.NotEmpty(t, first.Symbol)
+	require.Len(t, first.DenomUnits, 1)
+
+	// a second contract reusing the subdenom doesn't get the same symbol
+	second := createDenom(sdk.AccAddress([]byte("wasm_contract_denom2")), "contracttoken")
+	require.NotEmpty(t, second.Name)
+	require.NotEqual(t, strings.ToUpper(first.Symbol), strings.ToUpper(second.Symbol))
+}
+
+func TestCompleteDenomMetadata(t *testing.T) {
+	denom := "factory/kudo1creator/token"
+
+	// metadata stored by the tokenfactory module with only its base
+	metadata := completeDenomMetadata(banktypes.Metadata{Base: denom})
+	require.Equal(t, minimalDenomMetadata(denom), metadata)
+
+	// fields already set are kept
+	metadata = completeDenomMetadata(banktypes.Metadata{Base: denom, Name: "Token", Symbol: "TKN"})
+	require.Equal(t, "Token", metadata.Name)
+	require.Equal(t, "TKN", metadata.Symbol)
+	require.Equal(t, denom, metadata.Display)
+	require.Len(t, metadata.DenomUnits, 1)
+}
+
+func TestWasmStorageGasConfig(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).CacheContext()
+	service := gasConfigKVStoreService{key: app.GetKey(wasmtypes.StoreKey), params: newWasmParamsCache(app.KudoraParamsKeeper)}
+	key, value := []byte("contract_state_key"), []byte("contract_state_value")
+	size := uint64(len(key) + len(value))
+
+	// no override keeps the SDK defaults
+	defaults := storetypes.KVGasConfig()
+	require.Equal(t, defaults, wasmStorageGasConfig(kudoratypes.DefaultParams()))
+
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	require.NoError(t, service.OpenKVStore(ctx).Set(key, value))
+	require.Equal(t, defaults.WriteCostFlat+defaults.WriteCostPerByte*size, ctx.GasMeter().GasConsumed())
+
+	params := kudoratypes.DefaultParams()
+	params.WasmStorageWriteCostPerByte = 300
+	require.NoError(t, app.KudoraParamsKeeper.SetParams(ctx, params))
+
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	require.NoError(t, service.OpenKVStore(ctx).Set(key, value))
+	require.Equal(t, defaults.WriteCostFlat+300*size, ctx.GasMeter().GasConsumed())
+}
+
+func TestWasmCodes(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).CacheContext()
+	contractKeeper := wasmkeeper.NewDefaultPermissionKeeper(&app.WasmKeeper)
+
+	existing := len(app.WasmCodes(ctx))
+
+	// two codes uploaded by different accounts
+	alice := sdk.AccAddress([]byte("wasm_code_creator_a_"))
+	bob := sdk.AccAddress([]byte("wasm_code_creator_b_"))
+	reflectID, reflectChecksum, err := contractKeeper.Create(ctx, alice, wasmtestdata.ReflectContractWasm(), nil)
+	require.NoError(t, err)
+	hackatomID, hackatomChecksum, err := contractKeeper.Create(ctx, bob, wasmtestdata.HackatomContractWasm(), nil)
+	require.NoError(t, err)
+
+	codes := app.WasmCodes(ctx)
+	require.Len(t, codes, existing+2)
+	require.Contains(t, codes, WasmCode{CodeID: reflectID, Creator: alice.String(), Checksum: hex.EncodeToString(reflectChecksum)})
+	require.Contains(t, codes, WasmCode{CodeID: hackatomID, Creator: bob.String(), Checksum: hex.EncodeToString(hackatomChecksum)})
+}
+
+func TestContractStorageCap(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).CacheContext()
+	contractKeeper := wasmkeeper.NewDefaultPermissionKeeper(&app.WasmKeeper)
+
+	verifier := sdk.AccAddress([]byte("storage_cap_verifier"))
+	beneficiary := sdk.AccAddress([]byte("storage_cap_benefit_"))
+	codeID, checksum, err := contractKeeper.Create(ctx, verifier, wasmtestdata.HackatomContractWasm(), nil)
+	require.NoError(t, err)
+	initMsg := []byte(`{"verifier":"` + verifier.String() + `","beneficiary":"` + beneficiary.String() + `"}`)
+
+	// a contract instantiated before the cap
+	contract, _, err := contractKeeper.Instantiate(ctx, codeID, verifier, nil, initMsg, "storage cap", nil)
+	require.NoError(t, err)
+	var existing [][]byte
+	app.WasmKeeper.IterateContractState(ctx, contract, func(key, _ []byte) bool {
+		existing = append(existing, key)
+		return false
+	})
+	require.NotEmpty(t, existing)
+
+	params := kudoratypes.DefaultParams()
+	params.WasmMaxContractStorageEntries = 1
+	require.NoError(t, app.KudoraParamsKeeper.SetParams(ctx, params))
+
+	// only the entries it adds once the cap is enabled count towards it
+	store := app.newContractStorageCapKVStoreService(runtime.NewKVStoreService(app.GetKey(wasmtypes.StoreKey)), newWasmParamsCache(app.KudoraParamsKeeper)).OpenKVStore(ctx)
+	entryKey := func(key string) []byte {
+		return append(wasmtypes.GetContractStorePrefix(contract), key...)
+	}
+	require.NoError(t, store.Set(entryKey("entry_1"), []byte("1")))
+	require.NoError(t, store.Set(entryKey("entry_1"), []byte("2")))
+	require.ErrorIs(t, store.Set(entryKey("entry_2"), []byte("1")), wasmtypes.ErrLimit)
+
+	// deleting an entry makes room for another
+	require.NoError(t, store.Delete(entryKey("entry_1")))
+	require.NoError(t, store.Set(entryKey("entry_2"), []byte("1")))
+
+	// down to a count of zero, deleting older entries included
+	entriesStore := prefix.NewStore(ctx.KVStore(app.GetKey(KudoraStoreKey)), contractStorageEntriesPrefix)
+	require.NoError(t, store.Delete(append(wasmtypes.GetContractStorePrefix(contract), existing[0]...)))
+	require.NoError(t, store.Delete(entryKey("entry_2")))
+	require.Nil(t, entriesStore.Get(contract))
+
+	// contracts writing past the cap fail, here at instantiation
+	salt := []byte("storage cap")
+	capped := wasmkeeper.BuildContractAddressPredictable(checksum, verifier, salt, initMsg)
+	entriesStore.Set(capped, sdk.Uint64ToBigEndian(params.WasmMaxContractStorageEntries))
+	_, _, err = contractKeeper.Instantiate2(ctx, codeID, verifier, nil, initMsg, "capped", nil, salt, true)
+	require.Error(t, err)
+	require.False(t, app.WasmKeeper.HasContractInfo(ctx, capped))
+
+	// the counts are pruned in EndBlock once the cap is disabled, and the cap
+	// can't be enabled again until they are
+	entriesStore.Set(contract, sdk.Uint64ToBigEndian(1))
+	update := &kudoratypes.MsgUpdateParams{Authority: app.KudoraParamsKeeper.GetAuthority(), Params: kudoratypes.DefaultParams()}
+	handler := app.MsgServiceRouter().Handler(update)
+	require.NotNil(t, handler)
+	_, err = handler(ctx, update)
+	require.NoError(t, err)
+	require.NotNil(t, entriesStore.Get(contract))
+
+	update.Params = params
+	_, err = handler(ctx, update)
+	require.ErrorIs(t, err, errortypes.ErrInvalidRequest)
+
+	require.NoError(t, kudoraModule{app: app}.EndBlock(ctx))
+	require.Nil(t, entriesStore.Get(contract))
+	require.Nil(t, entriesStore.Get(capped))
+	_, err = handler(ctx, update)
+	require.NoError(t, err)
+}
+
+func TestBeforeSendHookContract(t *testing.T) {
+	app := setupTestApp(t)
+	ctx, _ := newTestContext(app).CacheContext()
+	contractKeeper := wasmkeeper.NewDefaultPermissionKeeper(&app.WasmKeeper)
+
+	admin := sdk.AccAddress([]byte("before_send_admin___"))
+	recipient := sdk.AccAddress([]byte("before_send_recipien"))
+	fundTestAccount(t, app, ctx, admin, sdk.NewCoins(sdk.NewCoin("kud", math.NewInt(1_000_000))))
+
+	denom, err := app.TokenFactoryKeeper.CreateDenom(ctx, admin.String(), "hooked")
+	require.NoError(t, err)
+	coins := sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(100)))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, "mint", coins))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, "mint", admin, coins))
+
+	// hackatom only accepts its own sudo message, so it rejects every send
+	codeID, _, err := contractKeeper.Create(ctx, admin, wasmtestdata.HackatomContractWasm(), nil)
+	require.NoError(t, err)
+	initMsg := []byte(`{"verifier":"` + admin.String() + `","beneficiary":"` + recipient.String() + `"}`)
+	hook, _, err := contractKeeper.Instantiate(ctx, codeID, admin, nil, initMsg, "before send hook", nil)
+	require.NoError(t, err)
+
+	// only the admin may set the hooks
+	set := &kudoratypes.MsgSetBeforeSendHooks{Sender: recipient.String(), Denom: denom, Contracts: []string{hook.String()}}
+	handler := app.MsgServiceRouter().Handler(set)
+	require.NotNil(t, handler)
+	_, err = handler(ctx, set)
+	require.Error(t, err)
+
+	set.Sender = admin.String()
+	_, err = handler(ctx, set)
+	require.NoError(t, err)
+
+	// the hook runs within its gas limit, charged to the sender
+	sendCtx := ctx.WithGasMeter(storetypes.NewGasMeter(10_000_000))
+	err = app.BankKeeper.SendCoins(sendCtx, admin, recipient, coins)
+	require.ErrorContains(t, err, hook.String())
+	require.Greater(t, sendCtx.GasMeter().GasConsumed(), uint64(0))
+
+	// removing the hook lets the send through
+	set.Contracts = nil
+	_, err = handler(ctx, set)
+	require.NoError(t, err)
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, admin, recipient, coins))
+}
@@ -95,6 +95,11 @@ func NewRootCmd() *cobra.Command {
 		moduleBasicManager[name] = module.CoreAppModuleBasicAdaptor(name, mod)
 		autoCliOpts.Modules[name] = mod
 	}
+	kudoraModule := app.RegisterKudora(clientCtx.Codec)
+	for name, mod := range kudoraModule {
+		moduleBasicManager[name] = module.CoreAppModuleBasicAdaptor(name, mod)
+		autoCliOpts.Modules[name] = mod
+	}
 	// Register IBC Middleware modules for CLI
 	pfmModules := app.RegisterPacketForward(clientCtx.Codec)
 	for name, mod := range pfmModules {
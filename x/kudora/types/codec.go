@@ -0,0 +1,23 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterInterfaces registers the Kudora msgs with the interface registry.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUpdateParams{},
+		&MsgSetAutoCompound{},
+		&MsgSetMintPaused{},
+		&MsgLockDenomMetadata{},
+		&MsgSetBeforeSendHooks{},
+		&MsgSetEVMGasPriceFloor{},
+		&MsgSetDenomSendEnabled{},
+		&MsgResubmitTimedOutTransfer{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
@@ -0,0 +1,7 @@
+package types
+
+// GasUsed returns the gas consumed by all the transactions of the block,
+// failed ones included.
+func (u BlockGasUsage) GasUsed() uint64 {
+	return u.EvmGas + u.CosmosGas + u.FailedGas
+}
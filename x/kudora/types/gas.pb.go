@@ -0,0 +1,451 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kudora/kudora/v1/gas.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BlockGasUsage is the gas consumed by the transactions of a block, split
+// between successful EVM and Cosmos transactions and failed ones, and the gas
+// they asked for.
+type BlockGasUsage struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// evm_gas is the gas consumed by successful EVM transactions.
+	EvmGas uint64 `protobuf:"varint,2,opt,name=evm_gas,json=evmGas,proto3" json:"evm_gas,omitempty"`
+	// cosmos_gas is the gas consumed by successful Cosmos transactions.
+	CosmosGas uint64 `protobuf:"varint,3,opt,name=cosmos_gas,json=cosmosGas,proto3" json:"cosmos_gas,omitempty"`
+	// gas_wanted is the sum of the gas limits of the transactions that passed
+	// the ante handler, failing or not.
+	GasWanted uint64 `protobuf:"varint,4,opt,name=gas_wanted,json=gasWanted,proto3" json:"gas_wanted,omitempty"`
+	// failed_gas is the gas consumed by the transactions failing in the ante
+	// handler or in their messages.
+	FailedGas uint64 `protobuf:"varint,5,opt,name=failed_gas,json=failedGas,proto3" json:"failed_gas,omitempty"`
+}
+
+func (m *BlockGasUsage) Reset()         { *m = BlockGasUsage{} }
+func (m *BlockGasUsage) String() string { return proto.CompactTextString(m) }
+func (*BlockGasUsage) ProtoMessage()    {}
+func (*BlockGasUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfffc509ed031a17, []int{0}
+}
+func (m *BlockGasUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockGasUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockGasUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockGasUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockGasUsage.Merge(m, src)
+}
+func (m *BlockGasUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockGasUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockGasUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockGasUsage proto.InternalMessageInfo
+
+func (m *BlockGasUsage) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockGasUsage) GetEvmGas() uint64 {
+	if m != nil {
+		return m.EvmGas
+	}
+	return 0
+}
+
+func (m *BlockGasUsage) GetCosmosGas() uint64 {
+	if m != nil {
+		return m.CosmosGas
+	}
+	return 0
+}
+
+func (m *BlockGasUsage) GetGasWanted() uint64 {
+	if m != nil {
+		return m.GasWanted
+	}
+	return 0
+}
+
+func (m *BlockGasUsage) GetFailedGas() uint64 {
+	if m != nil {
+		return m.FailedGas
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*BlockGasUsage)(nil), "kudora.kudora.v1.BlockGasUsage")
+}
+
+func init() { proto.RegisterFile("kudora/kudora/v1/gas.proto", fileDescriptor_cfffc509ed031a17) }
+
+var fileDescriptor_cfffc509ed031a17 = []byte{
+	// 216 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xca, 0x2e, 0x4d, 0xc9,
+	0x2f, 0x4a, 0xd4, 0x87, 0x52, 0x65, 0x86, 0xfa, 0xe9, 0x89, 0xc5, 0x7a, 0x05, 0x45, 0xf9, 0x25,
+	0xf9, 0x42, 0x02, 0x10, 0x41, 0x3d, 0x28, 0x55, 0x66, 0xa8, 0x34, 0x97, 0x91, 0x8b, 0xd7, 0x29,
+	0x27, 0x3f, 0x39, 0xdb, 0x3d, 0xb1, 0x38, 0xb4, 0x38, 0x31, 0x3d, 0x55, 0x48, 0x8c, 0x8b, 0x2d,
+	0x23, 0x35, 0x33, 0x3d, 0xa3, 0x44, 0x82, 0x51, 0x81, 0x51, 0x83, 0x39, 0x08, 0xca, 0x13, 0x12,
+	0xe7, 0x62, 0x4f, 0x2d, 0xcb, 0x8d, 0x4f, 0x4f, 0x2c, 0x96, 0x60, 0x52, 0x60, 0xd4, 0x60, 0x09,
+	0x62, 0x4b, 0x2d, 0xcb, 0x75, 0x4f, 0x2c, 0x16, 0x92, 0xe5, 0xe2, 0x4a, 0xce, 0x2f, 0xce, 0xcd,
+	0x2f, 0x06, 0xcb, 0x31, 0x83, 0xe5, 0x38, 0x21, 0x22, 0x50, 0xe9, 0xf4, 0xc4, 0xe2, 0xf8, 0xf2,
+	0xc4, 0xbc, 0x92, 0xd4, 0x14, 0x09, 0x16, 0x88, 0x74, 0x7a, 0x62, 0x71, 0x38, 0x58, 0x00, 0x24,
+	0x9d, 0x96, 0x98, 0x99, 0x93, 0x9a, 0x02, 0xd6, 0xcd, 0x0a, 0x91, 0x86, 0x88, 0xb8, 0x27, 0x16,
+	0x3b, 0xe9, 0x9f, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13,
+	0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x28, 0xd4, 0x83,
+	0x15, 0x30, 0x9f, 0x96, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x7d, 0x6a, 0x0c, 0x18, 0x00,
+	0xec, 0xa3, 0xee, 0x3d, 0x07, 0x01, 0x00, 0x00,
+}
+
+func (m *BlockGasUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockGasUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockGasUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FailedGas != 0 {
+		i = encodeVarintGas(dAtA, i, uint64(m.FailedGas))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.GasWanted != 0 {
+		i = encodeVarintGas(dAtA, i, uint64(m.GasWanted))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CosmosGas != 0 {
+		i = encodeVarintGas(dAtA, i, uint64(m.CosmosGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EvmGas != 0 {
+		i = encodeVarintGas(dAtA, i, uint64(m.EvmGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintGas(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGas(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGas(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BlockGasUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovGas(uint64(m.Height))
+	}
+	if m.EvmGas != 0 {
+		n += 1 + sovGas(uint64(m.EvmGas))
+	}
+	if m.CosmosGas != 0 {
+		n += 1 + sovGas(uint64(m.CosmosGas))
+	}
+	if m.GasWanted != 0 {
+		n += 1 + sovGas(uint64(m.GasWanted))
+	}
+	if m.FailedGas != 0 {
+		n += 1 + sovGas(uint64(m.FailedGas))
+	}
+	return n
+}
+
+func sovGas(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGas(x uint64) (n int) {
+	return sovGas(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BlockGasUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGas
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockGasUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockGasUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmGas", wireType)
+			}
+			m.EvmGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EvmGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosGas", wireType)
+			}
+			m.CosmosGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CosmosGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasWanted", wireType)
+			}
+			m.GasWanted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasWanted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedGas", wireType)
+			}
+			m.FailedGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGas(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGas
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGas(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGas
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGas
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGas
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGas
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGas        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGas          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGas = fmt.Errorf("proto: unexpected end of group")
+)
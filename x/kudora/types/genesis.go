@@ -0,0 +1,91 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// DefaultGenesis returns the default genesis state.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{Params: DefaultParams()}
+}
+
+// Validate performs basic validation of the genesis state.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return fmt.Errorf("invalid params: %w", err)
+	}
+
+	seen := make(map[string]bool, len(gs.BeforeSendHooks))
+	for _, hooks := range gs.BeforeSendHooks {
+		if seen[hooks.Denom] {
+			return fmt.Errorf("duplicate before-send hooks for denom %s", hooks.Denom)
+		}
+		seen[hooks.Denom] = true
+
+		for _, contract := range hooks.Contracts {
+			if _, err := sdk.AccAddressFromBech32(contract); err != nil {
+				return fmt.Errorf("invalid before-send hook %s of denom %s: %w", contract, hooks.Denom, err)
+			}
+		}
+	}
+
+	for _, denom := range gs.MetadataLockedDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid metadata locked denom %s: %w", denom, err)
+		}
+	}
+
+	for _, denom := range gs.MintPausedDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid mint paused denom %s: %w", denom, err)
+		}
+	}
+
+	for _, denom := range gs.SendDisabledDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid send disabled denom %s: %w", denom, err)
+		}
+	}
+
+	seenHistories := make(map[string]bool, len(gs.AdminHistories))
+	for _, history := range gs.AdminHistories {
+		if err := sdk.ValidateDenom(history.Denom); err != nil {
+			return fmt.Errorf("invalid admin history denom %s: %w", history.Denom, err)
+		}
+		if seenHistories[history.Denom] {
+			return fmt.Errorf("duplicate admin history for denom %s", history.Denom)
+		}
+		seenHistories[history.Denom] = true
+	}
+
+	seenBurns := make(map[string]bool, len(gs.BurnedDenoms))
+	for _, burned := range gs.BurnedDenoms {
+		if seenBurns[burned.Denom] {
+			return fmt.Errorf("duplicate burned amount for denom %s", burned.Denom)
+		}
+		seenBurns[burned.Denom] = true
+
+		if burned.Amount.IsNil() || burned.Amount.IsNegative() {
+			return fmt.Errorf("invalid burned amount of denom %s: %s", burned.Denom, burned.Amount)
+		}
+	}
+
+	for _, floor := range gs.EVMGasPriceFloors {
+		if !common.IsHexAddress(floor.Address) {
+			return fmt.Errorf("invalid EVM gas price floor address %s", floor.Address)
+		}
+		if floor.MinGasPrice.IsNil() || !floor.MinGasPrice.IsPositive() {
+			return fmt.Errorf("invalid EVM gas price floor of %s: %s", floor.Address, floor.MinGasPrice)
+		}
+	}
+
+	for _, delegator := range gs.AutoCompoundDelegators {
+		if _, err := sdk.AccAddressFromBech32(delegator); err != nil {
+			return fmt.Errorf("invalid auto-compound delegator %s: %w", delegator, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,1050 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kudora/kudora/v1/genesis.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState is the genesis state of the Kudora extensions to other
+// modules, which their own genesis doesn't carry.
+type GenesisState struct {
+	// params are the on-chain params of the Kudora extensions.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// before_send_hooks are the tokenfactory denoms with before-send hooks
+	// registered through the BeforeSendHooksKeeper.
+	BeforeSendHooks []DenomBeforeSendHooks `protobuf:"bytes,2,rep,name=before_send_hooks,json=beforeSendHooks,proto3" json:"before_send_hooks"`
+	// metadata_locked_denoms are the tokenfactory denoms whose metadata is
+	// locked through the DenomMetadataLockKeeper.
+	MetadataLockedDenoms []string `protobuf:"bytes,3,rep,name=metadata_locked_denoms,json=metadataLockedDenoms,proto3" json:"metadata_locked_denoms,omitempty"`
+	// mint_paused_denoms are the tokenfactory denoms whose minting is paused
+	// through the DenomMintPauseKeeper.
+	MintPausedDenoms []string `protobuf:"bytes,4,rep,name=mint_paused_denoms,json=mintPausedDenoms,proto3" json:"mint_paused_denoms,omitempty"`
+	// send_disabled_denoms are the tokenfactory denoms whose sends their admin
+	// disabled through the DenomSendEnabledKeeper.
+	SendDisabledDenoms []string `protobuf:"bytes,5,rep,name=send_disabled_denoms,json=sendDisabledDenoms,proto3" json:"send_disabled_denoms,omitempty"`
+	// admin_histories are the admin change histories of tokenfactory denoms
+	// recorded by the DenomAdminHistoryKeeper.
+	AdminHistories []DenomAdminHistory `protobuf:"bytes,6,rep,name=admin_histories,json=adminHistories,proto3" json:"admin_histories"`
+	// burned_denoms are the cumulative burned amounts of tokenfactory denoms
+	// tracked by the DenomBurnKeeper.
+	BurnedDenoms []DenomBurned `protobuf:"bytes,7,rep,name=burned_denoms,json=burnedDenoms,proto3" json:"burned_denoms"`
+	// evm_gas_price_floors are the minimum gas prices of specific EVM senders.
+	EvmGasPriceFloors []EVMGasPriceFloor `protobuf:"bytes,8,rep,name=evm_gas_price_floors,json=evmGasPriceFloors,proto3" json:"evm_gas_price_floors"`
+	// auto_compound_delegators are the delegators that opted in to the
+	// auto-compounding of their staking rewards.
+	AutoCompoundDelegators []string `protobuf:"bytes,9,rep,name=auto_compound_delegators,json=autoCompoundDelegators,proto3" json:"auto_compound_delegators,omitempty"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e115e094036aedc6, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetBeforeSendHooks() []DenomBeforeSendHooks {
+	if m != nil {
+		return m.BeforeSendHooks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMetadataLockedDenoms() []string {
+	if m != nil {
+		return m.MetadataLockedDenoms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMintPausedDenoms() []string {
+	if m != nil {
+		return m.MintPausedDenoms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSendDisabledDenoms() []string {
+	if m != nil {
+		return m.SendDisabledDenoms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAdminHistories() []DenomAdminHistory {
+	if m != nil {
+		return m.AdminHistories
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBurnedDenoms() []DenomBurned {
+	if m != nil {
+		return m.BurnedDenoms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEvmGasPriceFloors() []EVMGasPriceFloor {
+	if m != nil {
+		return m.EvmGasPriceFloors
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAutoCompoundDelegators() []string {
+	if m != nil {
+		return m.AutoCompoundDelegators
+	}
+	return nil
+}
+
+// EVMGasPriceFloor is the minimum gas price required from an EVM sender.
+type EVMGasPriceFloor struct {
+	// address is the hex address of the sender.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// min_gas_price is the minimum gas price of the sender, in the EVM denom.
+	MinGasPrice cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=min_gas_price,json=minGasPrice,proto3,customtype=cosmossdk.io/math.Int" json:"min_gas_price"`
+}
+
+func (m *EVMGasPriceFloor) Reset()         { *m = EVMGasPriceFloor{} }
+func (m *EVMGasPriceFloor) String() string { return proto.CompactTextString(m) }
+func (*EVMGasPriceFloor) ProtoMessage()    {}
+func (*EVMGasPriceFloor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e115e094036aedc6, []int{1}
+}
+func (m *EVMGasPriceFloor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EVMGasPriceFloor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EVMGasPriceFloor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EVMGasPriceFloor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EVMGasPriceFloor.Merge(m, src)
+}
+func (m *EVMGasPriceFloor) XXX_Size() int {
+	return m.Size()
+}
+func (m *EVMGasPriceFloor) XXX_DiscardUnknown() {
+	xxx_messageInfo_EVMGasPriceFloor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EVMGasPriceFloor proto.InternalMessageInfo
+
+func (m *EVMGasPriceFloor) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "kudora.kudora.v1.GenesisState")
+	proto.RegisterType((*EVMGasPriceFloor)(nil), "kudora.kudora.v1.EVMGasPriceFloor")
+}
+
+func init() { proto.RegisterFile("kudora/kudora/v1/genesis.proto", fileDescriptor_e115e094036aedc6) }
+
+var fileDescriptor_e115e094036aedc6 = []byte{
+	// 554 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x53, 0x4f, 0x6b, 0x1a, 0x41,
+	0x14, 0xd7, 0xfc, 0x31, 0x75, 0x4c, 0x1a, 0xb3, 0x98, 0xb0, 0x0d, 0x64, 0x23, 0x06, 0x8a, 0xd0,
+	0x66, 0x6d, 0xd2, 0xd2, 0x7b, 0xac, 0x6d, 0x0c, 0xb4, 0x54, 0x56, 0x28, 0x6d, 0x2f, 0xcb, 0xe8,
+	0x8c, 0xeb, 0xa0, 0x33, 0x4f, 0x76, 0x46, 0xa9, 0x87, 0x9e, 0xfa, 0x05, 0xfa, 0x61, 0xf2, 0x21,
+	0x72, 0x0c, 0x39, 0x95, 0x1e, 0x42, 0xd1, 0x2f, 0x52, 0x66, 0x76, 0x36, 0x92, 0x48, 0x4e, 0xbb,
+	0xf3, 0x7e, 0x7f, 0xde, 0xef, 0x3d, 0x66, 0x90, 0x37, 0x18, 0x13, 0x88, 0x71, 0xcd, 0x7e, 0x26,
+	0x27, 0xb5, 0x88, 0x0a, 0x2a, 0x99, 0xf4, 0x47, 0x31, 0x28, 0x70, 0x8a, 0x09, 0xe0, 0xdb, 0xcf,
+	0xe4, 0x64, 0xff, 0x59, 0x17, 0x24, 0x07, 0x19, 0x1a, 0xbc, 0x96, 0x1c, 0x12, 0xf2, 0x7e, 0x29,
+	0x82, 0x08, 0x92, 0xba, 0xfe, 0xb3, 0xd5, 0x83, 0xa5, 0x16, 0x23, 0x1c, 0x63, 0x9e, 0x8a, 0x8e,
+	0x96, 0x60, 0x05, 0x03, 0x2a, 0x7a, 0xb8, 0xab, 0x20, 0x9e, 0x26, 0xa4, 0xca, 0xaf, 0x75, 0xb4,
+	0x79, 0x9e, 0x04, 0x6b, 0x2b, 0xac, 0xa8, 0xf3, 0x16, 0xe5, 0x12, 0x17, 0x37, 0x5b, 0xce, 0x56,
+	0x0b, 0xa7, 0xae, 0xff, 0x30, 0xa8, 0xdf, 0x32, 0x78, 0x7d, 0xed, 0xea, 0xf6, 0x30, 0x13, 0x58,
+	0xb6, 0xf3, 0x15, 0xed, 0x74, 0x68, 0x0f, 0x62, 0x1a, 0x4a, 0x2a, 0x48, 0xd8, 0x07, 0x18, 0x48,
+	0x77, 0xa5, 0xbc, 0x5a, 0x2d, 0x9c, 0x3e, 0x5f, 0xb6, 0x68, 0x50, 0x01, 0xbc, 0x6e, 0xf8, 0x6d,
+	0x2a, 0x48, 0x53, 0xb3, 0xad, 0xe1, 0x76, 0xe7, 0x7e, 0xd9, 0x79, 0x83, 0xf6, 0x38, 0x55, 0x98,
+	0x60, 0x85, 0xc3, 0x21, 0x74, 0x07, 0x94, 0x84, 0x44, 0xcb, 0xa5, 0xbb, 0x5a, 0x5e, 0xad, 0xe6,
+	0x83, 0x52, 0x8a, 0x7e, 0x34, 0xa0, 0xb1, 0x96, 0xce, 0x4b, 0xe4, 0x70, 0x26, 0x54, 0x38, 0xc2,
+	0x63, 0xb9, 0x50, 0xac, 0x19, 0x45, 0x51, 0x23, 0x2d, 0x03, 0x58, 0xf6, 0x2b, 0x54, 0x32, 0xb1,
+	0x09, 0x93, 0xb8, 0x33, 0x5c, 0xf0, 0xd7, 0x0d, 0xdf, 0xd1, 0x58, 0xc3, 0x42, 0x56, 0x11, 0xa0,
+	0x6d, 0x4c, 0x38, 0x13, 0x61, 0x9f, 0x49, 0x05, 0x31, 0xa3, 0xd2, 0xcd, 0x99, 0x69, 0x8f, 0x1e,
+	0x99, 0xf6, 0x4c, 0xb3, 0x9b, 0x86, 0x3c, 0xb5, 0xa3, 0x3e, 0xc5, 0x8b, 0x1a, 0xa3, 0xd2, 0x69,
+	0xa2, 0xad, 0xce, 0x38, 0x16, 0x8b, 0xf6, 0x1b, 0xc6, 0xf1, 0xe0, 0xb1, 0xfd, 0x19, 0xae, 0xf5,
+	0xda, 0x4c, 0x94, 0x36, 0xdd, 0x37, 0x54, 0xa2, 0x13, 0x1e, 0x46, 0x58, 0x5f, 0x27, 0xd6, 0xa5,
+	0x61, 0x6f, 0x08, 0x10, 0x4b, 0xf7, 0x89, 0x31, 0xac, 0x2c, 0x1b, 0xbe, 0xff, 0xf2, 0xe9, 0x1c,
+	0xcb, 0x96, 0xe6, 0x7e, 0xd0, 0x54, 0xeb, 0xba, 0x43, 0x27, 0xfc, 0x5e, 0x5d, 0x0f, 0xee, 0xe2,
+	0xb1, 0x82, 0xb0, 0x0b, 0x7c, 0x04, 0x63, 0xbd, 0x33, 0x3a, 0xa4, 0x11, 0x56, 0xda, 0x3e, 0xaf,
+	0xd7, 0x55, 0x77, 0x6f, 0x2e, 0x8f, 0x4b, 0xf6, 0xfe, 0x9e, 0x11, 0x12, 0x53, 0x29, 0xdb, 0x2a,
+	0x66, 0x22, 0x0a, 0xf6, 0xb4, 0xf2, 0x9d, 0x15, 0x36, 0xee, 0x74, 0x95, 0x9f, 0xa8, 0xf8, 0x30,
+	0x80, 0xe3, 0xa2, 0x0d, 0x9c, 0x88, 0xcd, 0x4d, 0xcc, 0x07, 0xe9, 0xd1, 0xf9, 0x8c, 0xb6, 0xf4,
+	0xe2, 0xef, 0x86, 0x73, 0x57, 0x34, 0x5e, 0x7f, 0xa1, 0x13, 0xff, 0xbd, 0x3d, 0xdc, 0x4d, 0x5a,
+	0x4b, 0x32, 0xf0, 0x19, 0xd4, 0x38, 0x56, 0x7d, 0xff, 0x42, 0xa8, 0x9b, 0xcb, 0x63, 0x64, 0x33,
+	0x5d, 0x08, 0x15, 0x14, 0x38, 0x13, 0x69, 0xbf, 0x7a, 0xed, 0x6a, 0xe6, 0x65, 0xaf, 0x67, 0x5e,
+	0xf6, 0xdf, 0xcc, 0xcb, 0xfe, 0x9e, 0x7b, 0x99, 0xeb, 0xb9, 0x97, 0xf9, 0x33, 0xf7, 0x32, 0xdf,
+	0x77, 0xed, 0xe3, 0xf9, 0x91, 0xbe, 0x22, 0x35, 0x1d, 0x51, 0xd9, 0xc9, 0x99, 0xc7, 0xf3, 0xfa,
+	0xff, 0x00, 0xa9, 0x34, 0x8d, 0xc7, 0xe5, 0x03, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AutoCompoundDelegators) > 0 {
+		for iNdEx := len(m.AutoCompoundDelegators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AutoCompoundDelegators[iNdEx])
+			copy(dAtA[i:], m.AutoCompoundDelegators[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AutoCompoundDelegators[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.EvmGasPriceFloors) > 0 {
+		for iNdEx := len(m.EvmGasPriceFloors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EvmGasPriceFloors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.BurnedDenoms) > 0 {
+		for iNdEx := len(m.BurnedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BurnedDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.AdminHistories) > 0 {
+		for iNdEx := len(m.AdminHistories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdminHistories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.SendDisabledDenoms) > 0 {
+		for iNdEx := len(m.SendDisabledDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SendDisabledDenoms[iNdEx])
+			copy(dAtA[i:], m.SendDisabledDenoms[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.SendDisabledDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.MintPausedDenoms) > 0 {
+		for iNdEx := len(m.MintPausedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MintPausedDenoms[iNdEx])
+			copy(dAtA[i:], m.MintPausedDenoms[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.MintPausedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.MetadataLockedDenoms) > 0 {
+		for iNdEx := len(m.MetadataLockedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MetadataLockedDenoms[iNdEx])
+			copy(dAtA[i:], m.MetadataLockedDenoms[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.MetadataLockedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.BeforeSendHooks) > 0 {
+		for iNdEx := len(m.BeforeSendHooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BeforeSendHooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EVMGasPriceFloor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EVMGasPriceFloor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EVMGasPriceFloor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinGasPrice.Size()
+		i -= size
+		if _, err := m.MinGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.BeforeSendHooks) > 0 {
+		for _, e := range m.BeforeSendHooks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MetadataLockedDenoms) > 0 {
+		for _, s := range m.MetadataLockedDenoms {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MintPausedDenoms) > 0 {
+		for _, s := range m.MintPausedDenoms {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SendDisabledDenoms) > 0 {
+		for _, s := range m.SendDisabledDenoms {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AdminHistories) > 0 {
+		for _, e := range m.AdminHistories {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BurnedDenoms) > 0 {
+		for _, e := range m.BurnedDenoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EvmGasPriceFloors) > 0 {
+		for _, e := range m.EvmGasPriceFloors {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AutoCompoundDelegators) > 0 {
+		for _, s := range m.AutoCompoundDelegators {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EVMGasPriceFloor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.MinGasPrice.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeforeSendHooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeforeSendHooks = append(m.BeforeSendHooks, DenomBeforeSendHooks{})
+			if err := m.BeforeSendHooks[len(m.BeforeSendHooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataLockedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataLockedDenoms = append(m.MetadataLockedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintPausedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintPausedDenoms = append(m.MintPausedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendDisabledDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendDisabledDenoms = append(m.SendDisabledDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminHistories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdminHistories = append(m.AdminHistories, DenomAdminHistory{})
+			if err := m.AdminHistories[len(m.AdminHistories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnedDenoms = append(m.BurnedDenoms, DenomBurned{})
+			if err := m.BurnedDenoms[len(m.BurnedDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmGasPriceFloors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmGasPriceFloors = append(m.EvmGasPriceFloors, EVMGasPriceFloor{})
+			if err := m.EvmGasPriceFloors[len(m.EvmGasPriceFloors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundDelegators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundDelegators = append(m.AutoCompoundDelegators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EVMGasPriceFloor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EVMGasPriceFloor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EVMGasPriceFloor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
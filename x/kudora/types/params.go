@@ -0,0 +1,162 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+	"github.com/ethereum/go-ethereum/common"
+	gethvm "github.com/ethereum/go-ethereum/core/vm"
+	gethparams "github.com/ethereum/go-ethereum/params"
+)
+
+const (
+	// DefaultAutoCompoundMaxDelegationsPerBlock is the default cap on the
+	// delegations compounded per block.
+	DefaultAutoCompoundMaxDelegationsPerBlock = 100
+
+	// DefaultIBCPacketCountWindow is the default window of the IBC packet
+	// count limits.
+	DefaultIBCPacketCountWindow = time.Hour
+)
+
+// DefaultParams returns the default Kudora params, with every extension they
+// control disabled.
+func DefaultParams() Params {
+	return Params{
+		AutoCompoundMaxDelegationsPerBlock: DefaultAutoCompoundMaxDelegationsPerBlock,
+		IbcPacketCountWindow:               DefaultIBCPacketCountWindow,
+		MinSelfDelegation:                  sdkmath.ZeroInt(),
+	}
+}
+
+// Validate performs basic validation of the params.
+func (p Params) Validate() error {
+	if p.AutoCompoundEpochBlocks > 0 && p.AutoCompoundMaxDelegationsPerBlock == 0 {
+		return fmt.Errorf("auto-compound max delegations per block must be positive when auto-compounding is enabled")
+	}
+	if p.AutoCompoundMaxDelegationsPerBlock > math.MaxUint16 {
+		return fmt.Errorf("auto-compound max delegations per block must be at most %d, got %d", math.MaxUint16, p.AutoCompoundMaxDelegationsPerBlock)
+	}
+
+	if p.CommunityPoolFeePercent > 100 {
+		return fmt.Errorf("community pool fee percent must be at most 100, got %d", p.CommunityPoolFeePercent)
+	}
+
+	if !p.MinSelfDelegation.IsNil() && p.MinSelfDelegation.IsNegative() {
+		return fmt.Errorf("min self delegation must not be negative, got %s", p.MinSelfDelegation)
+	}
+
+	if !p.MinProposalDeposit.IsValid() {
+		return fmt.Errorf("invalid min proposal deposit: %s", p.MinProposalDeposit)
+	}
+
+	blockedMsgs := make(map[string]bool, len(p.BlockedProposalMsgs))
+	for _, typeURL := range p.BlockedProposalMsgs {
+		if !strings.HasPrefix(typeURL, "/") {
+			return fmt.Errorf("invalid blocked proposal msg type URL %q", typeURL)
+		}
+		if blockedMsgs[typeURL] {
+			return fmt.Errorf("duplicate blocked proposal msg %s", typeURL)
+		}
+		blockedMsgs[typeURL] = true
+	}
+
+	if p.EvmMaxCodeSize > gethparams.MaxCodeSize {
+		return fmt.Errorf("EVM max code size must be at most %d, got %d", gethparams.MaxCodeSize, p.EvmMaxCodeSize)
+	}
+
+	precompiles := make(map[common.Address]bool, len(p.EvmPrecompileGas))
+	for _, override := range p.EvmPrecompileGas {
+		if !common.IsHexAddress(override.Address) {
+			return fmt.Errorf("invalid EVM precompile gas address %q", override.Address)
+		}
+		address := common.HexToAddress(override.Address)
+		if _, native := gethvm.PrecompiledContractsPrague[address]; native {
+			return fmt.Errorf("invalid EVM precompile gas: %s is an Ethereum precompile", address)
+		}
+		if precompiles[address] {
+			return fmt.Errorf("duplicate EVM precompile gas for %s", address)
+		}
+		precompiles[address] = true
+	}
+
+	msgTypes := make(map[string]bool, len(p.MsgFees))
+	for _, fee := range p.MsgFees {
+		if !strings.HasPrefix(fee.MsgTypeUrl, "/") {
+			return fmt.Errorf("invalid msg fee type URL %q", fee.MsgTypeUrl)
+		}
+		if msgTypes[fee.MsgTypeUrl] {
+			return fmt.Errorf("duplicate msg fee for %s", fee.MsgTypeUrl)
+		}
+		msgTypes[fee.MsgTypeUrl] = true
+
+		if !fee.Fee.IsValid() {
+			return fmt.Errorf("invalid msg fee of %s: %s", fee.MsgTypeUrl, fee.Fee)
+		}
+	}
+
+	if err := validateDenoms("IBC ERC20 denom allowlist", p.IbcErc20DenomAllowlist); err != nil {
+		return err
+	}
+	if err := validateDenoms("IBC rate limit exempt denoms", p.IbcRatelimitExemptDenoms); err != nil {
+		return err
+	}
+
+	if p.IbcPacketForwardRetries > math.MaxUint8 {
+		return fmt.Errorf("IBC packet forward retries must be at most %d, got %d", math.MaxUint8, p.IbcPacketForwardRetries)
+	}
+	if p.IbcPacketForwardTimeout < 0 {
+		return fmt.Errorf("IBC packet forward timeout must not be negative, got %s", p.IbcPacketForwardTimeout)
+	}
+
+	if !p.IbcDustThresholds.IsValid() {
+		return fmt.Errorf("invalid IBC dust thresholds: %s", p.IbcDustThresholds)
+	}
+
+	recipients := make(map[string]bool, len(p.IbcBlockedRecipients))
+	for _, recipient := range p.IbcBlockedRecipients {
+		addr, err := sdk.AccAddressFromBech32(recipient)
+		if err != nil {
+			return fmt.Errorf("invalid IBC blocked recipient %q: %w", recipient, err)
+		}
+		if recipients[string(addr)] {
+			return fmt.Errorf("duplicate IBC blocked recipient %s", recipient)
+		}
+		recipients[string(addr)] = true
+	}
+
+	channels := make(map[string]bool, len(p.IbcPacketCountLimits))
+	for _, limit := range p.IbcPacketCountLimits {
+		if err := host.ChannelIdentifierValidator(limit.ChannelId); err != nil {
+			return fmt.Errorf("invalid IBC packet count limit channel: %w", err)
+		}
+		if channels[limit.ChannelId] {
+			return fmt.Errorf("duplicate IBC packet count limit for channel %s", limit.ChannelId)
+		}
+		channels[limit.ChannelId] = true
+	}
+	if len(p.IbcPacketCountLimits) > 0 && p.IbcPacketCountWindow <= 0 {
+		return fmt.Errorf("IBC packet count window must be positive, got %s", p.IbcPacketCountWindow)
+	}
+	return nil
+}
+
+// validateDenoms checks that denoms are valid and listed once.
+func validateDenoms(name string, denoms []string) error {
+	seen := make(map[string]bool, len(denoms))
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid %s: %w", name, err)
+		}
+		if seen[denom] {
+			return fmt.Errorf("duplicate %s denom %s", name, denom)
+		}
+		seen[denom] = true
+	}
+	return nil
+}
@@ -0,0 +1,2726 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kudora/kudora/v1/params.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the on-chain parameters of the Kudora extensions to other
+// modules. They are set at genesis and updated by governance through
+// MsgUpdateParams, so that every validator applies the same values.
+type Params struct {
+	// burn_evm_gas_refunds burns the fees of the gas EVM transactions don't
+	// use instead of refunding them to the sender, so that senders pay for
+	// their whole gas limit.
+	BurnEvmGasRefunds bool `protobuf:"varint,1,opt,name=burn_evm_gas_refunds,json=burnEvmGasRefunds,proto3" json:"burn_evm_gas_refunds,omitempty"`
+	// auto_compound_epoch_blocks is the number of blocks between two claims
+	// and re-delegations of the staking rewards of the delegators that opted
+	// in to auto-compounding. Zero disables auto-compounding.
+	AutoCompoundEpochBlocks uint64 `protobuf:"varint,2,opt,name=auto_compound_epoch_blocks,json=autoCompoundEpochBlocks,proto3" json:"auto_compound_epoch_blocks,omitempty"`
+	// auto_compound_max_delegations_per_block caps the delegations whose
+	// rewards are compounded in a single block. An epoch whose delegations
+	// don't fit in one block is spread over the following ones. At most this
+	// many delegations of each delegator are compounded per epoch.
+	AutoCompoundMaxDelegationsPerBlock uint64 `protobuf:"varint,3,opt,name=auto_compound_max_delegations_per_block,json=autoCompoundMaxDelegationsPerBlock,proto3" json:"auto_compound_max_delegations_per_block,omitempty"`
+	// ibc_packet_count_limits caps the number of IBC transfer packets received
+	// per ibc_packet_count_window on the given channels, against packet floods.
+	IbcPacketCountLimits []ChannelPacketCountLimit `protobuf:"bytes,4,rep,name=ibc_packet_count_limits,json=ibcPacketCountLimits,proto3" json:"ibc_packet_count_limits"`
+	// ibc_packet_count_window is the duration of the windows of
+	// ibc_packet_count_limits. Windows are aligned on multiples of it.
+	IbcPacketCountWindow time.Duration `protobuf:"bytes,5,opt,name=ibc_packet_count_window,json=ibcPacketCountWindow,proto3,stdduration" json:"ibc_packet_count_window"`
+	// ibc_denom_metadata names the IBC vouchers received for the first time
+	// after their source denom, such as uatom, in their bank metadata instead
+	// of their full trace.
+	IbcDenomMetadata bool `protobuf:"varint,6,opt,name=ibc_denom_metadata,json=ibcDenomMetadata,proto3" json:"ibc_denom_metadata,omitempty"`
+	// wasm_max_contract_storage_entries caps the number of storage entries a
+	// single wasm contract may add while the cap is enabled, net of the entries
+	// it deletes. Entries written before are not counted. Contract calls
+	// writing more fail. Zero disables the cap.
+	WasmMaxContractStorageEntries uint64 `protobuf:"varint,7,opt,name=wasm_max_contract_storage_entries,json=wasmMaxContractStorageEntries,proto3" json:"wasm_max_contract_storage_entries,omitempty"`
+	// tokenfactory_admin_change_cooldown_blocks is the minimum number of blocks
+	// between two admin changes of a tokenfactory denom, against rapid admin
+	// swapping. Zero disables the cooldown.
+	TokenfactoryAdminChangeCooldownBlocks uint64 `protobuf:"varint,8,opt,name=tokenfactory_admin_change_cooldown_blocks,json=tokenfactoryAdminChangeCooldownBlocks,proto3" json:"tokenfactory_admin_change_cooldown_blocks,omitempty"`
+	// community_pool_fee_percent is the percentage of the fees collected in
+	// each block sent to the community pool, before the distribution module
+	// allocates the rest. Zero disables it.
+	CommunityPoolFeePercent uint32 `protobuf:"varint,9,opt,name=community_pool_fee_percent,json=communityPoolFeePercent,proto3" json:"community_pool_fee_percent,omitempty"`
+	// slash_to_community_pool sends the tokens slashed from validators to the
+	// community pool instead of burning them.
+	SlashToCommunityPool bool `protobuf:"varint,10,opt,name=slash_to_community_pool,json=slashToCommunityPool,proto3" json:"slash_to_community_pool,omitempty"`
+	// msg_fees are fixed fees charged, on top of gas fees, for each message of
+	// the given types, including messages wrapped in an authz MsgExec. They
+	// are sent to the community pool.
+	MsgFees []MsgFee `protobuf:"bytes,11,rep,name=msg_fees,json=msgFees,proto3" json:"msg_fees"`
+	// min_self_delegation is the lowest minimum self delegation, in base
+	// units, validators may be created or edited with. Zero disables it.
+	MinSelfDelegation cosmossdk_io_math.Int `protobuf:"bytes,12,opt,name=min_self_delegation,json=minSelfDelegation,proto3,customtype=cosmossdk.io/math.Int" json:"min_self_delegation"`
+	// min_proposal_deposit is the lowest initial deposit governance proposals
+	// may be submitted with, on top of the gov min initial deposit ratio.
+	// Empty disables it.
+	MinProposalDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,13,rep,name=min_proposal_deposit,json=minProposalDeposit,proto3" json:"min_proposal_deposit"`
+	// blocked_proposal_msgs are the msg type URLs, such as
+	// /cosmos.bank.v1beta1.MsgSend, that governance proposals are rejected for
+	// if they would execute them, including wrapped in an authz MsgExec.
+	BlockedProposalMsgs []string `protobuf:"bytes,14,rep,name=blocked_proposal_msgs,json=blockedProposalMsgs,proto3" json:"blocked_proposal_msgs,omitempty"`
+	// wasm_storage_write_cost_flat and wasm_storage_write_cost_per_byte are
+	// the gas charged for writes to the wasm store, including contract
+	// storage, to discourage storage bloat. Zero keeps the SDK default.
+	WasmStorageWriteCostFlat    uint64 `protobuf:"varint,15,opt,name=wasm_storage_write_cost_flat,json=wasmStorageWriteCostFlat,proto3" json:"wasm_storage_write_cost_flat,omitempty"`
+	WasmStorageWriteCostPerByte uint64 `protobuf:"varint,16,opt,name=wasm_storage_write_cost_per_byte,json=wasmStorageWriteCostPerByte,proto3" json:"wasm_storage_write_cost_per_byte,omitempty"`
+	// evm_precompile_gas overrides the gas cost of calls to the given
+	// precompiles. The Ethereum precompiles keep their Ethereum costs.
+	EvmPrecompileGas []PrecompileGas `protobuf:"bytes,17,rep,name=evm_precompile_gas,json=evmPrecompileGas,proto3" json:"evm_precompile_gas"`
+	// evm_max_code_size lowers the maximum size, in bytes, of the code of
+	// contracts deployed by EVM transactions. Zero keeps the EIP-170 limit of
+	// 24576 bytes, which can't be raised.
+	EvmMaxCodeSize uint64 `protobuf:"varint,18,opt,name=evm_max_code_size,json=evmMaxCodeSize,proto3" json:"evm_max_code_size,omitempty"`
+	// tokenfactory_max_denoms_per_creator caps the number of tokenfactory
+	// denoms a single account or contract can create. Zero disables the cap.
+	TokenfactoryMaxDenomsPerCreator uint64 `protobuf:"varint,19,opt,name=tokenfactory_max_denoms_per_creator,json=tokenfactoryMaxDenomsPerCreator,proto3" json:"tokenfactory_max_denoms_per_creator,omitempty"`
+	// tokenfactory_unique_symbols rejects tokenfactory metadata updates
+	// reusing the symbol of another denom, to make look-alike tokens harder.
+	TokenfactoryUniqueSymbols bool `protobuf:"varint,20,opt,name=tokenfactory_unique_symbols,json=tokenfactoryUniqueSymbols,proto3" json:"tokenfactory_unique_symbols,omitempty"`
+	// tokenfactory_unregister_burned_pairs deletes the ERC20 token pair and
+	// precompile of a tokenfactory denom once burns bring its supply down to
+	// zero.
+	TokenfactoryUnregisterBurnedPairs bool `protobuf:"varint,21,opt,name=tokenfactory_unregister_burned_pairs,json=tokenfactoryUnregisterBurnedPairs,proto3" json:"tokenfactory_unregister_burned_pairs,omitempty"`
+	// min_gas_price_bootstrap_blocks accepts Cosmos transactions below the
+	// minimum gas price up to this block height, while the fee market warms
+	// up. Zero disables the bypass.
+	MinGasPriceBootstrapBlocks uint64 `protobuf:"varint,22,opt,name=min_gas_price_bootstrap_blocks,json=minGasPriceBootstrapBlocks,proto3" json:"min_gas_price_bootstrap_blocks,omitempty"`
+	// wasm_max_instantiations_per_block caps the contract instantiations,
+	// including those wrapped in an authz MsgExec, accepted per block. Zero
+	// disables the cap.
+	WasmMaxInstantiationsPerBlock uint64 `protobuf:"varint,23,opt,name=wasm_max_instantiations_per_block,json=wasmMaxInstantiationsPerBlock,proto3" json:"wasm_max_instantiations_per_block,omitempty"`
+	// evm_block_contract_precompile_calls only lets externally owned accounts
+	// call the precompiles other than the Ethereum ones, rejecting calls made by
+	// contracts.
+	EvmBlockContractPrecompileCalls bool `protobuf:"varint,24,opt,name=evm_block_contract_precompile_calls,json=evmBlockContractPrecompileCalls,proto3" json:"evm_block_contract_precompile_calls,omitempty"`
+	// ibc_erc20_denom_allowlist lists the IBC denoms, as credited on this chain
+	// (ibc/...), that the ERC20 middleware converts on receive. Other denoms are
+	// credited as native coins. Empty converts every denom.
+	IbcErc20DenomAllowlist []string `protobuf:"bytes,25,rep,name=ibc_erc20_denom_allowlist,json=ibcErc20DenomAllowlist,proto3" json:"ibc_erc20_denom_allowlist,omitempty"`
+	// ibc_disable_packet_forward rejects incoming transfers whose memo asks the
+	// packet forward middleware to forward them.
+	IbcDisablePacketForward bool `protobuf:"varint,26,opt,name=ibc_disable_packet_forward,json=ibcDisablePacketForward,proto3" json:"ibc_disable_packet_forward,omitempty"`
+	// ibc_ratelimit_exempt_denoms lists the denoms, as credited on this chain
+	// (ibc/...), whose incoming transfers bypass the IBC rate limits.
+	IbcRatelimitExemptDenoms []string `protobuf:"bytes,27,rep,name=ibc_ratelimit_exempt_denoms,json=ibcRatelimitExemptDenoms,proto3" json:"ibc_ratelimit_exempt_denoms,omitempty"`
+	// ibc_max_memo_bytes caps the memo length of incoming IBC transfers. Zero
+	// keeps only the transfer module limit.
+	IbcMaxMemoBytes uint64 `protobuf:"varint,28,opt,name=ibc_max_memo_bytes,json=ibcMaxMemoBytes,proto3" json:"ibc_max_memo_bytes,omitempty"`
+	// ibc_blocked_recipients lists local addresses, in bech32, that incoming
+	// IBC transfers are rejected for.
+	IbcBlockedRecipients []string `protobuf:"bytes,29,rep,name=ibc_blocked_recipients,json=ibcBlockedRecipients,proto3" json:"ibc_blocked_recipients,omitempty"`
+	// ibc_dust_thresholds are the minimum amounts of incoming IBC transfers,
+	// keyed by their denom on this chain. Smaller transfers are refunded.
+	IbcDustThresholds github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,30,rep,name=ibc_dust_thresholds,json=ibcDustThresholds,proto3" json:"ibc_dust_thresholds"`
+	// wasm_denom_metadata registers minimal bank metadata for the denoms
+	// contracts create through the tokenfactory custom bindings.
+	WasmDenomMetadata bool `protobuf:"varint,31,opt,name=wasm_denom_metadata,json=wasmDenomMetadata,proto3" json:"wasm_denom_metadata,omitempty"`
+	// ibc_packet_forward_retries is the number of times the packet forward
+	// middleware resends a forwarded packet that timed out before giving up
+	// and failing the incoming transfer, at most 255, unless the forward memo
+	// sets its own. Retries are sent as soon as the timeout is processed, with
+	// no backoff. Zero fails the transfer on the first timeout.
+	IbcPacketForwardRetries uint32 `protobuf:"varint,32,opt,name=ibc_packet_forward_retries,json=ibcPacketForwardRetries,proto3" json:"ibc_packet_forward_retries,omitempty"`
+	// ibc_packet_forward_timeout is the timeout of forwarded packets, unless
+	// the forward memo sets its own. Zero uses the packet forward middleware
+	// default of 28 days.
+	IbcPacketForwardTimeout time.Duration `protobuf:"bytes,33,opt,name=ibc_packet_forward_timeout,json=ibcPacketForwardTimeout,proto3,stdduration" json:"ibc_packet_forward_timeout"`
+	// evm_jail_missed_blocks jails the bonded validators that missed more than
+	// this many blocks of the slashing signed blocks window, a tighter
+	// threshold than the slashing min_signed_per_window meant for EVM block
+	// production. They stay jailed for the slashing downtime jail duration but
+	// are not slashed. Zero disables it.
+	EvmJailMissedBlocks uint64 `protobuf:"varint,34,opt,name=evm_jail_missed_blocks,json=evmJailMissedBlocks,proto3" json:"evm_jail_missed_blocks,omitempty"`
+	// max_tx_signers caps the number of distinct signers of Cosmos
+	// transactions, in CheckTx and block execution alike, to bound the cost of
+	// their signature verification. Nodes may set a lower cap for their own
+	// mempool. Zero disables it.
+	MaxTxSigners uint64 `protobuf:"varint,35,opt,name=max_tx_signers,json=maxTxSigners,proto3" json:"max_tx_signers,omitempty"`
+	// reject_expired_txs rejects Cosmos and EVM transactions whose timeout
+	// height or timeout timestamp has passed at the start of the ante handler,
+	// before any other work is done on them.
+	RejectExpiredTxs bool `protobuf:"varint,36,opt,name=reject_expired_txs,json=rejectExpiredTxs,proto3" json:"reject_expired_txs,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_06558562d99cbbc3, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetBurnEvmGasRefunds() bool {
+	if m != nil {
+		return m.BurnEvmGasRefunds
+	}
+	return false
+}
+
+func (m *Params) GetAutoCompoundEpochBlocks() uint64 {
+	if m != nil {
+		return m.AutoCompoundEpochBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetAutoCompoundMaxDelegationsPerBlock() uint64 {
+	if m != nil {
+		return m.AutoCompoundMaxDelegationsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetIbcPacketCountLimits() []ChannelPacketCountLimit {
+	if m != nil {
+		return m.IbcPacketCountLimits
+	}
+	return nil
+}
+
+func (m *Params) GetIbcPacketCountWindow() time.Duration {
+	if m != nil {
+		return m.IbcPacketCountWindow
+	}
+	return 0
+}
+
+func (m *Params) GetIbcDenomMetadata() bool {
+	if m != nil {
+		return m.IbcDenomMetadata
+	}
+	return false
+}
+
+func (m *Params) GetWasmMaxContractStorageEntries() uint64 {
+	if m != nil {
+		return m.WasmMaxContractStorageEntries
+	}
+	return 0
+}
+
+func (m *Params) GetTokenfactoryAdminChangeCooldownBlocks() uint64 {
+	if m != nil {
+		return m.TokenfactoryAdminChangeCooldownBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetCommunityPoolFeePercent() uint32 {
+	if m != nil {
+		return m.CommunityPoolFeePercent
+	}
+	return 0
+}
+
+func (m *Params) GetSlashToCommunityPool() bool {
+	if m != nil {
+		return m.SlashToCommunityPool
+	}
+	return false
+}
+
+func (m *Params) GetMsgFees() []MsgFee {
+	if m != nil {
+		return m.MsgFees
+	}
+	return nil
+}
+
+func (m *Params) GetMinProposalDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinProposalDeposit
+	}
+	return nil
+}
+
+func (m *Params) GetBlockedProposalMsgs() []string {
+	if m != nil {
+		return m.BlockedProposalMsgs
+	}
+	return nil
+}
+
+func (m *Params) GetWasmStorageWriteCostFlat() uint64 {
+	if m != nil {
+		return m.WasmStorageWriteCostFlat
+	}
+	return 0
+}
+
+func (m *Params) GetWasmStorageWriteCostPerByte() uint64 {
+	if m != nil {
+		return m.WasmStorageWriteCostPerByte
+	}
+	return 0
+}
+
+func (m *Params) GetEvmPrecompileGas() []PrecompileGas {
+	if m != nil {
+		return m.EvmPrecompileGas
+	}
+	return nil
+}
+
+func (m *Params) GetEvmMaxCodeSize() uint64 {
+	if m != nil {
+		return m.EvmMaxCodeSize
+	}
+	return 0
+}
+
+func (m *Params) GetTokenfactoryMaxDenomsPerCreator() uint64 {
+	if m != nil {
+		return m.TokenfactoryMaxDenomsPerCreator
+	}
+	return 0
+}
+
+func (m *Params) GetTokenfactoryUniqueSymbols() bool {
+	if m != nil {
+		return m.TokenfactoryUniqueSymbols
+	}
+	return false
+}
+
+func (m *Params) GetTokenfactoryUnregisterBurnedPairs() bool {
+	if m != nil {
+		return m.TokenfactoryUnregisterBurnedPairs
+	}
+	return false
+}
+
+func (m *Params) GetMinGasPriceBootstrapBlocks() uint64 {
+	if m != nil {
+		return m.MinGasPriceBootstrapBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetWasmMaxInstantiationsPerBlock() uint64 {
+	if m != nil {
+		return m.WasmMaxInstantiationsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetEvmBlockContractPrecompileCalls() bool {
+	if m != nil {
+		return m.EvmBlockContractPrecompileCalls
+	}
+	return false
+}
+
+func (m *Params) GetIbcErc20DenomAllowlist() []string {
+	if m != nil {
+		return m.IbcErc20DenomAllowlist
+	}
+	return nil
+}
+
+func (m *Params) GetIbcDisablePacketForward() bool {
+	if m != nil {
+		return m.IbcDisablePacketForward
+	}
+	return false
+}
+
+func (m *Params) GetIbcRatelimitExemptDenoms() []string {
+	if m != nil {
+		return m.IbcRatelimitExemptDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetIbcMaxMemoBytes() uint64 {
+	if m != nil {
+		return m.IbcMaxMemoBytes
+	}
+	return 0
+}
+
+func (m *Params) GetIbcBlockedRecipients() []string {
+	if m != nil {
+		return m.IbcBlockedRecipients
+	}
+	return nil
+}
+
+func (m *Params) GetIbcDustThresholds() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.IbcDustThresholds
+	}
+	return nil
+}
+
+func (m *Params) GetWasmDenomMetadata() bool {
+	if m != nil {
+		return m.WasmDenomMetadata
+	}
+	return false
+}
+
+func (m *Params) GetIbcPacketForwardRetries() uint32 {
+	if m != nil {
+		return m.IbcPacketForwardRetries
+	}
+	return 0
+}
+
+func (m *Params) GetIbcPacketForwardTimeout() time.Duration {
+	if m != nil {
+		return m.IbcPacketForwardTimeout
+	}
+	return 0
+}
+
+func (m *Params) GetEvmJailMissedBlocks() uint64 {
+	if m != nil {
+		return m.EvmJailMissedBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxTxSigners() uint64 {
+	if m != nil {
+		return m.MaxTxSigners
+	}
+	return 0
+}
+
+func (m *Params) GetRejectExpiredTxs() bool {
+	if m != nil {
+		return m.RejectExpiredTxs
+	}
+	return false
+}
+
+// MsgFee is the fixed fee charged for each message of a type.
+type MsgFee struct {
+	// msg_type_url is the type URL of the message, such as
+	// /osmosis.tokenfactory.v1beta1.MsgCreateDenom.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// fee is charged for each message of the type.
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fee,proto3" json:"fee"`
+}
+
+func (m *MsgFee) Reset()         { *m = MsgFee{} }
+func (m *MsgFee) String() string { return proto.CompactTextString(m) }
+func (*MsgFee) ProtoMessage()    {}
+func (*MsgFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_06558562d99cbbc3, []int{1}
+}
+func (m *MsgFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFee.Merge(m, src)
+}
+func (m *MsgFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFee proto.InternalMessageInfo
+
+func (m *MsgFee) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MsgFee) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
+// PrecompileGas is the gas cost of every call to a precompile, whatever its
+// input.
+type PrecompileGas struct {
+	// address is the hex address of the precompile, such as
+	// 0x0000000000000000000000000000000000000400 for bech32.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// gas is charged for each call to the precompile.
+	Gas uint64 `protobuf:"varint,2,opt,name=gas,proto3" json:"gas,omitempty"`
+}
+
+func (m *PrecompileGas) Reset()         { *m = PrecompileGas{} }
+func (m *PrecompileGas) String() string { return proto.CompactTextString(m) }
+func (*PrecompileGas) ProtoMessage()    {}
+func (*PrecompileGas) Descriptor() ([]byte, []int) {
+	return fileDescriptor_06558562d99cbbc3, []int{2}
+}
+func (m *PrecompileGas) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrecompileGas) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrecompileGas.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrecompileGas) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrecompileGas.Merge(m, src)
+}
+func (m *PrecompileGas) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrecompileGas) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrecompileGas.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrecompileGas proto.InternalMessageInfo
+
+func (m *PrecompileGas) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PrecompileGas) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+// ChannelPacketCountLimit caps the packets received on a channel per window.
+type ChannelPacketCountLimit struct {
+	// channel_id is the destination channel of the packets, on this chain.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// max_packets is the number of packets accepted per window.
+	MaxPackets uint64 `protobuf:"varint,2,opt,name=max_packets,json=maxPackets,proto3" json:"max_packets,omitempty"`
+}
+
+func (m *ChannelPacketCountLimit) Reset()         { *m = ChannelPacketCountLimit{} }
+func (m *ChannelPacketCountLimit) String() string { return proto.CompactTextString(m) }
+func (*ChannelPacketCountLimit) ProtoMessage()    {}
+func (*ChannelPacketCountLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_06558562d99cbbc3, []int{3}
+}
+func (m *ChannelPacketCountLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelPacketCountLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelPacketCountLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelPacketCountLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelPacketCountLimit.Merge(m, src)
+}
+func (m *ChannelPacketCountLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelPacketCountLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelPacketCountLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelPacketCountLimit proto.InternalMessageInfo
+
+func (m *ChannelPacketCountLimit) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ChannelPacketCountLimit) GetMaxPackets() uint64 {
+	if m != nil {
+		return m.MaxPackets
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "kudora.kudora.v1.Params")
+	proto.RegisterType((*MsgFee)(nil), "kudora.kudora.v1.MsgFee")
+	proto.RegisterType((*PrecompileGas)(nil), "kudora.kudora.v1.PrecompileGas")
+	proto.RegisterType((*ChannelPacketCountLimit)(nil), "kudora.kudora.v1.ChannelPacketCountLimit")
+}
+
+func init() { proto.RegisterFile("kudora/kudora/v1/params.proto", fileDescriptor_06558562d99cbbc3) }
+
+var fileDescriptor_06558562d99cbbc3 = []byte{
+	// 1483 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0x1b, 0xb9,
+	0x15, 0xb7, 0xd6, 0xa9, 0x63, 0xd3, 0x49, 0xd6, 0xa6, 0xed, 0x98, 0xb6, 0xd7, 0x92, 0xe2, 0xa4,
+	0xa8, 0x82, 0xed, 0x4a, 0x6b, 0x6f, 0x7b, 0x58, 0x04, 0x5d, 0x20, 0x92, 0xed, 0xd4, 0x45, 0x84,
+	0x0a, 0xb2, 0x83, 0xb4, 0x29, 0x0a, 0x96, 0x33, 0xf3, 0x34, 0x62, 0x3c, 0x1c, 0x4e, 0x49, 0x8e,
+	0x2c, 0xa7, 0xe8, 0x07, 0xe8, 0xad, 0xc7, 0x7e, 0x86, 0x9e, 0xfb, 0x21, 0x72, 0x4c, 0x7b, 0x2a,
+	0x7a, 0x48, 0x8a, 0xe4, 0x8b, 0x14, 0x24, 0x67, 0x6c, 0xc9, 0x4e, 0x80, 0x1e, 0x72, 0x92, 0x86,
+	0xef, 0xf7, 0x7e, 0x24, 0xdf, 0xef, 0xfd, 0x21, 0xda, 0x3e, 0xcd, 0x23, 0xa9, 0x58, 0xab, 0xf8,
+	0x19, 0xed, 0xb6, 0x32, 0xa6, 0x98, 0xd0, 0xcd, 0x4c, 0x49, 0x23, 0xf1, 0x92, 0x5f, 0x6f, 0x16,
+	0x3f, 0xa3, 0xdd, 0xcd, 0x6a, 0x28, 0xb5, 0x90, 0xba, 0x15, 0x30, 0x0d, 0xad, 0xd1, 0x6e, 0x00,
+	0x86, 0xed, 0xb6, 0x42, 0xc9, 0x53, 0xef, 0xb1, 0xb9, 0xe1, 0xed, 0xd4, 0x7d, 0xb5, 0xfc, 0x47,
+	0x61, 0x5a, 0x8d, 0x65, 0x2c, 0xfd, 0xba, 0xfd, 0x57, 0xac, 0x56, 0x63, 0x29, 0xe3, 0x04, 0x5a,
+	0xee, 0x2b, 0xc8, 0x07, 0xad, 0x28, 0x57, 0xcc, 0x70, 0x59, 0x10, 0xee, 0xfc, 0x73, 0x05, 0xcd,
+	0xf5, 0xdc, 0x99, 0x70, 0x0b, 0xad, 0x06, 0xb9, 0x4a, 0x29, 0x8c, 0x04, 0x8d, 0x99, 0xa6, 0x0a,
+	0x06, 0x79, 0x1a, 0x69, 0x52, 0xa9, 0x57, 0x1a, 0xf3, 0xfd, 0x65, 0x6b, 0x3b, 0x18, 0x89, 0x27,
+	0x4c, 0xf7, 0xbd, 0x01, 0x3f, 0x42, 0x9b, 0x2c, 0x37, 0x92, 0x86, 0x52, 0x64, 0x32, 0x4f, 0x23,
+	0x0a, 0x99, 0x0c, 0x87, 0x34, 0x48, 0x64, 0x78, 0xaa, 0xc9, 0x17, 0xf5, 0x4a, 0xe3, 0x46, 0x7f,
+	0xdd, 0x22, 0x3a, 0x05, 0xe0, 0xc0, 0xda, 0xdb, 0xce, 0x8c, 0x8f, 0xd1, 0x4f, 0xa6, 0x9d, 0x05,
+	0x1b, 0xd3, 0x08, 0x12, 0x88, 0xdd, 0xf1, 0x34, 0xcd, 0x40, 0x79, 0x2a, 0x32, 0xeb, 0x98, 0x76,
+	0x26, 0x99, 0xba, 0x6c, 0xbc, 0x7f, 0x89, 0xed, 0x81, 0x72, 0xac, 0x78, 0x80, 0xd6, 0x79, 0x10,
+	0xd2, 0x8c, 0x85, 0xa7, 0x60, 0x68, 0x28, 0xf3, 0xd4, 0xd0, 0x84, 0x0b, 0x6e, 0x34, 0xb9, 0x51,
+	0x9f, 0x6d, 0x2c, 0xee, 0x3d, 0x6c, 0x5e, 0x0d, 0x79, 0xb3, 0x33, 0x64, 0x69, 0x0a, 0x49, 0xcf,
+	0xf9, 0x74, 0xac, 0xcb, 0x53, 0xeb, 0xd1, 0xbe, 0xf1, 0xfa, 0x6d, 0x6d, 0xa6, 0xbf, 0xca, 0x83,
+	0xf0, 0xaa, 0x49, 0xe3, 0x17, 0x1f, 0xd9, 0xe7, 0x8c, 0xa7, 0x91, 0x3c, 0x23, 0x3f, 0xaa, 0x57,
+	0x1a, 0x8b, 0x7b, 0x1b, 0x4d, 0x1f, 0xf7, 0x66, 0x19, 0xf7, 0xe6, 0x7e, 0x11, 0xf7, 0xf6, 0xbc,
+	0xe5, 0xfd, 0xdb, 0xbb, 0x5a, 0xe5, 0x2a, 0xf7, 0x73, 0x47, 0x80, 0x7f, 0x8a, 0xb0, 0xe5, 0x8e,
+	0x20, 0x95, 0x82, 0x0a, 0x30, 0x2c, 0x62, 0x86, 0x91, 0x39, 0x27, 0xc2, 0x12, 0x0f, 0xc2, 0x7d,
+	0x6b, 0xe8, 0x16, 0xeb, 0xf8, 0x97, 0xe8, 0xde, 0x19, 0xd3, 0xc2, 0x45, 0x2f, 0x94, 0xa9, 0x51,
+	0x2c, 0x34, 0x54, 0x1b, 0xa9, 0x58, 0x0c, 0x14, 0x52, 0xa3, 0x38, 0x68, 0x72, 0xd3, 0x05, 0x70,
+	0xdb, 0x02, 0xbb, 0x6c, 0xdc, 0x29, 0x60, 0xc7, 0x1e, 0x75, 0xe0, 0x41, 0xf8, 0x37, 0xe8, 0xa1,
+	0x91, 0xa7, 0x90, 0x0e, 0x58, 0x68, 0xa4, 0x3a, 0xa7, 0x2c, 0x12, 0x3c, 0xa5, 0xe1, 0x90, 0xa5,
+	0x31, 0xd0, 0x50, 0xca, 0x24, 0x92, 0x67, 0x69, 0x29, 0xee, 0xbc, 0x63, 0xfc, 0xf1, 0xa4, 0xc3,
+	0x63, 0x8b, 0xef, 0x38, 0x78, 0xa7, 0x40, 0x17, 0x52, 0x3f, 0x42, 0x9b, 0xa1, 0x14, 0x22, 0x4f,
+	0xb9, 0x39, 0xa7, 0x99, 0x94, 0x09, 0x1d, 0x00, 0x58, 0x7d, 0x43, 0x48, 0x0d, 0x59, 0xa8, 0x57,
+	0x1a, 0xb7, 0xfb, 0xeb, 0x17, 0x88, 0x9e, 0x94, 0xc9, 0x21, 0x40, 0xcf, 0x9b, 0xf1, 0xcf, 0xd1,
+	0xba, 0x4e, 0x98, 0x1e, 0x52, 0x9f, 0x2b, 0x13, 0x2c, 0x04, 0xb9, 0x98, 0xac, 0x3a, 0xf3, 0x89,
+	0xec, 0x94, 0x46, 0x4b, 0x80, 0xbf, 0x47, 0xf3, 0x42, 0xc7, 0x76, 0x23, 0x4d, 0x16, 0x9d, 0xf4,
+	0xe4, 0xba, 0xf4, 0x5d, 0x1d, 0x1f, 0x02, 0x14, 0x4a, 0xdf, 0x14, 0xee, 0x4b, 0xe3, 0xdf, 0xa1,
+	0x15, 0x7b, 0x73, 0x0d, 0xc9, 0x60, 0x22, 0x21, 0xc9, 0xad, 0x7a, 0xa5, 0xb1, 0xd0, 0xfe, 0xda,
+	0x62, 0xff, 0xf3, 0xb6, 0xb6, 0xe6, 0x6b, 0x4f, 0x47, 0xa7, 0x4d, 0x2e, 0x5b, 0x82, 0x99, 0x61,
+	0xf3, 0x28, 0x35, 0xff, 0xfa, 0xc7, 0x37, 0xa8, 0x28, 0xca, 0xa3, 0xd4, 0xf4, 0x97, 0x05, 0x4f,
+	0x8f, 0x21, 0x19, 0x5c, 0xa6, 0x2a, 0xfe, 0x33, 0x5a, 0xb5, 0xe4, 0x99, 0x92, 0x99, 0xd4, 0x2c,
+	0xa1, 0x11, 0x64, 0x52, 0x73, 0x43, 0x6e, 0xbb, 0x33, 0x6e, 0x34, 0x0b, 0x6f, 0x5b, 0xff, 0xcd,
+	0xa2, 0xfe, 0x9b, 0x1d, 0xc9, 0xd3, 0xf6, 0xb7, 0x76, 0xe3, 0xbf, 0xbf, 0xab, 0x35, 0x62, 0x6e,
+	0x86, 0x79, 0xd0, 0x0c, 0xa5, 0x28, 0xea, 0xbf, 0xf8, 0xf9, 0x46, 0x47, 0xa7, 0x2d, 0x73, 0x9e,
+	0x81, 0x76, 0x0e, 0xba, 0x8f, 0x05, 0x4f, 0x7b, 0xc5, 0x3e, 0xfb, 0x7e, 0x1b, 0xbc, 0x87, 0xd6,
+	0x9c, 0x82, 0x10, 0x5d, 0x1e, 0x41, 0xe8, 0x58, 0x93, 0x3b, 0xf5, 0xd9, 0xc6, 0x42, 0x7f, 0xa5,
+	0x30, 0x96, 0x6e, 0x5d, 0x1d, 0x6b, 0xfc, 0x03, 0xfa, 0xca, 0xa5, 0x58, 0x99, 0x55, 0x67, 0x8a,
+	0x1b, 0x9b, 0x11, 0xda, 0xd0, 0x41, 0xc2, 0x0c, 0xf9, 0xd2, 0xe5, 0x02, 0xb1, 0x98, 0x22, 0xa5,
+	0x9e, 0x5b, 0x44, 0x47, 0x6a, 0x73, 0x98, 0x30, 0x83, 0x0f, 0x50, 0xfd, 0x53, 0xfe, 0xae, 0xc6,
+	0xcf, 0x0d, 0x90, 0x25, 0xc7, 0xb1, 0xf5, 0x31, 0x0e, 0x5b, 0xdc, 0xe7, 0x06, 0xf0, 0x31, 0xc2,
+	0xb6, 0x33, 0x65, 0x0a, 0x6c, 0xcb, 0xe0, 0x09, 0xd8, 0x26, 0x45, 0x96, 0x5d, 0xdc, 0x6a, 0xd7,
+	0xb5, 0xed, 0x5d, 0xe0, 0x9e, 0x30, 0x5d, 0x48, 0xbc, 0x04, 0x23, 0x31, 0xb5, 0x8e, 0x1f, 0xa2,
+	0x65, 0x18, 0x95, 0xd5, 0x13, 0x01, 0xd5, 0xfc, 0x15, 0x10, 0xec, 0x0e, 0x73, 0x07, 0x46, 0xbe,
+	0x5a, 0x22, 0x38, 0xe6, 0xaf, 0x00, 0x3f, 0x45, 0xf7, 0xa7, 0xea, 0xc3, 0xf7, 0xab, 0x54, 0x0a,
+	0xdf, 0xaa, 0x42, 0x05, 0xcc, 0x48, 0x45, 0x56, 0x9c, 0x73, 0x6d, 0x12, 0xea, 0x9a, 0x95, 0x05,
+	0xf6, 0x40, 0x75, 0x3c, 0x0c, 0xff, 0x80, 0xb6, 0xa6, 0xd8, 0xf2, 0x94, 0xff, 0x31, 0x07, 0xaa,
+	0xcf, 0x45, 0x20, 0x13, 0x4d, 0x56, 0x5d, 0x6a, 0x6f, 0x4c, 0x42, 0x9e, 0x39, 0xc4, 0xb1, 0x07,
+	0xe0, 0x5f, 0xa3, 0x07, 0x57, 0xfc, 0x15, 0xc4, 0x5c, 0x1b, 0x1b, 0xd0, 0x5c, 0xa5, 0x56, 0x5f,
+	0xc6, 0x95, 0x26, 0x6b, 0x8e, 0xe8, 0xde, 0x34, 0x51, 0x09, 0x6d, 0x3b, 0x64, 0xcf, 0x02, 0x71,
+	0x1b, 0x55, 0x6d, 0x62, 0xda, 0xc6, 0x9f, 0x29, 0x1e, 0x02, 0x0d, 0xa4, 0x34, 0xda, 0x28, 0x96,
+	0x95, 0x35, 0x7f, 0xd7, 0xdd, 0x6c, 0x53, 0xf0, 0xf4, 0x09, 0xd3, 0x3d, 0x8b, 0x69, 0x97, 0x90,
+	0xa2, 0xd0, 0x27, 0x9b, 0x11, 0x4f, 0xb5, 0x61, 0xa9, 0xe1, 0xd7, 0xba, 0xf9, 0xfa, 0x54, 0x33,
+	0x3a, 0x9a, 0x82, 0x5d, 0x34, 0xf2, 0xa7, 0xe8, 0xbe, 0xd5, 0xc5, 0x79, 0x5c, 0xf6, 0xb5, 0x09,
+	0xed, 0x43, 0x96, 0x24, 0x9a, 0x10, 0x77, 0xbb, 0x1a, 0x8c, 0x84, 0x73, 0x2b, 0x3b, 0xdb, 0xa5,
+	0xc6, 0x1d, 0x0b, 0xc3, 0xdf, 0xa3, 0x0d, 0xdb, 0x52, 0x41, 0x85, 0x7b, 0xdf, 0x16, 0x8d, 0x95,
+	0x25, 0x89, 0x3c, 0x4b, 0xb8, 0x36, 0x64, 0xc3, 0x65, 0xfe, 0x5d, 0x1e, 0x84, 0x07, 0xd6, 0xee,
+	0x94, 0x7a, 0x5c, 0x5a, 0x6d, 0xef, 0xb2, 0xae, 0x11, 0xd7, 0x2c, 0x48, 0xa0, 0xec, 0xf8, 0x03,
+	0xa9, 0xce, 0x98, 0x8a, 0xc8, 0xa6, 0xdb, 0xdf, 0xce, 0x82, 0x7d, 0x0f, 0xf0, 0xed, 0xfc, 0xd0,
+	0x9b, 0xf1, 0x2f, 0xd0, 0x96, 0x75, 0x56, 0xcc, 0x80, 0x9b, 0x42, 0x14, 0xc6, 0x20, 0x32, 0x53,
+	0xa4, 0x0d, 0xd9, 0x72, 0x3b, 0x13, 0x1e, 0x84, 0xfd, 0x12, 0x71, 0xe0, 0x00, 0x3e, 0x5b, 0xf0,
+	0xd7, 0x7e, 0x12, 0xd8, 0x68, 0x0a, 0x10, 0xd2, 0x55, 0x8a, 0x26, 0x5f, 0xb9, 0xf8, 0x7d, 0xc9,
+	0x83, 0xb0, 0xcb, 0xc6, 0x5d, 0x10, 0xd2, 0x56, 0x87, 0xc6, 0x3f, 0x43, 0xf6, 0x0a, 0xb4, 0xac,
+	0x6e, 0x05, 0x21, 0xcf, 0x38, 0xa4, 0x46, 0x93, 0x6d, 0xb7, 0x8d, 0x1d, 0x36, 0x6d, 0x6f, 0xec,
+	0x5f, 0xd8, 0xf0, 0x9f, 0xd0, 0x8a, 0xbb, 0x5e, 0xae, 0x0d, 0x35, 0x43, 0x05, 0x7a, 0x28, 0x93,
+	0x48, 0x93, 0xea, 0xe7, 0xef, 0x46, 0xcb, 0x36, 0x48, 0xb9, 0x36, 0x27, 0x17, 0xbb, 0xe0, 0x26,
+	0x5a, 0x71, 0xe9, 0x72, 0x65, 0xd4, 0xd5, 0xfc, 0x7b, 0xc3, 0x9a, 0xa6, 0x67, 0x5d, 0xa1, 0xc5,
+	0xb4, 0x06, 0x54, 0x81, 0x1f, 0x72, 0x75, 0x3f, 0x47, 0x2e, 0x66, 0x6a, 0x21, 0x42, 0xdf, 0x9b,
+	0xf1, 0x1f, 0x3e, 0xea, 0x6c, 0xb8, 0x00, 0x99, 0x1b, 0x72, 0xef, 0xff, 0x9f, 0xda, 0xd7, 0x76,
+	0x38, 0xf1, 0x1c, 0xf8, 0x3b, 0x74, 0xd7, 0xe6, 0xec, 0x4b, 0xc6, 0x13, 0x2a, 0xb8, 0xd6, 0x10,
+	0x95, 0x95, 0xb3, 0xe3, 0x24, 0x5b, 0x81, 0x91, 0xf8, 0x15, 0xe3, 0x49, 0xd7, 0xd9, 0x8a, 0x92,
+	0x79, 0x80, 0xee, 0x58, 0x7d, 0xcd, 0x98, 0x6a, 0x1e, 0xa7, 0xa0, 0x34, 0xb9, 0xef, 0xc0, 0xb7,
+	0x04, 0x1b, 0x9f, 0x8c, 0x8f, 0xfd, 0x9a, 0x7d, 0x13, 0x28, 0x78, 0x09, 0xa1, 0xcd, 0xa0, 0x8c,
+	0x2b, 0x88, 0xa8, 0x19, 0x6b, 0xf2, 0xc0, 0xbf, 0x09, 0xbc, 0xe5, 0xc0, 0x1b, 0x4e, 0xc6, 0x7a,
+	0xe7, 0x2f, 0x15, 0x34, 0xe7, 0x47, 0x1b, 0xae, 0xa3, 0x5b, 0x76, 0x0c, 0x5a, 0x21, 0x68, 0xae,
+	0x12, 0xf7, 0x96, 0x5b, 0xe8, 0x23, 0xa1, 0xe3, 0x93, 0xf3, 0x0c, 0x9e, 0xa9, 0x04, 0xff, 0x1e,
+	0xcd, 0x0e, 0x00, 0xc8, 0x17, 0x9f, 0x5f, 0x71, 0xcb, 0xbb, 0xf3, 0x08, 0xdd, 0x9e, 0xee, 0xb8,
+	0x04, 0xdd, 0x64, 0x51, 0xa4, 0x40, 0xeb, 0xe2, 0x30, 0xe5, 0x27, 0x5e, 0x42, 0xb3, 0x31, 0x2b,
+	0xdf, 0x8d, 0xf6, 0xef, 0xce, 0x6f, 0xd1, 0xfa, 0x27, 0x5e, 0x67, 0x78, 0x1b, 0xa1, 0xd0, 0x9b,
+	0x28, 0x8f, 0x0a, 0xa6, 0x85, 0x62, 0xe5, 0x28, 0xc2, 0x35, 0xb4, 0x68, 0xc3, 0xea, 0xd5, 0x2e,
+	0x39, 0x91, 0x60, 0x63, 0x4f, 0xa4, 0xdb, 0xad, 0xd7, 0xef, 0xab, 0x95, 0x37, 0xef, 0xab, 0x95,
+	0xff, 0xbe, 0xaf, 0x56, 0xfe, 0xfa, 0xa1, 0x3a, 0xf3, 0xe6, 0x43, 0x75, 0xe6, 0xdf, 0x1f, 0xaa,
+	0x33, 0x2f, 0xd6, 0x8a, 0xc7, 0xfa, 0xb8, 0x7c, 0xb5, 0xbb, 0x3b, 0x05, 0x73, 0x2e, 0x27, 0xbe,
+	0xfb, 0xdf, 0x00, 0xeb, 0x48, 0xcc, 0x32, 0xd3, 0x0b, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RejectExpiredTxs {
+		i--
+		if m.RejectExpiredTxs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.MaxTxSigners != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTxSigners))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.EvmJailMissedBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EvmJailMissedBlocks))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x90
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.IbcPacketForwardTimeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.IbcPacketForwardTimeout):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0x8a
+	if m.IbcPacketForwardRetries != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.IbcPacketForwardRetries))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.WasmDenomMetadata {
+		i--
+		if m.WasmDenomMetadata {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
+	}
+	if len(m.IbcDustThresholds) > 0 {
+		for iNdEx := len(m.IbcDustThresholds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IbcDustThresholds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if len(m.IbcBlockedRecipients) > 0 {
+		for iNdEx := len(m.IbcBlockedRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IbcBlockedRecipients[iNdEx])
+			copy(dAtA[i:], m.IbcBlockedRecipients[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.IbcBlockedRecipients[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if m.IbcMaxMemoBytes != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.IbcMaxMemoBytes))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	if len(m.IbcRatelimitExemptDenoms) > 0 {
+		for iNdEx := len(m.IbcRatelimitExemptDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IbcRatelimitExemptDenoms[iNdEx])
+			copy(dAtA[i:], m.IbcRatelimitExemptDenoms[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.IbcRatelimitExemptDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if m.IbcDisablePacketForward {
+		i--
+		if m.IbcDisablePacketForward {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if len(m.IbcErc20DenomAllowlist) > 0 {
+		for iNdEx := len(m.IbcErc20DenomAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IbcErc20DenomAllowlist[iNdEx])
+			copy(dAtA[i:], m.IbcErc20DenomAllowlist[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.IbcErc20DenomAllowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if m.EvmBlockContractPrecompileCalls {
+		i--
+		if m.EvmBlockContractPrecompileCalls {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.WasmMaxInstantiationsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.WasmMaxInstantiationsPerBlock))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.MinGasPriceBootstrapBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinGasPriceBootstrapBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.TokenfactoryUnregisterBurnedPairs {
+		i--
+		if m.TokenfactoryUnregisterBurnedPairs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.TokenfactoryUniqueSymbols {
+		i--
+		if m.TokenfactoryUniqueSymbols {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.TokenfactoryMaxDenomsPerCreator != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TokenfactoryMaxDenomsPerCreator))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.EvmMaxCodeSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EvmMaxCodeSize))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.EvmPrecompileGas) > 0 {
+		for iNdEx := len(m.EvmPrecompileGas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EvmPrecompileGas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.WasmStorageWriteCostPerByte != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.WasmStorageWriteCostPerByte))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.WasmStorageWriteCostFlat != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.WasmStorageWriteCostFlat))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.BlockedProposalMsgs) > 0 {
+		for iNdEx := len(m.BlockedProposalMsgs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlockedProposalMsgs[iNdEx])
+			copy(dAtA[i:], m.BlockedProposalMsgs[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.BlockedProposalMsgs[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.MinProposalDeposit) > 0 {
+		for iNdEx := len(m.MinProposalDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinProposalDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	{
+		size := m.MinSelfDelegation.Size()
+		i -= size
+		if _, err := m.MinSelfDelegation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if len(m.MsgFees) > 0 {
+		for iNdEx := len(m.MsgFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.SlashToCommunityPool {
+		i--
+		if m.SlashToCommunityPool {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.CommunityPoolFeePercent != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CommunityPoolFeePercent))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.TokenfactoryAdminChangeCooldownBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TokenfactoryAdminChangeCooldownBlocks))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.WasmMaxContractStorageEntries != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.WasmMaxContractStorageEntries))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.IbcDenomMetadata {
+		i--
+		if m.IbcDenomMetadata {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.IbcPacketCountWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.IbcPacketCountWindow):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.IbcPacketCountLimits) > 0 {
+		for iNdEx := len(m.IbcPacketCountLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IbcPacketCountLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.AutoCompoundMaxDelegationsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AutoCompoundMaxDelegationsPerBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AutoCompoundEpochBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AutoCompoundEpochBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BurnEvmGasRefunds {
+		i--
+		if m.BurnEvmGasRefunds {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PrecompileGas) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrecompileGas) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrecompileGas) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelPacketCountLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelPacketCountLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelPacketCountLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxPackets != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPackets))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BurnEvmGasRefunds {
+		n += 2
+	}
+	if m.AutoCompoundEpochBlocks != 0 {
+		n += 1 + sovParams(uint64(m.AutoCompoundEpochBlocks))
+	}
+	if m.AutoCompoundMaxDelegationsPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.AutoCompoundMaxDelegationsPerBlock))
+	}
+	if len(m.IbcPacketCountLimits) > 0 {
+		for _, e := range m.IbcPacketCountLimits {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.IbcPacketCountWindow)
+	n += 1 + l + sovParams(uint64(l))
+	if m.IbcDenomMetadata {
+		n += 2
+	}
+	if m.WasmMaxContractStorageEntries != 0 {
+		n += 1 + sovParams(uint64(m.WasmMaxContractStorageEntries))
+	}
+	if m.TokenfactoryAdminChangeCooldownBlocks != 0 {
+		n += 1 + sovParams(uint64(m.TokenfactoryAdminChangeCooldownBlocks))
+	}
+	if m.CommunityPoolFeePercent != 0 {
+		n += 1 + sovParams(uint64(m.CommunityPoolFeePercent))
+	}
+	if m.SlashToCommunityPool {
+		n += 2
+	}
+	if len(m.MsgFees) > 0 {
+		for _, e := range m.MsgFees {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.MinSelfDelegation.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.MinProposalDeposit) > 0 {
+		for _, e := range m.MinProposalDeposit {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.BlockedProposalMsgs) > 0 {
+		for _, s := range m.BlockedProposalMsgs {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.WasmStorageWriteCostFlat != 0 {
+		n += 1 + sovParams(uint64(m.WasmStorageWriteCostFlat))
+	}
+	if m.WasmStorageWriteCostPerByte != 0 {
+		n += 2 + sovParams(uint64(m.WasmStorageWriteCostPerByte))
+	}
+	if len(m.EvmPrecompileGas) > 0 {
+		for _, e := range m.EvmPrecompileGas {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	if m.EvmMaxCodeSize != 0 {
+		n += 2 + sovParams(uint64(m.EvmMaxCodeSize))
+	}
+	if m.TokenfactoryMaxDenomsPerCreator != 0 {
+		n += 2 + sovParams(uint64(m.TokenfactoryMaxDenomsPerCreator))
+	}
+	if m.TokenfactoryUniqueSymbols {
+		n += 3
+	}
+	if m.TokenfactoryUnregisterBurnedPairs {
+		n += 3
+	}
+	if m.MinGasPriceBootstrapBlocks != 0 {
+		n += 2 + sovParams(uint64(m.MinGasPriceBootstrapBlocks))
+	}
+	if m.WasmMaxInstantiationsPerBlock != 0 {
+		n += 2 + sovParams(uint64(m.WasmMaxInstantiationsPerBlock))
+	}
+	if m.EvmBlockContractPrecompileCalls {
+		n += 3
+	}
+	if len(m.IbcErc20DenomAllowlist) > 0 {
+		for _, s := range m.IbcErc20DenomAllowlist {
+			l = len(s)
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	if m.IbcDisablePacketForward {
+		n += 3
+	}
+	if len(m.IbcRatelimitExemptDenoms) > 0 {
+		for _, s := range m.IbcRatelimitExemptDenoms {
+			l = len(s)
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	if m.IbcMaxMemoBytes != 0 {
+		n += 2 + sovParams(uint64(m.IbcMaxMemoBytes))
+	}
+	if len(m.IbcBlockedRecipients) > 0 {
+		for _, s := range m.IbcBlockedRecipients {
+			l = len(s)
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.IbcDustThresholds) > 0 {
+		for _, e := range m.IbcDustThresholds {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	if m.WasmDenomMetadata {
+		n += 3
+	}
+	if m.IbcPacketForwardRetries != 0 {
+		n += 2 + sovParams(uint64(m.IbcPacketForwardRetries))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.IbcPacketForwardTimeout)
+	n += 2 + l + sovParams(uint64(l))
+	if m.EvmJailMissedBlocks != 0 {
+		n += 2 + sovParams(uint64(m.EvmJailMissedBlocks))
+	}
+	if m.MaxTxSigners != 0 {
+		n += 2 + sovParams(uint64(m.MaxTxSigners))
+	}
+	if m.RejectExpiredTxs {
+		n += 3
+	}
+	return n
+}
+
+func (m *MsgFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PrecompileGas) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.Gas != 0 {
+		n += 1 + sovParams(uint64(m.Gas))
+	}
+	return n
+}
+
+func (m *ChannelPacketCountLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MaxPackets != 0 {
+		n += 1 + sovParams(uint64(m.MaxPackets))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnEvmGasRefunds", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnEvmGasRefunds = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundEpochBlocks", wireType)
+			}
+			m.AutoCompoundEpochBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundEpochBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundMaxDelegationsPerBlock", wireType)
+			}
+			m.AutoCompoundMaxDelegationsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundMaxDelegationsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcPacketCountLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcPacketCountLimits = append(m.IbcPacketCountLimits, ChannelPacketCountLimit{})
+			if err := m.IbcPacketCountLimits[len(m.IbcPacketCountLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcPacketCountWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.IbcPacketCountWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcDenomMetadata", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IbcDenomMetadata = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WasmMaxContractStorageEntries", wireType)
+			}
+			m.WasmMaxContractStorageEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WasmMaxContractStorageEntries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenfactoryAdminChangeCooldownBlocks", wireType)
+			}
+			m.TokenfactoryAdminChangeCooldownBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TokenfactoryAdminChangeCooldownBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolFeePercent", wireType)
+			}
+			m.CommunityPoolFeePercent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommunityPoolFeePercent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashToCommunityPool", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SlashToCommunityPool = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgFees = append(m.MsgFees, MsgFee{})
+			if err := m.MsgFees[len(m.MsgFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinProposalDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinProposalDeposit = append(m.MinProposalDeposit, types.Coin{})
+			if err := m.MinProposalDeposit[len(m.MinProposalDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedProposalMsgs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedProposalMsgs = append(m.BlockedProposalMsgs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WasmStorageWriteCostFlat", wireType)
+			}
+			m.WasmStorageWriteCostFlat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WasmStorageWriteCostFlat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WasmStorageWriteCostPerByte", wireType)
+			}
+			m.WasmStorageWriteCostPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WasmStorageWriteCostPerByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmPrecompileGas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmPrecompileGas = append(m.EvmPrecompileGas, PrecompileGas{})
+			if err := m.EvmPrecompileGas[len(m.EvmPrecompileGas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmMaxCodeSize", wireType)
+			}
+			m.EvmMaxCodeSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EvmMaxCodeSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenfactoryMaxDenomsPerCreator", wireType)
+			}
+			m.TokenfactoryMaxDenomsPerCreator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TokenfactoryMaxDenomsPerCreator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenfactoryUniqueSymbols", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TokenfactoryUniqueSymbols = bool(v != 0)
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenfactoryUnregisterBurnedPairs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TokenfactoryUnregisterBurnedPairs = bool(v != 0)
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPriceBootstrapBlocks", wireType)
+			}
+			m.MinGasPriceBootstrapBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinGasPriceBootstrapBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WasmMaxInstantiationsPerBlock", wireType)
+			}
+			m.WasmMaxInstantiationsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WasmMaxInstantiationsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmBlockContractPrecompileCalls", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EvmBlockContractPrecompileCalls = bool(v != 0)
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcErc20DenomAllowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcErc20DenomAllowlist = append(m.IbcErc20DenomAllowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcDisablePacketForward", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IbcDisablePacketForward = bool(v != 0)
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcRatelimitExemptDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcRatelimitExemptDenoms = append(m.IbcRatelimitExemptDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcMaxMemoBytes", wireType)
+			}
+			m.IbcMaxMemoBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IbcMaxMemoBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcBlockedRecipients", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcBlockedRecipients = append(m.IbcBlockedRecipients, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcDustThresholds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcDustThresholds = append(m.IbcDustThresholds, types.Coin{})
+			if err := m.IbcDustThresholds[len(m.IbcDustThresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WasmDenomMetadata", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WasmDenomMetadata = bool(v != 0)
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcPacketForwardRetries", wireType)
+			}
+			m.IbcPacketForwardRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IbcPacketForwardRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcPacketForwardTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.IbcPacketForwardTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 34:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmJailMissedBlocks", wireType)
+			}
+			m.EvmJailMissedBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EvmJailMissedBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 35:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxSigners", wireType)
+			}
+			m.MaxTxSigners = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxSigners |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 36:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectExpiredTxs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RejectExpiredTxs = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrecompileGas) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrecompileGas: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrecompileGas: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelPacketCountLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelPacketCountLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelPacketCountLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPackets", wireType)
+			}
+			m.MaxPackets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPackets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
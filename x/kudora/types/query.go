@@ -0,0 +1,18 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+var _ codectypes.UnpackInterfacesMessage = (*QueryFeeGrantsForGranteeResponse)(nil)
+
+// UnpackInterfaces implements codectypes.UnpackInterfacesMessage, unpacking
+// the allowances of the grants.
+func (r *QueryFeeGrantsForGranteeResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, grant := range r.Grants {
+		if err := grant.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
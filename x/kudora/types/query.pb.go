@@ -0,0 +1,10423 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kudora/kudora/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
+	feegrant "cosmossdk.io/x/feegrant"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/x/bank/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryParamsRequest is the request type of the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05f851922b02ee8e, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type of the Query/Params RPC method.
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05f851922b02ee8e, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// QueryEVMAddressRequest is the request type of the Query/EVMAddress RPC
+// method.
+type QueryEVMAddressRequest struct {
+	// address is the bech32 account address to convert.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryEVMAddressRequest) Reset()         { *m = QueryEVMAddressRequest{} }
+func (m *QueryEVMAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEVMAddressRequest) ProtoMessage()    {}
+func (*QueryEVMAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05f851922b02ee8e, []int{2}
+}
+func (m *QueryEVMAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEVMAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEVMAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEVMAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEVMAddressRequest.Merge(m, src)
+}
+func (m *QueryEVMAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEVMAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEVMAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEVMAddressRequest proto.InternalMessageInfo
+
+func (m *QueryEVMAddressRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryEVMAddressResponse is the response type of the Query/EVMAddress RPC
+// method.
+type QueryEVMAddressResponse struct {
+	// evm_address is the checksummed 0x EVM address.
+	EvmAddress string `protobuf:"bytes,1,opt,name=evm_address,json=evmAddress,proto3" json:"evm_address,omitempty"`
+}
+
+func (m *QueryEVMAddressResponse) Reset()         { *m = QueryEVMAddressResponse{} }
+func (m *QueryEVMAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEVMAddressResponse) ProtoMessage()    {}
+func (*QueryEVMAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05f851922b02ee8e, []int{3}
+}
+func (m *QueryEVMAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEVMAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEVMAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEVMAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEVMAddressResponse.Merge(m, src)
+}
+func (m *QueryEVMAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEVMAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEVMAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEVMAddressResponse proto.InternalMessageInfo
+
+func (m *QueryEVMAddressResponse) GetEvmAddress() string {
+	if m != nil {
+		return m.EvmAddress
+	}
+	return ""
+}
+
+// QueryBech32AddressRequest is the request type of the Query/Bech32Address
+// RPC method.
+type QueryBech32AddressRequest struct {
+	// evm_address is the 0x EVM address to convert.
+	EvmAddress string `protobuf:"bytes,1,opt,name=evm_address,json=evmAddress,proto3" json:"evm_address,omitempty"`
+}
+
+func (m *QueryBech32AddressRequest) Reset()         { *m = QueryBech32AddressRequest{} }
+func (m *QueryBech32AddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBech32AddressRequest) ProtoMessage()    {}
+func (*QueryBech32AddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05f851922b02ee8e, []int{4}
+}
+func (m *QueryBech32AddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBech32AddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBech32AddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBech32AddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBech32AddressRequest.Merge(m, src)
+}
+func (m *QueryBech32AddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBech32AddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBech32AddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBech32AddressRequest proto.InternalMessageInfo
+
+func (m *QueryBech32AddressRequest) GetEvmAddress() string {
+	if m != nil {
+		return m.EvmAddress
+	}
+	return ""
+}
+
+// QueryBech32AddressResponse is the response type of the Query/Bech32Address
+// RPC method.
+type QueryBech32AddressResponse struct {
+	// address is the bech32 account address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryBech32AddressResponse) Reset()         { *m = QueryBech32AddressResponse{} }
+func (m *QueryBech32AddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBech32AddressResponse) ProtoMessage()    {}
+func (*QueryBech32AddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05f851922b02ee8e, []int{5}
+}
+func (m *QueryBech32AddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBech32AddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBech32AddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBech32AddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBech32AddressResponse.Merge(m, src)
+}
+func (m *QueryBech32AddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBech32AddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBech32AddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBech32AddressResponse proto.InternalMessageInfo
+
+func (m *QueryBech32AddressResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryIBCEscrowBalancesRequest is the request type of the
+// Query/IBCEscrowBalances RPC method.
+type QueryIBCEscrowBalancesRequest struct {
+}
+
+func (m *QueryIBCEscrowBalancesRequest) Reset()         { *m = QueryIBCEscrowBalancesRequest{} }
+func (m *QueryIBCEscrowBalancesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIBCEscrowBalancesRequest) ProtoMessage()    {}
+func (*QueryIBCEscrowBalancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05f851922b02ee8e, []int{6}
+}
+func (m *QueryIBCEscrowBalancesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIBCEscrowBalancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIBCEscrowBalancesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIBCEscrowBalancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIBCEscrowBalancesRequest.Merge(m, src)
+}
+func (m *QueryIBCEscrowBalancesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIBCEscrowBalancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIBCEscrowBalancesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIBCEscrowBalancesRequest proto.InternalMessageInfo
+
+// QueryIBCEscrowBalancesResponse is the response type of the
+// Query/IBCEscrowBalances RPC method.
+type QueryIBCEscrowBalancesResponse struct {
+	Balances github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances"`
+}
+
+func (m *QueryIBCEscrowBalancesResponse) Reset()         { *m = QueryIBCEscrowBalancesResponse{} }
+func (m *QueryIBCEscrowBalancesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIBCEscrowBalancesResponse) ProtoMessage()    {}
+func (*QueryIBCEscrowBalancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05f851922b02ee8e, []int{7}
+}
+func (m *QueryIBCEscrowBalancesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIBCEscrowBalancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIBCEscrowBalancesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIBCEscrowBalancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIBCEscrowBalancesResponse.Merge(m, src)
+}
+func (m *QueryIBCEscrowBalancesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIBCEscrowBalancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIBCEscrowBalancesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIBCEscrowBalancesResponse proto.InternalMessageInfo
+
+func (m *QueryIBCEscrowBalancesResponse) GetBalances() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
+// QueryIBCTransferFlowRequest is the request type of the
+// Query/IBCTransferFlow RPC method.
+type QueryIBCTransferFlowRequest struct {
+	// denom is the denom, an ibc/ one for tokens from other chains.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryIBCTransferFlowRequest) Reset()         { *m = QueryIBCTransferFlowRequest{} }
+func (m *QueryIBCTransferFlowRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIBCTransferFlowRequest) ProtoMessage()    {}
+func (*QueryIBCTransferFlowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05f851922b02ee8e, []int{8}
+}
+func (m *QueryIBCTransferFlowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIBCTransferFlowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIBCTransferFlowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIBCTransferFlowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIBCTransferFlowRequest.Merge(m, src)
+}
+func (m *QueryIBCTransferFlowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIBCTransferFlowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIBCTransferFlowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIBCTransferFlowRequest proto.InternalMessageInfo
+
+func (m *QueryIBCTransferFlowRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryIBCTransferFlowResponse is the response type of the
+// Query/IBCTransferFlow RPC method.
+type QueryIBCTransferFlowResponse struct {
+	// inflow is the amount received by successful incoming transfers.
+	Inflow cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=inflow,proto3,customtype=cosmossdk.io/math.Int" json:"inflow"`
+	// outflow is the amount sent by acknowledged outgoing transfers.
+	Outflow cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=outflow,proto3,customtype=cosmossdk.io/math.Int" json:"outflow"`
+}
+
+func (m *QueryIBCTransferFlowResponse) Reset()         { *m = QueryIBCTransferFlowResponse{} }
+func (m *QueryIBCTransferFlowResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIBCTransferFlowResponse) ProtoMessage()    {}
+func (*QueryIBCTransferFlowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05f851922b02ee8e, []int{9}
+}
+func (m *QueryIBCTransferFlowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIBCTransferFlowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIBCTransferFlowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIBCTransferFlowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIBCTransferFlowResponse.Merge(m, src)
+}
+func (m *QueryIBCTransferFlowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIBCTransferFlowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIBCTransferFlowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIBCTransferFlowResponse proto.InternalMessageInfo
+
+// QueryIBCConnectionsByClientRequest is the request type of the
+// Query/IBCConnectionsByClient RPC method.
+type QueryIBCConnectionsByClientRequest struct {
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+}
+
+func (m *QueryIBCConnectionsByClientRequest) Reset()         { *m = QueryIBCConnectionsByClientRequest{} }
+func (m *QueryIBCConnectionsByClientRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIBCConnectionsByClientRequest) ProtoMessage()    {}
+func (*QueryIBCConnectionsByClientRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05f851922b02ee8e, []int{10}
+}
+func (m *QueryIBCConnectionsByClientRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIBCConnectionsByClientRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIBCConnectionsByClientRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIBCConnectionsByClientRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIBCConnectionsByClientRequest.Merge(m, src)
+}
+func (m *QueryIBCConnectionsByClientRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIBCConnectionsByClientRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIBCConnectionsByClientRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIBCConnectionsByClientRequest proto.InternalMessageInfo
+
+func (m *QueryIBCConnectionsByClientRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+// QueryIBCConnectionsByClientResponse is the response type of the
+// Query/IBCConnectionsByClient RPC method.
+type QueryIBCConnectionsByClientResponse struct {
+	Connections []IBCConnection `protobuf:"bytes,1,rep,name=connections,proto3" json:"connections"`
+}
+
+func (m *QueryIBCConnectionsByClientResponse) Reset()         { *m = QueryIBCConnectionsByClientResponse{} }
+func (m *QueryIBCConnectionsByClientResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIBCConnectionsByClientResponse) ProtoMessage()    {}
+func (*QueryIBCConnectionsByClientResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05f851922b02ee8e, []int{11}
+}
+func (m *QueryIBCConnectionsByClientResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIBCConnectionsByClientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIBCConnectionsByClientResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIBCConnectionsByClientResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIBCConnectionsByClientResponse.Merge(m, src)
+}
+func (m *QueryIBCConnectionsByClientResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIBCConnectionsByClientResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIBCConnectionsByClientResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIBCConnectionsByClientResponse proto.InternalMessageInfo
+
+func (m *QueryIBCConnectionsByClientResponse) GetConnections() []IBCConnection {
+	if m != nil {
+		return m.Connections
+	}
+	return nil
+}
+
+// IBCConnection summarizes an IBC connection end of this chain.
+type IBCConnection struct {
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	ClientId     string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// state is the name of the ibc.core.connection.v1.State, such as
+	// STATE_OPEN.
+	State                    string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	CounterpartyConnectionId string `protobuf:"bytes,4,opt,name=counterparty_connection_id,json=counterpartyConnectionId,proto3" json:"counterparty_connection_id,omitempty"`
+	CounterpartyClientId     string `protobuf:"bytes,5,opt,name=counterparty_client_id,json=counterpartyClientId,proto3" json:"counterparty_client_id,omitempty"`
+}
+
+func (m *IBCConnection) Reset()         { *m = IBCConnection{} }
+func (m *IBCConnection) String() string { return proto.CompactTextString(m) }
+func (*IBCConnection) ProtoMessage()    {}
+func (*IBCConnection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05f851922b02ee8e, []int{12}
+}
+func (m *IBCConnection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IBCConnection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IBCConnection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IBCConnection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IBCConnection.Merge(m, src)
+}
+func (m *IBCConnection) XXX_Size() int {
+	return m.Size()
+}
+func (m *IBCConnection) XXX_DiscardUnknown() {
+	xxx_messageInfo_IBCConnection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IBCConnection proto.InternalMessageInfo
+
+func (m *IBCConnection) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *IBCConnection) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *IBCConnection) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *IBCConnection) GetCounterpartyConnectionId() string {
+	if m != nil {
+		return m.CounterpartyConnectionId
+	}
+	return ""
+}
+
+func (m *IBCConnection) GetCounterpartyClientId() string {
+	if m != nil {
+		return m.CounterpartyClientId
+	}
+	return ""
+}
+
+// QueryIBCChannelCountsByStateRequest is the request type of the
+// Query/IBCChannelCountsByState RPC method.
+type QueryIBCChannelCountsByStateRequest struct {
+}
+
+func (m *QueryIBCChannelCountsByStateRequest) Reset()         { *m = QueryIBCChannelCountsByStateRequest{} }
+func (m *QueryIBCChannelCountsByStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIBCChannelCountsByStateRequest) ProtoMessage()    {}
+func (*QueryIBCChannelCountsByStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05f851922b02ee8e, []int{13}
+}
+func (m *QueryIBCChannelCountsByStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIBCChannelCountsByStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIBCChannelCountsByStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIBCChannelCountsByStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIBCChannelCountsByStateRequest.Merge(m, src)
+}
+func (m *QueryIBCChannelCountsByStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIBCChannelCountsByStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIBCChannelCountsByStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIBCChannelCountsByStateRequest proto.InternalMessageInfo
+
+// QueryIBCChannelCountsByStateResponse is the response type of the
+// Query/IBCChannelCountsByState RPC method.
+type QueryIBCChannelCountsByStateResponse struct {
+	// counts are ordered by state, and include the states no channel is in.
+	Counts []ChannelStateCount `protobuf:"bytes,1,rep,name=counts,proto3" json:"counts"`
+}
+
+func (m *QueryIBCChannelCountsByStateResponse) Reset()         { *m = QueryIBCChannelCountsByStateResponse{} }
+func (m *QueryIBCChannelCountsByStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIBCChannelCountsByStateResponse) ProtoMessage()    {}
+func (*QueryIBCChannelCountsByStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05f851922b02ee8e, []int{14}
+}
+func (m *QueryIBCChannelCountsByStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIBCChannelCountsByStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIBCChannelCountsByStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIBCChannelCountsByStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIBCChannelCountsByStateResponse.Merge(m, src)
+}
+func (m *QueryIBCChannelCountsByStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIBCChannelCountsByStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIBCChannelCountsByStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIBCChannelCountsByStateResponse proto.InternalMessageInfo
+
+func (m *QueryIBCChannelCountsByStateResponse) GetCounts() []ChannelStateCount {
+	if m != nil {
+		return m.Counts
+	}
+	return nil
+}
+
+// ChannelStateCount is the number of IBC channels in a state.
+type ChannelStateCount struct {
+	// state is the name of the ibc.core.channel.v1.State, such as STATE_OPEN.
+	State string `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *ChannelStateCount) Reset()         { *m = ChannelStateCount{} }
+func (m *ChannelStateCount) String() string { return proto.CompactTextString(m) }
+func (*ChannelStateCount) ProtoMessage()    {}
+func (*ChannelStateCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05f851922b02ee8e, []int{15}
+}
+func (m *ChannelStateCount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelStateCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelStateCount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelStateCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelStateCount.Merge(m, src)
+}
+func (m *ChannelStateCount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelStateCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelStateCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelStateCount proto.InternalMessageInfo
+
+func (m *ChannelStateCount) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *ChannelStateCount) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// QueryIBCOutstandingAcksByChannelRequest is the request type of the
+// Query/IBCOutstandingAcksByChannel RPC method.
+type QueryIBCOutstandingAcksByChannelRequest struct {
+}
+
+func (m *QueryIBCOutstandingAcksByChannelRequest) Reset() {
+	*m = QueryIBCOutstandingAcksByChannelRequest{}
+}
+func (m *QueryIBCOutstandingAcksByChannelRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIBCOutstandingAcksByChannelRequest) ProtoMessage()    {}
+func (*QueryIBCOutstandingAcksByChannelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05f851922b02ee8e, []int{16}
+}
+func (m *QueryIBCOutstandingAcksByChannelRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIBCOutstandingAcksByChannelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIBCOutstandingAcksByChannelRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIBCOutstandingAcksByChannelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIBCOutstandingAcksByChannelRequest.Merge(m, src)
+}
+func (m *QueryIBCOutstandingAcksByChannelRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIBCOutstandingAcksByChannelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIBCOutstandingAcksByChannelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIBCOutstandingAcksByChannelRequest proto.InternalMessageInfo
+
+// QueryIBCOutstandingAcksByChannelResponse is the response type of the
+// Query/IBCOutstandingAcksByChannel RPC method.
+type QueryIBCOutstandingAcksByChannelResponse struct {
+	Channels []ChannelSequences `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels"`
+}
+
+func (m *QueryIBCOutstandingAcksByChannelResponse) Reset() {
+	*m = QueryIBCOutstandingAcksByChannelResponse{}
+}
+func (m *QueryIBCOutstandingAcksByChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIBCOutstandingAcksByChannelResponse) ProtoMessage()    {}
+func (*QueryIBCOutstandingAcksByChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05f851922b02ee8e, []int{17}
+}
+func (m *QueryIBCOutstandingAcksByChannelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIBCOutstandingAcksByChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIBCOutstandingAcksByChannelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIBCOutstandingAcksByChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIBCOutstandingAcksByChannelResponse.Merge(m, src)
+}
+func (m *QueryIBCOutstandingAcksByChannelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIBCOutstandingAcksByChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIBCOutstandingAcksByChannelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIBCOutstandingAcksByChannelResponse proto.InternalMessageInfo
+
+func (m *QueryIBCOutstandingAcksByChannelResponse) GetChannels() []ChannelSequences {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+// ChannelSequences lists packet sequences of an IBC channel.
+type ChannelSequences struct {
+	PortId    string   `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string   `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Sequences []uint64 `protobuf:"varint,3,rep,packed,name=sequences,proto3" json:"sequences,omitempty"`
+}
+
+func (m *ChannelSequences) Reset()         { *m = ChannelSequences{} }
+func (m *ChannelSequences) String() string { return proto.CompactTextString(m) }
+func (*ChannelSequences) ProtoMessage()    {}
+func (*ChannelSequences) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05f851922b02ee8e, []int{18}
+}
+func (m *ChannelSequences) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelSequences) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelSequences.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelSequences) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelSequences.Merge(m, src)
+}
+func (m *ChannelSequences) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelSequences) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelSequences.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelSequences proto.InternalMessageInfo
+
+func (m *ChannelSequences) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *ChannelSequences) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ChannelSequences) GetSequences() []uint64 {
+	if m != nil {
+		return m.Sequences
+	}
+	return nil
+}
+
+// QueryICAHostAccountsRequest is the request type of the
+// Query/ICAHostAccounts RPC method.
+type QueryICAHostAccountsRequest struct {
+}
+
+func (m *QueryICAHostAccountsRequest) Reset()         { *m = QueryICAHostAccountsRequest{} }
+func (m *QueryICAHostAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryICAHostAccountsRequest) ProtoMessage()    {}
+func (*QueryICAHostAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05f851922b02ee8e, []int{19}
+}
+func (m *QueryICAHostAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryICAHostAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryICAHostAccountsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryICAHostAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryICAHostAccountsRequest.Merge(m, src)
+}
+func (m *QueryICAHostAccountsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryICAHostAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryICAHostAccountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryICAHostAccountsRequest proto.InternalMessageInfo
+
+// QueryICAHostAccountsResponse is the response type of the
+// Query/ICAHostAccounts RPC method.
+type QueryICAHostAccountsResponse struct {
+	Accounts []ICAHostAccount `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
+}
+
+func (m *QueryICAHostAccountsResponse) Reset()         { *m = QueryICAHostAccountsResponse{} }
+func (m *QueryICAHostAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryICAHostAccountsResponse) ProtoMessage()    {}
+func (*QueryICAHostAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05f851922b02ee8e, []int{20}
+}
+func (m *QueryICAHostAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryICAHostAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryICAHostAccountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryICAHostAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryICAHostAccountsResponse.Merge(m, src)
+}
+func (m *QueryICAHostAccountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryICAHostAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryICAHostAccountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryICAHostAccountsResponse proto.InternalMessageInfo
+
+func (m *QueryICAHostAccountsResponse) GetAccounts() []ICAHostAccount {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+// ICAHostAccount is an interchain account hosted on this chain, along with
+// the controller owning it.
+type ICAHostAccount struct {
+	Address          string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	ConnectionId     string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	ControllerPortId string `protobuf:"bytes,3,opt,name=controller_port_id,json=controllerPortId,proto3" json:"controller_port_id,omitempty"`
+	// controller_chain_id is empty unless the connection's client is a
+	// tendermint light client.
+	ControllerChainId string `protobuf:"bytes,4,opt,name=controller_chain_id,json=controllerChainId,proto3" json:"controller_chain_id,omitempty"`
+}
+
+func (m *ICAHostAccount) Reset()         { *m = ICAHostAccount{} }
+func (m *ICAHostAccount) String() string { return proto.CompactTextString(m) }
+func (*ICAHostAccount) ProtoMessage()    {}
+func (*ICAHostAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05f851922b02ee8e, []int{21}
+}
+func (m *ICAHostAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ICAHostAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ICAHostAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ICAHostAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ICAHostAccount.Merge(m, src)
+}
+func (m *ICAHostAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ICAHostAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ICAHostAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ICAHostAccount proto.InternalMessageInfo
+
+func (m *ICAHostAccount) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ICAHostAccount) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *ICAHostAccount) GetControllerPortId() string {
+	if m != nil {
+		return m.ControllerPortId
+	}
+	return ""
+}
+
+func (m *ICAHostAccount) GetControllerChainId() string {
+	if m != nil {
+		return m.ControllerChainId
+	}
+	return ""
+}
+
+// QueryRateLimitsRequest is the request type of the Query/RateLimits RPC
+// method.
+type QueryRateLimitsRequest struct {
+}
+
+func (m *QueryRateLimitsRequest) Reset()         { *m = QueryRateLimitsRequest{} }
+func (m *QueryRateLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitsRequest) ProtoMessage()    {}
+func (*QueryRateLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05f851922b02ee8e, []int{22}
+}
+func (m *QueryRateLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRateLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitsRequest.Merge(m, src)
+}
+func (m *QueryRateLimitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRateLimitsRequest proto.InternalMessageInfo
+
+// QueryRateLimitsResponse is the response type of the Query/RateLimits RPC
+// method.
+type QueryRateLimitsResponse struct {
+	RateLimits []RateLimitStatus `protobuf:"bytes,1,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
+}
+
+func (m *QueryRateLimitsResponse) Reset()         { *m = QueryRateLimitsResponse{} }
+func (m *QueryRateLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitsResponse) ProtoMessage()    {}
+func (*QueryRateLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05f851922b02ee8e, []int{23}
+}
+func (m *QueryRateLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRateLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitsResponse.Merge(m, src)
+}
+func (m *QueryRateLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRateLimitsResponse proto.InternalMessageInfo
+
+func (m *QueryRateLimitsResponse) GetRateLimits() []RateLimitStatus {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
+// RateLimitStatus is an IBC rate limit, with its quota and the flow of its
+// current window, and the time left until that window resets.
+type RateLimitStatus struct {
+	// denom is the rate limited denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// channel_or_client_id is the IBC classic channel or IBC v2 client the rate
+	// limit applies to.
+	ChannelOrClientId string `protobuf:"bytes,2,opt,name=channel_or_client_id,json=channelOrClientId,proto3" json:"channel_or_client_id,omitempty"`
+	// max_percent_send is the percentage of the channel value that can be sent
+	// in a window.
+	MaxPercentSend cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=max_percent_send,json=maxPercentSend,proto3,customtype=cosmossdk.io/math.Int" json:"max_percent_send"`
+	// max_percent_recv is the percentage of the channel value that can be
+	// received in a window.
+	MaxPercentRecv cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=max_percent_recv,json=maxPercentRecv,proto3,customtype=cosmossdk.io/math.Int" json:"max_percent_recv"`
+	// duration_hours is the length of a window.
+	DurationHours uint64 `protobuf:"varint,5,opt,name=duration_hours,json=durationHours,proto3" json:"duration_hours,omitempty"`
+	// inflow is the amount received in the current window.
+	Inflow cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=inflow,proto3,customtype=cosmossdk.io/math.Int" json:"inflow"`
+	// outflow is the amount sent in the current window.
+	Outflow cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=outflow,proto3,customtype=cosmossdk.io/math.Int" json:"outflow"`
+	// channel_value is the supply of the denom the percentages apply to.
+	ChannelValue cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=channel_value,json=channelValue,proto3,customtype=cosmossdk.io/math.Int" json:"channel_value"`
+	// resets_in is the time until the first epoch of the next window starts,
+	// zero if it is due. The flow is reset in the first block after it.
+	ResetsIn time.Duration `protobuf:"bytes,9,opt,name=resets_in,json=resetsIn,proto3,stdduration" json:"resets_in"`
+}
+
+func (m *RateLimitStatus) Reset()         { *m = RateLimitStatus{} }
+func (m *RateLimitStatus) String() string { return proto.CompactTextString(m) }
+func (*RateLimitStatus) ProtoMessage()    {}
+func (*RateLimitStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05f851922b02ee8e, []int{24}
+}
+func (m *RateLimitStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitStatus.Merge(m, src)
+}
+func (m *RateLimitStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitStatus proto.InternalMessageInfo
+
+func (m *RateLimitStatus) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *RateLimitStatus) GetChannelOrClientId() string {
+	if m != nil {
+		return m.ChannelOrClientId
+	}
+	return ""
+}
+
+func (m *RateLimitStatus) GetDurationHours() uint64 {
+	if m != nil {
+		return m.DurationHours
+	}
+	return 0
+}
+
+func (m *RateLimitStatus) GetResetsIn() time.Duration {
+	if m != nil {
+		return m.ResetsIn
+	}
+	return 0
+}
+
+// QueryRateLimitFlowHistoryRequest is the request type of the
+// Query/RateLimitFlowHistory RPC method.
+type QueryRateLimitFlowHistoryRequest struct {
+	// denom is the rate limited denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// channel_or_client_id is the IBC classic channel or IBC v2 client the rate
+	// limit applies to.
+	ChannelOrClientId string `protobuf:"bytes,2,opt,name=channel_or_client_id,json=channelOrClientId,proto3" json:"channel_or_client_id,omitempty"`
+	// limit is the maximum number of windows returned, the most recent ones.
+	// Zero returns all the windows kept.
+	Limit uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *QueryRateLimitFlowHistoryRequest) Reset()         { *m = QueryRateLimitFlowHistoryRequest{} }
+func (m *QueryRateLimitFlowHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitFlowHistoryRequest) ProtoMessage()    {}
+func (*QueryRateLimitFlowHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05f851922b02ee8e, []int{25}
+}
+func (m *QueryRateLimitFlowHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitFlowHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitFlowHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRateLimitFlowHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitFlowHistoryRequest.Merge(m, src)
+}
+func (m *QueryRateLimitFlowHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimitFlowHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitFlowHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRateLimitFlowHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryRateLimitFlowHistoryRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QueryRateLimitFlowHistoryRequest) GetChannelOrClientId() string {
+	if m != nil {
+		return m.ChannelOrClientId
+	}
+	return ""
+}
+
+func (m *QueryRateLimitFlowHistoryRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// QueryRateLimitFlowHistoryResponse is the response type of the
+// Query/RateLimitFlowHistory RPC method.
+type QueryRateLimitFlowHistoryResponse struct {
+	Windows []RateLimitWindow `protobuf:"bytes,1,rep,name=windows,proto3" json:"windows"`
+}
+
+func (m *QueryRateLimitFlowHistoryResponse) Reset()         { *m = QueryRateLimitFlowHistoryResponse{} }
+func (m *QueryRateLimitFlowHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitFlowHistoryResponse) ProtoMessage()    {}
+func (*QueryRateLimitFlowHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05f851922b02ee8e, []int{26}
+}
+func (m *QueryRateLimitFlowHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitFlowHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitFlowHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRateLimitFlowHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitFlowHistoryResponse.Merge(m, src)
+}
+func (m *QueryRateLimitFlowHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimitFlowHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitFlowHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRateLimitFlowHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryRateLimitFlowHistoryResponse) GetWindows() []RateLimitWindow {
+	if m != nil {
+		return m.Windows
+	}
+	return nil
+}
+
+// RateLimitWindow is the flow of a past window of an IBC rate limit.
+type RateLimitWindow struct {
+	// end is the time the window ended and its flow was reset.
+	End          time.Time             `protobuf:"bytes,1,opt,name=end,proto3,stdtime" json:"end"`
+	Inflow       cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=inflow,proto3,customtype=cosmossdk.io/math.Int" json:"inflow"`
+	Outflow      cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=outflow,proto3,customtype=cosmossdk.io/math.Int" json:"outflow"`
+	ChannelValue cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=channel_value,json=channelValue,proto3,customtype=cosmossdk.io/math.Int" json:"channel_value"`
+}
+
+func (m *RateLimitWindow) Reset()         { *m = RateLimitWindow{} }
+func (m *RateLimitWindow) String() string { return proto.CompactTextString(m) }
+func (*RateLimitWindow) ProtoMessage()    {}
+func (*RateLimitWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05f851922b02ee8e, []int{27}
+}
+func (m *RateLimitWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitWindow.Merge(m, src)
+}
+func (m *RateLimitWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitWindow proto.InternalMessageInfo
+
+func (m *RateLimitWindow) GetEnd() time.Time {
+	if m != nil {
+		return m.End
+	}
+	return time.Time{}
+}
+
+// QueryLatestBlockGasUsageRequest is the request type of the
+// Query/LatestBlockGasUsage RPC method.
+type QueryLatestBlockGasUsageRequest struct {
+}
+
+func (m *QueryLatestBlockGasUsageRequest) Reset()         { *m = QueryLatestBlockGasUsageRequest{} }
+func (m *QueryLatestBlockGasUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLatestBlockGasUsageRequest) ProtoMessage()    {}
+func (*QueryLatestBlockGasUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05f851922b02ee8e, []int{28}
+}
+func (m *QueryLatestBlockGasUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLatestBlockGasUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLatestBlockGasUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLatestBlockGasUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestBlockGasUsageRequest.Merge(m, src)
+}
+func (m *QueryLatestBlockGasUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLatestBlockGasUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestBlockGasUsageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLatestBlockGasUsageRequest proto.InternalMessageInfo
+
+// QueryLatestBlockGasUsageResponse is the response type of the
+// Query/LatestBlockGasUsage RPC method.
+type QueryLatestBlockGasUsageResponse struct {
+	Usage BlockGasUsage `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage"`
+}
+
+func (m *QueryLatestBlockGasUsageResponse) Reset()         { *m = QueryLatestBlockGasUsageResponse{} }
+func (m *QueryLatestBlockGasUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLatestBlockGasUsageResponse) ProtoMessage()    {}
+func (*QueryLatestBlockGasUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05f851922b02ee8e, []int{29}
+}
+func (m *QueryLatestBlockGasUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLatestBlockGasUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLatestBlockGasUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLatestBlockGasUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestBlockGasUsageResponse.Merge(m, src)
+}
+func (m *QueryLatestBlockGasUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLatestBlockGasUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestBlockGasUsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLatestBlockGasUsageResponse proto.InternalMessageInfo
+
+func (m *QueryLatestBlockGasUsageResponse) GetUsage() BlockGasUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return BlockGasUsage{}
+}
+
+// QueryDenomSummaryRequest is the request type of the Query/DenomSummary
+// RPC method.
+type QueryDenomSummaryRequest struct {
+	// denom is the factory/ denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryDenomSummaryRequest) Reset()         { *m = QueryDenomSummaryRequest{} }
+func (m *QueryDenomSummaryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomSummaryRequest) ProtoMessage()    {}
+func (*QueryDenomSummaryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05f851922b02ee8e, []int{30}
+}
+func (m *QueryDenomSummaryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomSummaryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomSummaryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomSummaryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomSummaryRequest.Merge(m, src)
+}
+func (m *QueryDenomSummaryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomSummaryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomSummaryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomSummaryRequest proto.InternalMessageInfo
+
+func (m *QueryDenomSummaryRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryDenomSummaryResponse is the response type of the Query/DenomSummary
+// RPC method.
+type QueryDenomSummaryResponse struct {
+	Summary DenomSummary `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary"`
+}
+
+func (m *QueryDenomSummaryResponse) Reset()         { *m = QueryDenomSummaryResponse{} }
+func (m *QueryDenomSummaryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomSummaryResponse) ProtoMessage()    {}
+func (*QueryDenomSummaryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05f851922b02ee8e, []int{31}
+}
+func (m *QueryDenomSummaryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomSummaryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomSummaryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomSummaryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomSummaryResponse.Merge(m, src)
+}
+func (m *QueryDenomSummaryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomSummaryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomSummaryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomSummaryResponse proto.InternalMessageInfo
+
+func (m *QueryDenomSummaryResponse) GetSummary() DenomSummary {
+	if m != nil {
+		return m.Summary
+	}
+	return DenomSummary{}
+}
+
+// DenomSummary gathers what explorers display about a tokenfactory denom.
+type DenomSummary struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// creator is the account or contract that created the denom.
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// admin is the current admin of the denom, empty if it has none.
+	Admin          string          `protobuf:"bytes,3,opt,name=admin,proto3" json:"admin,omitempty"`
+	Supply         types.Coin      `protobuf:"bytes,4,opt,name=supply,proto3" json:"supply"`
+	Metadata       types1.Metadata `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata"`
+	MetadataLocked bool            `protobuf:"varint,6,opt,name=metadata_locked,json=metadataLocked,proto3" json:"metadata_locked,omitempty"`
+	MintPaused     bool            `protobuf:"varint,7,opt,name=mint_paused,json=mintPaused,proto3" json:"mint_paused,omitempty"`
+	// before_send_hooks lists the upstream tokenfactory hook, if any, followed
+	// by the Kudora before-send hooks in execution order.
+	BeforeSendHooks []string `protobuf:"bytes,8,rep,name=before_send_hooks,json=beforeSendHooks,proto3" json:"before_send_hooks,omitempty"`
+}
+
+func (m *DenomSummary) Reset()         { *m = DenomSummary{} }
+func (m *DenomSummary) String() string { return proto.CompactTextString(m) }
+func (*DenomSummary) ProtoMessage()    {}
+func (*DenomSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05f851922b02ee8e, []int{32}
+}
+func (m *DenomSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomSummary.Merge(m, src)
+}
+func (m *DenomSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomSummary proto.InternalMessageInfo
+
+func (m *DenomSummary) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomSummary) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *DenomSummary) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *DenomSummary) GetSupply() types.Coin {
+	if m != nil {
+		return m.Supply
+	}
+	return types.Coin{}
+}
+
+func (m *DenomSummary) GetMetadata() types1.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return types1.Metadata{}
+}
+
+func (m *DenomSummary) GetMetadataLocked() bool {
+	if m != nil {
+		return m.MetadataLocked
+	}
+	return false
+}
+
+func (m *DenomSummary) GetMintPaused() bool {
+	if m != nil {
+		return m.MintPaused
+	}
+	return false
+}
+
+func (m *DenomSummary) GetBeforeSendHooks() []string {
+	if m != nil {
+		return m.BeforeSendHooks
+	}
+	return nil
+}
+
+// QueryDenomsByAdminRequest is the request type of the Query/DenomsByAdmin
+// RPC method.
+type QueryDenomsByAdminRequest struct {
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+}
+
+func (m *QueryDenomsByAdminRequest) Reset()         { *m = QueryDenomsByAdminRequest{} }
+func (m *QueryDenomsByAdminRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomsByAdminRequest) ProtoMessage()    {}
+func (*QueryDenomsByAdminRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05f851922b02ee8e, []int{33}
+}
+func (m *QueryDenomsByAdminRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomsByAdminRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomsByAdminRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomsByAdminRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomsByAdminRequest.Merge(m, src)
+}
+func (m *QueryDenomsByAdminRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomsByAdminRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomsByAdminRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomsByAdminRequest proto.InternalMessageInfo
+
+func (m *QueryDenomsByAdminRequest) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+// QueryDenomsByAdminResponse is the response type of the Query/DenomsByAdmin
+// RPC method.
+type QueryDenomsByAdminResponse struct {
+	Denoms []string `protobuf:"bytes,1,rep,name=denoms,proto3" json:"denoms,omitempty"`
+}
+
+func (m *QueryDenomsByAdminResponse) Reset()         { *m = QueryDenomsByAdminResponse{} }
+func (m *QueryDenomsByAdminResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomsByAdminResponse) ProtoMessage()    {}
+func (*QueryDenomsByAdminResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05f851922b02ee8e, []int{34}
+}
+func (m *QueryDenomsByAdminResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomsByAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomsByAdminResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomsByAdminResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomsByAdminResponse.Merge(m, src)
+}
+func (m *QueryDenomsByAdminResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomsByAdminResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomsByAdminResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomsByAdminResponse proto.InternalMessageInfo
+
+func (m *QueryDenomsByAdminResponse) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
+// QueryAdminSupplyRequest is the request type of the Query/AdminSupply RPC
+// method.
+type QueryAdminSupplyRequest struct {
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+}
+
+func (m *QueryAdminSupplyRequest) Reset()         { *m = QueryAdminSupplyRequest{} }
+func (m *QueryAdminSupplyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAdminSupplyRequest) ProtoMessage()    {}
+func (*QueryAdminSupplyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05f851922b02ee8e, []int{35}
+}
+func (m *QueryAdminSupplyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAdminSupplyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAdminSupplyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAdminSupplyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAdminSupplyRequest.Merge(m, src)
+}
+func (m *QueryAdminSupplyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAdminSupplyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAdminSupplyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAdminSupplyRequest proto.InternalMessageInfo
+
+func (m *QueryAdminSupplyRequest) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+// QueryAdminSupplyResponse is the response type of the Query/AdminSupply RPC
+// method.
+type QueryAdminSupplyResponse struct {
+	// denoms are the tokenfactory denoms administered by the admin.
+	Denoms []string `protobuf:"bytes,1,rep,name=denoms,proto3" json:"denoms,omitempty"`
+	// supply is the total supply of the denoms.
+	Supply github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=supply,proto3" json:"supply"`
+}
+
+func (m *QueryAdminSupplyResponse) Reset()         { *m = QueryAdminSupplyResponse{} }
+func (m *QueryAdminSupplyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAdminSupplyResponse) ProtoMessage()    {}
+func (*QueryAdminSupplyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05f851922b02ee8e, []int{36}
+}
+func (m *QueryAdminSupplyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAdminSupplyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAdminSupplyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAdminSupplyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAdminSupplyResponse.Merge(m, src)
+}
+func (m *QueryAdminSupplyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAdminSupplyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAdminSupplyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAdminSupplyResponse proto.InternalMessageInfo
+
+func (m *QueryAdminSupplyResponse) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
+func (m *QueryAdminSupplyResponse) GetSupply() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Supply
+	}
+	return nil
+}
+
+// QueryDenomERC20PairRequest is the request type of the Query/DenomERC20Pair
+// RPC method.
+type QueryDenomERC20PairRequest struct {
+	// denom is the factory/ denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryDenomERC20PairRequest) Reset()         { *m = QueryDenomERC20PairRequest{} }
+func (m *QueryDenomERC20PairRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomERC20PairRequest) ProtoMessage()    {}
+func (*QueryDenomERC20PairRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05f851922b02ee8e, []int{37}
+}
+func (m *QueryDenomERC20PairRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomERC20PairRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomERC20PairRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomERC20PairRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomERC20PairRequest.Merge(m, src)
+}
+func (m *QueryDenomERC20PairRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomERC20PairRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomERC20PairRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomERC20PairRequest proto.InternalMessageInfo
+
+func (m *QueryDenomERC20PairRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryDenomERC20PairResponse is the response type of the
+// Query/DenomERC20Pair RPC method.
+type QueryDenomERC20PairResponse struct {
+	// registered tells whether the denom has an ERC20 token pair. The other
+	// fields are empty if not.
+	Registered bool `protobuf:"varint,1,opt,name=registered,proto3" json:"registered,omitempty"`
+	// contract_address is the 0x address of the ERC20 contract.
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// enabled tells whether conversions between the denom and the ERC20 token
+	// are enabled.
+	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *QueryDenomERC20PairResponse) Reset()         { *m = QueryDenomERC20PairResponse{} }
+func (m *QueryDenomERC20PairResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomERC20PairResponse) ProtoMessage()    {}
+func (*QueryDenomERC20PairResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05f851922b02ee8e, []int{38}
+}
+func (m *QueryDenomERC20PairResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomERC20PairResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomERC20PairResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomERC20PairResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomERC20PairResponse.Merge(m, src)
+}
+func (m *QueryDenomERC20PairResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomERC20PairResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomERC20PairResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomERC20PairResponse proto.InternalMessageInfo
+
+func (m *QueryDenomERC20PairResponse) GetRegistered() bool {
+	if m != nil {
+		return m.Registered
+	}
+	return false
+}
+
+func (m *QueryDenomERC20PairResponse) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *QueryDenomERC20PairResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+// QueryTrippedMsgTypeURLsRequest is the request type of the
+// Query/TrippedMsgTypeURLs RPC method.
+type QueryTrippedMsgTypeURLsRequest struct {
+}
+
+func (m *QueryTrippedMsgTypeURLsRequest) Reset()         { *m = QueryTrippedMsgTypeURLsRequest{} }
+func (m *QueryTrippedMsgTypeURLsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTrippedMsgTypeURLsRequest) ProtoMessage()    {}
+func (*QueryTrippedMsgTypeURLsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05f851922b02ee8e, []int{39}
+}
+func (m *QueryTrippedMsgTypeURLsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTrippedMsgTypeURLsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTrippedMsgTypeURLsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTrippedMsgTypeURLsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTrippedMsgTypeURLsRequest.Merge(m, src)
+}
+func (m *QueryTrippedMsgTypeURLsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTrippedMsgTypeURLsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTrippedMsgTypeURLsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTrippedMsgTypeURLsRequest proto.InternalMessageInfo
+
+// QueryTrippedMsgTypeURLsResponse is the response type of the
+// Query/TrippedMsgTypeURLs RPC method.
+type QueryTrippedMsgTypeURLsResponse struct {
+	TypeUrls []string `protobuf:"bytes,1,rep,name=type_urls,json=typeUrls,proto3" json:"type_urls,omitempty"`
+}
+
+func (m *QueryTrippedMsgTypeURLsResponse) Reset()         { *m = QueryTrippedMsgTypeURLsResponse{} }
+func (m *QueryTrippedMsgTypeURLsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTrippedMsgTypeURLsResponse) ProtoMessage()    {}
+func (*QueryTrippedMsgTypeURLsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05f851922b02ee8e, []int{40}
+}
+func (m *QueryTrippedMsgTypeURLsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTrippedMsgTypeURLsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTrippedMsgTypeURLsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTrippedMsgTypeURLsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTrippedMsgTypeURLsResponse.Merge(m, src)
+}
+func (m *QueryTrippedMsgTypeURLsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTrippedMsgTypeURLsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTrippedMsgTypeURLsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTrippedMsgTypeURLsResponse proto.InternalMessageInfo
+
+func (m *QueryTrippedMsgTypeURLsResponse) GetTypeUrls() []string {
+	if m != nil {
+		return m.TypeUrls
+	}
+	return nil
+}
+
+// QueryNativeSupplyBreakdownRequest is the request type of the
+// Query/NativeSupplyBreakdown RPC method.
+type QueryNativeSupplyBreakdownRequest struct {
+}
+
+func (m *QueryNativeSupplyBreakdownRequest) Reset()         { *m = QueryNativeSupplyBreakdownRequest{} }
+func (m *QueryNativeSupplyBreakdownRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNativeSupplyBreakdownRequest) ProtoMessage()    {}
+func (*QueryNativeSupplyBreakdownRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05f851922b02ee8e, []int{41}
+}
+func (m *QueryNativeSupplyBreakdownRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNativeSupplyBreakdownRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNativeSupplyBreakdownRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNativeSupplyBreakdownRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNativeSupplyBreakdownRequest.Merge(m, src)
+}
+func (m *QueryNativeSupplyBreakdownRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNativeSupplyBreakdownRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNativeSupplyBreakdownRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNativeSupplyBreakdownRequest proto.InternalMessageInfo
+
+// QueryNativeSupplyBreakdownResponse is the response type of the
+// Query/NativeSupplyBreakdown RPC method. Its parts add up to the total
+// supply.
+type QueryNativeSupplyBreakdownResponse struct {
+	Total cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=total,proto3,customtype=cosmossdk.io/math.Int" json:"total"`
+	// bonded is held by the staking bonded pool.
+	Bonded cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=bonded,proto3,customtype=cosmossdk.io/math.Int" json:"bonded"`
+	// community_pool is the community pool, held by the distribution module.
+	CommunityPool cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=community_pool,json=communityPool,proto3,customtype=cosmossdk.io/math.Int" json:"community_pool"`
+	// module_held is held by other module accounts, including the unbonding
+	// pool and the undistributed rewards.
+	ModuleHeld cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=module_held,json=moduleHeld,proto3,customtype=cosmossdk.io/math.Int" json:"module_held"`
+	// circulating is everything else, held by regular accounts and contracts.
+	Circulating cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=circulating,proto3,customtype=cosmossdk.io/math.Int" json:"circulating"`
+}
+
+func (m *QueryNativeSupplyBreakdownResponse) Reset()         { *m = QueryNativeSupplyBreakdownResponse{} }
+func (m *QueryNativeSupplyBreakdownResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNativeSupplyBreakdownResponse) ProtoMessage()    {}
+func (*QueryNativeSupplyBreakdownResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05f851922b02ee8e, []int{42}
+}
+func (m *QueryNativeSupplyBreakdownResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNativeSupplyBreakdownResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNativeSupplyBreakdownResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNativeSupplyBreakdownResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNativeSupplyBreakdownResponse.Merge(m, src)
+}
+func (m *QueryNativeSupplyBreakdownResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNativeSupplyBreakdownResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNativeSupplyBreakdownResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNativeSupplyBreakdownResponse proto.InternalMessageInfo
+
+// QueryFeeGrantsForGranteeRequest is the request type of the
+// Query/FeeGrantsForGrantee RPC method.
+type QueryFeeGrantsForGranteeRequest struct {
+	// grantee is the bech32 address of the grantee.
+	Grantee string `protobuf:"bytes,1,opt,name=grantee,proto3" json:"grantee,omitempty"`
+}
+
+func (m *QueryFeeGrantsForGranteeRequest) Reset()         { *m = QueryFeeGrantsForGranteeRequest{} }
+func (m *QueryFeeGrantsForGranteeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeGrantsForGranteeRequest) ProtoMessage()    {}
+func (*QueryFeeGrantsForGranteeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05f851922b02ee8e, []int{43}
+}
+func (m *QueryFeeGrantsForGranteeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeGrantsForGranteeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeGrantsForGranteeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeGrantsForGranteeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeGrantsForGranteeRequest.Merge(m, src)
+}
+func (m *QueryFeeGrantsForGranteeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeGrantsForGranteeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeGrantsForGranteeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeGrantsForGranteeRequest proto.InternalMessageInfo
+
+func (m *QueryFeeGrantsForGranteeRequest) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+// QueryFeeGrantsForGranteeResponse is the response type of the
+// Query/FeeGrantsForGrantee RPC method.
+type QueryFeeGrantsForGranteeResponse struct {
+	Grants []feegrant.Grant `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants"`
+}
+
+func (m *QueryFeeGrantsForGranteeResponse) Reset()         { *m = QueryFeeGrantsForGranteeResponse{} }
+func (m *QueryFeeGrantsForGranteeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeGrantsForGranteeResponse) ProtoMessage()    {}
+func (*QueryFeeGrantsForGranteeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05f851922b02ee8e, []int{44}
+}
+func (m *QueryFeeGrantsForGranteeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeGrantsForGranteeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeGrantsForGranteeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeGrantsForGranteeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeGrantsForGranteeResponse.Merge(m, src)
+}
+func (m *QueryFeeGrantsForGranteeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeGrantsForGranteeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeGrantsForGranteeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeGrantsForGranteeResponse proto.InternalMessageInfo
+
+func (m *QueryFeeGrantsForGranteeResponse) GetGrants() []feegrant.Grant {
+	if m != nil {
+		return m.Grants
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "kudora.kudora.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "kudora.kudora.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryEVMAddressRequest)(nil), "kudora.kudora.v1.QueryEVMAddressRequest")
+	proto.RegisterType((*QueryEVMAddressResponse)(nil), "kudora.kudora.v1.QueryEVMAddressResponse")
+	proto.RegisterType((*QueryBech32AddressRequest)(nil), "kudora.kudora.v1.QueryBech32AddressRequest")
+	proto.RegisterType((*QueryBech32AddressResponse)(nil), "kudora.kudora.v1.QueryBech32AddressResponse")
+	proto.RegisterType((*QueryIBCEscrowBalancesRequest)(nil), "kudora.kudora.v1.QueryIBCEscrowBalancesRequest")
+	proto.RegisterType((*QueryIBCEscrowBalancesResponse)(nil), "kudora.kudora.v1.QueryIBCEscrowBalancesResponse")
+	proto.RegisterType((*QueryIBCTransferFlowRequest)(nil), "kudora.kudora.v1.QueryIBCTransferFlowRequest")
+	proto.RegisterType((*QueryIBCTransferFlowResponse)(nil), "kudora.kudora.v1.QueryIBCTransferFlowResponse")
+	proto.RegisterType((*QueryIBCConnectionsByClientRequest)(nil), "kudora.kudora.v1.QueryIBCConnectionsByClientRequest")
+	proto.RegisterType((*QueryIBCConnectionsByClientResponse)(nil), "kudora.kudora.v1.QueryIBCConnectionsByClientResponse")
+	proto.RegisterType((*IBCConnection)(nil), "kudora.kudora.v1.IBCConnection")
+	proto.RegisterType((*QueryIBCChannelCountsByStateRequest)(nil), "kudora.kudora.v1.QueryIBCChannelCountsByStateRequest")
+	proto.RegisterType((*QueryIBCChannelCountsByStateResponse)(nil), "kudora.kudora.v1.QueryIBCChannelCountsByStateResponse")
+	proto.RegisterType((*ChannelStateCount)(nil), "kudora.kudora.v1.ChannelStateCount")
+	proto.RegisterType((*QueryIBCOutstandingAcksByChannelRequest)(nil), "kudora.kudora.v1.QueryIBCOutstandingAcksByChannelRequest")
+	proto.RegisterType((*QueryIBCOutstandingAcksByChannelResponse)(nil), "kudora.kudora.v1.QueryIBCOutstandingAcksByChannelResponse")
+	proto.RegisterType((*ChannelSequences)(nil), "kudora.kudora.v1.ChannelSequences")
+	proto.RegisterType((*QueryICAHostAccountsRequest)(nil), "kudora.kudora.v1.QueryICAHostAccountsRequest")
+	proto.RegisterType((*QueryICAHostAccountsResponse)(nil), "kudora.kudora.v1.QueryICAHostAccountsResponse")
+	proto.RegisterType((*ICAHostAccount)(nil), "kudora.kudora.v1.ICAHostAccount")
+	proto.RegisterType((*QueryRateLimitsRequest)(nil), "kudora.kudora.v1.QueryRateLimitsRequest")
+	proto.RegisterType((*QueryRateLimitsResponse)(nil), "kudora.kudora.v1.QueryRateLimitsResponse")
+	proto.RegisterType((*RateLimitStatus)(nil), "kudora.kudora.v1.RateLimitStatus")
+	proto.RegisterType((*QueryRateLimitFlowHistoryRequest)(nil), "kudora.kudora.v1.QueryRateLimitFlowHistoryRequest")
+	proto.RegisterType((*QueryRateLimitFlowHistoryResponse)(nil), "kudora.kudora.v1.QueryRateLimitFlowHistoryResponse")
+	proto.RegisterType((*RateLimitWindow)(nil), "kudora.kudora.v1.RateLimitWindow")
+	proto.RegisterType((*QueryLatestBlockGasUsageRequest)(nil), "kudora.kudora.v1.QueryLatestBlockGasUsageRequest")
+	proto.RegisterType((*QueryLatestBlockGasUsageResponse)(nil), "kudora.kudora.v1.QueryLatestBlockGasUsageResponse")
+	proto.RegisterType((*QueryDenomSummaryRequest)(nil), "kudora.kudora.v1.QueryDenomSummaryRequest")
+	proto.RegisterType((*QueryDenomSummaryResponse)(nil), "kudora.kudora.v1.QueryDenomSummaryResponse")
+	proto.RegisterType((*DenomSummary)(nil), "kudora.kudora.v1.DenomSummary")
+	proto.RegisterType((*QueryDenomsByAdminRequest)(nil), "kudora.kudora.v1.QueryDenomsByAdminRequest")
+	proto.RegisterType((*QueryDenomsByAdminResponse)(nil), "kudora.kudora.v1.QueryDenomsByAdminResponse")
+	proto.RegisterType((*QueryAdminSupplyRequest)(nil), "kudora.kudora.v1.QueryAdminSupplyRequest")
+	proto.RegisterType((*QueryAdminSupplyResponse)(nil), "kudora.kudora.v1.QueryAdminSupplyResponse")
+	proto.RegisterType((*QueryDenomERC20PairRequest)(nil), "kudora.kudora.v1.QueryDenomERC20PairRequest")
+	proto.RegisterType((*QueryDenomERC20PairResponse)(nil), "kudora.kudora.v1.QueryDenomERC20PairResponse")
+	proto.RegisterType((*QueryTrippedMsgTypeURLsRequest)(nil), "kudora.kudora.v1.QueryTrippedMsgTypeURLsRequest")
+	proto.RegisterType((*QueryTrippedMsgTypeURLsResponse)(nil), "kudora.kudora.v1.QueryTrippedMsgTypeURLsResponse")
+	proto.RegisterType((*QueryNativeSupplyBreakdownRequest)(nil), "kudora.kudora.v1.QueryNativeSupplyBreakdownRequest")
+	proto.RegisterType((*QueryNativeSupplyBreakdownResponse)(nil), "kudora.kudora.v1.QueryNativeSupplyBreakdownResponse")
+	proto.RegisterType((*QueryFeeGrantsForGranteeRequest)(nil), "kudora.kudora.v1.QueryFeeGrantsForGranteeRequest")
+	proto.RegisterType((*QueryFeeGrantsForGranteeResponse)(nil), "kudora.kudora.v1.QueryFeeGrantsForGranteeResponse")
+}
+
+func init() { proto.RegisterFile("kudora/kudora/v1/query.proto", fileDescriptor_05f851922b02ee8e) }
+
+var fileDescriptor_05f851922b02ee8e = []byte{
+	// 2506 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4b, 0x6f, 0x1c, 0xc7,
+	0xf1, 0xd7, 0xf0, 0xb9, 0x2c, 0x9a, 0xa4, 0xd4, 0xa2, 0xa5, 0xe5, 0x48, 0x5a, 0x52, 0x43, 0xcb,
+	0x22, 0x25, 0x71, 0x87, 0x5c, 0xd1, 0x34, 0xfe, 0xfe, 0x2b, 0x76, 0xb8, 0xb4, 0x24, 0x12, 0x91,
+	0x62, 0x66, 0x25, 0x39, 0x40, 0x72, 0x98, 0xf4, 0xce, 0x34, 0x97, 0x03, 0xce, 0x4e, 0xaf, 0x67,
+	0x7a, 0x49, 0x11, 0x82, 0x10, 0xc0, 0xb7, 0x00, 0x39, 0x18, 0x09, 0x90, 0xe4, 0x62, 0x20, 0x31,
+	0x10, 0x24, 0x30, 0x82, 0x1c, 0x02, 0x27, 0xf9, 0x02, 0x01, 0xe2, 0x4b, 0x00, 0xc3, 0xb9, 0x04,
+	0x09, 0x60, 0x07, 0x52, 0x3e, 0x44, 0x8e, 0xc1, 0xf4, 0x63, 0x5f, 0x33, 0xb3, 0x0f, 0x4a, 0xa7,
+	0xdd, 0xa9, 0xae, 0x5f, 0xf5, 0xaf, 0xab, 0xab, 0xab, 0xbb, 0x0a, 0x2e, 0x1e, 0xd4, 0x1d, 0x1a,
+	0x60, 0x53, 0xfe, 0x1c, 0xae, 0x99, 0x1f, 0xd4, 0x49, 0x70, 0x9c, 0xaf, 0x05, 0x94, 0x51, 0x74,
+	0x5a, 0x88, 0xf3, 0xf2, 0xe7, 0x70, 0x4d, 0xcf, 0xd9, 0x34, 0xac, 0xd2, 0xd0, 0x2c, 0x63, 0xff,
+	0xc0, 0x3c, 0x5c, 0x2b, 0x13, 0x86, 0xd7, 0xf8, 0x87, 0x40, 0xb4, 0x8c, 0x87, 0xa4, 0x31, 0x6e,
+	0x53, 0xd7, 0x97, 0xe3, 0xaf, 0xcb, 0xf1, 0x3d, 0x42, 0x2a, 0x01, 0xf6, 0x59, 0x43, 0x47, 0x09,
+	0xa4, 0xde, 0x9c, 0xd0, 0xb3, 0xf8, 0x97, 0x29, 0x3e, 0xe4, 0xd0, 0x6c, 0x85, 0x56, 0xa8, 0x90,
+	0x47, 0xff, 0xa4, 0xf4, 0x62, 0x85, 0xd2, 0x8a, 0x47, 0x4c, 0x5c, 0x73, 0x4d, 0xec, 0xfb, 0x94,
+	0x61, 0xe6, 0x52, 0x5f, 0x61, 0x72, 0x72, 0x94, 0x7f, 0x95, 0xeb, 0x7b, 0xa6, 0x53, 0x0f, 0xb8,
+	0x82, 0x1c, 0x9f, 0xef, 0x1c, 0x67, 0x6e, 0x95, 0x84, 0x0c, 0x57, 0x6b, 0x52, 0x41, 0x8f, 0xf9,
+	0xa9, 0x82, 0x95, 0xf1, 0x4b, 0xb1, 0xb1, 0x1a, 0x0e, 0x70, 0x55, 0x0e, 0x1b, 0xb3, 0x80, 0xbe,
+	0x13, 0xf9, 0x74, 0x97, 0x0b, 0x4b, 0xe4, 0x83, 0x3a, 0x09, 0x99, 0x71, 0x1f, 0xce, 0xb6, 0x49,
+	0xc3, 0x1a, 0xf5, 0x43, 0x82, 0x36, 0x60, 0x4c, 0x80, 0xb3, 0xda, 0x82, 0xb6, 0x34, 0x59, 0xc8,
+	0xe6, 0x3b, 0xb7, 0x20, 0x2f, 0x10, 0xc5, 0x91, 0xcf, 0xbf, 0x9a, 0x3f, 0x55, 0x92, 0xda, 0x46,
+	0x01, 0xce, 0x71, 0x73, 0xb7, 0xdf, 0xbf, 0xbf, 0xe9, 0x38, 0x01, 0x09, 0xd5, 0x44, 0x28, 0x0b,
+	0xe3, 0x58, 0x48, 0xb8, 0xc9, 0x89, 0x92, 0xfa, 0x34, 0xde, 0x82, 0xf3, 0x31, 0x8c, 0xa4, 0x31,
+	0x0f, 0x93, 0xe4, 0xb0, 0x6a, 0xb5, 0x03, 0x81, 0x1c, 0x56, 0xa5, 0xa2, 0x71, 0x0b, 0xe6, 0x38,
+	0xb6, 0x48, 0xec, 0xfd, 0x9b, 0x85, 0x8e, 0x29, 0x7b, 0xa2, 0x37, 0x40, 0x4f, 0x42, 0xcb, 0xc9,
+	0xd3, 0x19, 0xcf, 0xc3, 0x25, 0x8e, 0xdb, 0x29, 0x6e, 0xdd, 0x0e, 0xed, 0x80, 0x1e, 0x15, 0xb1,
+	0x87, 0x7d, 0x9b, 0x34, 0xbc, 0xfa, 0x23, 0x0d, 0x72, 0x69, 0x1a, 0xd2, 0x7a, 0x05, 0x32, 0x65,
+	0x29, 0xcb, 0x6a, 0x0b, 0xc3, 0x4b, 0x93, 0x85, 0xb9, 0xbc, 0x8c, 0xaf, 0x28, 0x68, 0xf3, 0x32,
+	0x20, 0xf3, 0x5b, 0xd4, 0xf5, 0x8b, 0xab, 0x91, 0x93, 0x3f, 0xfd, 0x7a, 0x7e, 0xa9, 0xe2, 0xb2,
+	0xfd, 0x7a, 0x39, 0x6f, 0xd3, 0xaa, 0x0c, 0x46, 0xf9, 0xb3, 0x12, 0x3a, 0x07, 0x26, 0x3b, 0xae,
+	0x91, 0x90, 0x03, 0xc2, 0x52, 0xc3, 0xb8, 0x71, 0x13, 0x2e, 0x28, 0x2a, 0x0f, 0x03, 0xec, 0x87,
+	0x7b, 0x24, 0xb8, 0xe3, 0xd1, 0x23, 0xe5, 0xa4, 0x59, 0x18, 0x75, 0x88, 0x4f, 0xab, 0x72, 0x8d,
+	0xe2, 0xc3, 0xf8, 0x54, 0x83, 0x8b, 0xc9, 0x28, 0x49, 0x7f, 0x0b, 0xc6, 0x5c, 0x7f, 0xcf, 0xa3,
+	0x47, 0x02, 0x57, 0xbc, 0x1e, 0x31, 0xfc, 0xe7, 0x57, 0xf3, 0xaf, 0x0a, 0x3e, 0xa1, 0x73, 0x90,
+	0x77, 0xa9, 0x59, 0xc5, 0x6c, 0x3f, 0xbf, 0xe3, 0xb3, 0x2f, 0x3f, 0x5b, 0x01, 0xb9, 0xb8, 0x1d,
+	0x9f, 0x95, 0x24, 0x14, 0xdd, 0x86, 0x71, 0x5a, 0x67, 0xdc, 0xca, 0xd0, 0xe0, 0x56, 0x14, 0xd6,
+	0xd8, 0x04, 0x43, 0x71, 0xdd, 0xa2, 0xbe, 0x4f, 0x6c, 0x7e, 0xe4, 0x8a, 0xc7, 0x5b, 0x9e, 0x4b,
+	0x7c, 0xa6, 0x16, 0x7a, 0x01, 0x26, 0x6c, 0x2e, 0xb0, 0x5c, 0x47, 0x2e, 0x36, 0x23, 0x04, 0x3b,
+	0x8e, 0xe1, 0xc3, 0x62, 0x57, 0x13, 0x72, 0xd5, 0x77, 0x61, 0xd2, 0x6e, 0x0e, 0xcb, 0x7d, 0x9b,
+	0x8f, 0x9f, 0x8d, 0x36, 0x33, 0xf2, 0x88, 0xb4, 0x22, 0x8d, 0x7f, 0x69, 0x30, 0xd5, 0xa6, 0x84,
+	0x16, 0x61, 0xaa, 0xa9, 0xd0, 0xa4, 0xf8, 0x4a, 0x53, 0xb8, 0xe3, 0xb4, 0xaf, 0x61, 0xa8, 0x7d,
+	0x0d, 0xd1, 0x4e, 0x86, 0x0c, 0x33, 0x92, 0x1d, 0x16, 0x3b, 0xc9, 0x3f, 0xd0, 0x2d, 0xd0, 0x6d,
+	0x5a, 0xf7, 0x19, 0x09, 0x6a, 0x38, 0x60, 0xc7, 0x56, 0xfb, 0x24, 0x23, 0x5c, 0x35, 0xdb, 0xaa,
+	0xb1, 0xd5, 0x3a, 0xe1, 0x3a, 0x9c, 0x6b, 0x47, 0x37, 0x66, 0x1f, 0xe5, 0xc8, 0xd9, 0x36, 0xa4,
+	0xf2, 0xe6, 0x95, 0x16, 0x6f, 0xee, 0x63, 0xdf, 0x27, 0xde, 0x56, 0xa4, 0x16, 0x16, 0x8f, 0x1f,
+	0x44, 0x9c, 0xd4, 0x29, 0x71, 0xe1, 0xb5, 0xee, 0x6a, 0xd2, 0xeb, 0x9b, 0x30, 0xc6, 0xa7, 0x51,
+	0x0e, 0x5f, 0x8c, 0x3b, 0x5c, 0xe2, 0x39, 0x8e, 0x1b, 0x51, 0x79, 0x49, 0x00, 0x8d, 0x77, 0xe0,
+	0x4c, 0x4c, 0xa5, 0xe9, 0x30, 0xad, 0xd5, 0x61, 0xb3, 0x30, 0xca, 0x41, 0xdc, 0xbf, 0x23, 0x25,
+	0xf1, 0x61, 0x2c, 0xc3, 0x55, 0xc5, 0xf5, 0xbd, 0x3a, 0x0b, 0x19, 0xf6, 0x1d, 0xd7, 0xaf, 0x6c,
+	0xda, 0x07, 0x51, 0x90, 0x08, 0xcb, 0x6a, 0x59, 0x35, 0x58, 0xea, 0xad, 0x2a, 0x97, 0xf6, 0x2e,
+	0x64, 0x6c, 0x21, 0x52, 0x8b, 0x33, 0xd2, 0x17, 0x17, 0xd9, 0x8f, 0x8e, 0xb4, 0x5c, 0x5b, 0x03,
+	0x69, 0xec, 0xc3, 0xe9, 0x4e, 0x1d, 0x74, 0x1e, 0xc6, 0x6b, 0x34, 0x68, 0x09, 0xf6, 0xb1, 0xe8,
+	0x73, 0xc7, 0x41, 0x97, 0x00, 0x24, 0xb0, 0x19, 0x44, 0x13, 0x52, 0xb2, 0xe3, 0xa0, 0x8b, 0x30,
+	0x11, 0x2a, 0x23, 0xd9, 0xe1, 0x85, 0xe1, 0xa5, 0x91, 0x52, 0x53, 0x60, 0x5c, 0x52, 0xc9, 0x64,
+	0x6b, 0x73, 0x9b, 0x86, 0x6c, 0xd3, 0x16, 0xfe, 0x55, 0x4b, 0x2f, 0xc3, 0xc5, 0xe4, 0x61, 0xb9,
+	0xdc, 0x22, 0x64, 0xb0, 0xdd, 0xb6, 0x97, 0x0b, 0x09, 0x87, 0xa7, 0x0d, 0xac, 0x16, 0xab, 0x70,
+	0xc6, 0x5f, 0x35, 0x98, 0x6e, 0x57, 0x41, 0x85, 0x8e, 0x4c, 0x5d, 0xcc, 0x7e, 0xf9, 0xd9, 0xca,
+	0xac, 0x4c, 0x15, 0x32, 0xad, 0x3f, 0x60, 0x81, 0xeb, 0x57, 0x1a, 0x39, 0x3c, 0x7e, 0xde, 0x86,
+	0x12, 0xce, 0xdb, 0x0d, 0x40, 0x36, 0xf5, 0x59, 0x40, 0x3d, 0x8f, 0x04, 0x96, 0xf2, 0xa7, 0x38,
+	0x5f, 0xa7, 0x9b, 0x23, 0xbb, 0xc2, 0xb3, 0x79, 0x38, 0xdb, 0xa2, 0x6d, 0xef, 0x63, 0xb7, 0xe5,
+	0x8c, 0x9d, 0x69, 0x0e, 0x6d, 0x45, 0x23, 0x3b, 0x8e, 0x91, 0x95, 0x97, 0x65, 0x09, 0x33, 0x72,
+	0xcf, 0xad, 0xba, 0x4d, 0x3f, 0xda, 0x70, 0x3e, 0x36, 0x22, 0x5d, 0xb8, 0x0d, 0x93, 0x01, 0x66,
+	0xc4, 0xf2, 0xb8, 0x58, 0x7a, 0xf1, 0x72, 0xdc, 0x8b, 0x0d, 0x68, 0x14, 0xf0, 0x75, 0x15, 0x33,
+	0x10, 0x34, 0x2c, 0x1a, 0x7f, 0x1b, 0x81, 0x99, 0x0e, 0xad, 0xe4, 0xdb, 0x00, 0x99, 0x30, 0xab,
+	0x42, 0x86, 0x06, 0x56, 0x67, 0x06, 0x3a, 0x23, 0xc7, 0xde, 0x0b, 0x54, 0x02, 0x40, 0x8f, 0xe0,
+	0x74, 0x15, 0x3f, 0xb6, 0x6a, 0x24, 0xb0, 0x23, 0xd5, 0x90, 0xf8, 0xd2, 0x6b, 0x83, 0x65, 0xf8,
+	0xe9, 0x2a, 0x7e, 0xbc, 0x2b, 0x6c, 0x3c, 0x20, 0x7e, 0xcc, 0x6c, 0x40, 0xec, 0xc3, 0xec, 0xc8,
+	0x0b, 0x99, 0x2d, 0x11, 0xfb, 0x10, 0x5d, 0x81, 0x69, 0xf5, 0x0e, 0xb3, 0xf6, 0x69, 0x3d, 0x08,
+	0x79, 0x72, 0x1b, 0x29, 0x4d, 0x29, 0xe9, 0x76, 0x24, 0x6c, 0xb9, 0xf2, 0xc6, 0x5e, 0xca, 0x95,
+	0x37, 0x7e, 0xf2, 0x2b, 0x0f, 0xed, 0xc2, 0x94, 0xda, 0x91, 0x43, 0xec, 0xd5, 0x49, 0x36, 0x33,
+	0xb8, 0xb1, 0x57, 0xa4, 0x85, 0xf7, 0x23, 0x03, 0xe8, 0x9b, 0x30, 0x11, 0x90, 0x90, 0xb0, 0xd0,
+	0x72, 0xfd, 0xec, 0x04, 0x7f, 0xf4, 0xcd, 0xe5, 0xc5, 0x73, 0x34, 0xaf, 0x9e, 0xa3, 0xf9, 0x77,
+	0xa5, 0x43, 0x8a, 0x99, 0x68, 0xa2, 0x5f, 0x7c, 0x3d, 0xaf, 0x95, 0x32, 0x02, 0xb5, 0xe3, 0x1b,
+	0x3f, 0x84, 0x85, 0xf6, 0xa0, 0x8d, 0x1e, 0x0c, 0xdb, 0x6e, 0xc8, 0x68, 0x70, 0xdc, 0xf5, 0xb5,
+	0x31, 0x78, 0x7c, 0xcd, 0xc2, 0x28, 0x8f, 0x7f, 0x1e, 0x54, 0x53, 0x25, 0xf1, 0x61, 0xec, 0xc1,
+	0xe5, 0x2e, 0x04, 0x1a, 0x97, 0xc9, 0xf8, 0x91, 0xeb, 0x3b, 0xf4, 0xa8, 0x9f, 0xb3, 0xf3, 0x5d,
+	0xae, 0x29, 0xcf, 0x8e, 0xc2, 0x19, 0xbf, 0x19, 0x82, 0x99, 0x0e, 0x15, 0xb4, 0x01, 0xc3, 0x51,
+	0x90, 0x8b, 0xd7, 0xb2, 0x1e, 0x73, 0xdc, 0x43, 0xf5, 0x8e, 0x17, 0x9e, 0xfb, 0x28, 0xf2, 0x5c,
+	0x04, 0x68, 0x09, 0xaa, 0xa1, 0x97, 0x12, 0x54, 0xc3, 0x2f, 0x33, 0xa8, 0x46, 0x5e, 0x30, 0xa8,
+	0x8c, 0xcb, 0x30, 0xcf, 0x77, 0xe4, 0x1e, 0x66, 0x24, 0x64, 0x45, 0x8f, 0xda, 0x07, 0x77, 0x71,
+	0xf8, 0x28, 0xc4, 0x95, 0xc6, 0x23, 0xc0, 0x82, 0x85, 0x74, 0x15, 0xb9, 0x67, 0xff, 0x0f, 0xa3,
+	0xf5, 0x48, 0x20, 0xdd, 0x9b, 0xf0, 0xe0, 0x6a, 0xc3, 0xc9, 0xfd, 0x12, 0x18, 0x63, 0x15, 0xb2,
+	0x7c, 0x82, 0x77, 0xa3, 0x50, 0x7b, 0x50, 0xaf, 0x56, 0x71, 0x8f, 0x70, 0x34, 0xbe, 0x0f, 0x73,
+	0x09, 0x08, 0xc9, 0xe5, 0x6d, 0x18, 0x0f, 0x85, 0x48, 0xb2, 0xc9, 0xc5, 0xd9, 0xb4, 0x02, 0x55,
+	0xf0, 0x48, 0x90, 0xf1, 0xdf, 0x21, 0x78, 0xa5, 0x75, 0x3c, 0xe5, 0x48, 0x14, 0x60, 0xdc, 0x0e,
+	0x08, 0x66, 0x34, 0xc8, 0x0e, 0xf5, 0xba, 0xd2, 0xa4, 0x22, 0xca, 0xc3, 0x28, 0x76, 0xaa, 0xae,
+	0x9f, 0x1d, 0xee, 0x81, 0x10, 0x6a, 0xe8, 0x4d, 0x18, 0x0b, 0xeb, 0xb5, 0x9a, 0x77, 0x9c, 0x1d,
+	0x91, 0xe7, 0x3d, 0xb5, 0x00, 0x91, 0xaf, 0x29, 0xa1, 0x8e, 0xde, 0x81, 0x4c, 0x95, 0x30, 0xec,
+	0x60, 0x86, 0x79, 0xaa, 0x9c, 0x2c, 0x5c, 0x6a, 0x42, 0xfd, 0x83, 0x06, 0xf4, 0xbe, 0x54, 0x52,
+	0x77, 0xb8, 0x02, 0xa1, 0xab, 0x30, 0xa3, 0xfe, 0x5b, 0xd1, 0xce, 0x11, 0x87, 0xe7, 0xd4, 0x4c,
+	0x69, 0x5a, 0x89, 0xef, 0x71, 0x69, 0x54, 0xc2, 0x55, 0x5d, 0x9f, 0x59, 0x35, 0x5c, 0x0f, 0x89,
+	0xc3, 0x53, 0x66, 0xa6, 0x04, 0x91, 0x68, 0x97, 0x4b, 0xd0, 0x35, 0x38, 0x53, 0x26, 0x7b, 0x34,
+	0x20, 0xfc, 0x92, 0xb1, 0xf6, 0x29, 0x3d, 0x08, 0xb3, 0x99, 0x85, 0xe1, 0xa5, 0x89, 0xd2, 0x8c,
+	0x18, 0x88, 0x6e, 0x8e, 0xed, 0x48, 0x6c, 0x7c, 0xab, 0x75, 0x5f, 0xc3, 0xe2, 0xf1, 0x66, 0xe4,
+	0x05, 0x15, 0x0a, 0x0d, 0xe7, 0x69, 0x7d, 0x39, 0xcf, 0x58, 0x07, 0x3d, 0xc9, 0x98, 0x8c, 0x92,
+	0x73, 0x30, 0xc6, 0xf7, 0x51, 0x24, 0x99, 0x89, 0x92, 0xfc, 0x32, 0x76, 0xe4, 0xc5, 0xce, 0xb5,
+	0x1f, 0x70, 0x6f, 0x9e, 0x94, 0xc0, 0xcf, 0x35, 0xc8, 0xc6, 0x6d, 0x75, 0x9f, 0x1f, 0xd9, 0x8d,
+	0x2d, 0x1f, 0x7a, 0xf9, 0x35, 0xa7, 0x34, 0x6d, 0x14, 0x5a, 0x5d, 0x73, 0xbb, 0xb4, 0x55, 0x58,
+	0xdd, 0xc5, 0x6e, 0xd0, 0xfd, 0xcc, 0x7d, 0xa8, 0xc1, 0x85, 0x44, 0x90, 0x5c, 0x50, 0x0e, 0x20,
+	0x20, 0x15, 0x37, 0x64, 0x24, 0x20, 0x22, 0xcd, 0x66, 0x4a, 0x2d, 0x12, 0xb4, 0x0c, 0xe2, 0x3d,
+	0x86, 0x6d, 0xd6, 0x28, 0xf8, 0xc5, 0xf5, 0x31, 0xa3, 0xe4, 0xd2, 0x8b, 0x51, 0x5d, 0x4f, 0x7c,
+	0x5c, 0xf6, 0x88, 0x78, 0x93, 0x64, 0x4a, 0xea, 0xd3, 0x58, 0x90, 0x55, 0xfb, 0xc3, 0xc0, 0xad,
+	0xd5, 0x88, 0x73, 0x3f, 0xac, 0x3c, 0x3c, 0xae, 0x91, 0x47, 0xa5, 0x7b, 0x8d, 0x87, 0xd9, 0xdb,
+	0x30, 0x9f, 0xaa, 0x21, 0x99, 0x5e, 0x80, 0x89, 0xc8, 0x29, 0x56, 0x3d, 0xf0, 0x94, 0xf7, 0x33,
+	0x91, 0xe0, 0x51, 0xe0, 0x85, 0xc6, 0xa2, 0xbc, 0xa2, 0xbe, 0x8d, 0x99, 0x7b, 0x48, 0xc4, 0xa6,
+	0x15, 0x03, 0x82, 0x0f, 0x1c, 0x7a, 0xa4, 0x42, 0xd1, 0xf8, 0x78, 0x18, 0x8c, 0x6e, 0x5a, 0x8d,
+	0x9b, 0x6c, 0x94, 0x51, 0x86, 0xbd, 0x93, 0x54, 0xe0, 0x02, 0x19, 0xdd, 0x3e, 0x65, 0xea, 0x3b,
+	0xc4, 0x39, 0xd1, 0xed, 0x23, 0xa0, 0xa8, 0x04, 0xd3, 0x36, 0xad, 0x56, 0xeb, 0xbe, 0xcb, 0x8e,
+	0xad, 0x1a, 0xa5, 0xde, 0x49, 0x2e, 0xa1, 0xa9, 0x86, 0x89, 0x5d, 0x4a, 0x3d, 0x74, 0x0f, 0x26,
+	0xab, 0xd4, 0xa9, 0x7b, 0xc4, 0xda, 0x27, 0x9e, 0x73, 0x92, 0x8b, 0x08, 0x04, 0x7e, 0x9b, 0x78,
+	0x0e, 0xba, 0x0f, 0x93, 0xb6, 0x1b, 0xd8, 0x75, 0x0f, 0x33, 0xd7, 0xaf, 0x64, 0x47, 0x07, 0xb7,
+	0xd6, 0x8a, 0x37, 0x1e, 0xc9, 0x20, 0xb8, 0x43, 0xc8, 0xdd, 0x00, 0xfb, 0x2c, 0xbc, 0x43, 0x03,
+	0xfe, 0x87, 0xa8, 0x5b, 0x2d, 0x4a, 0xdf, 0x15, 0x21, 0xe9, 0x5d, 0x91, 0x48, 0x45, 0xe3, 0x07,
+	0xb0, 0x90, 0x6e, 0x56, 0xee, 0xf9, 0x2d, 0x18, 0xe3, 0xea, 0xea, 0xf1, 0x92, 0x53, 0xe7, 0xb7,
+	0xd1, 0xb7, 0x54, 0x67, 0x98, 0x23, 0x55, 0xde, 0x16, 0x98, 0xc2, 0xcf, 0xe6, 0x60, 0x94, 0x4f,
+	0x81, 0x8e, 0x60, 0x4c, 0xf4, 0xef, 0xd0, 0x6b, 0xf1, 0xeb, 0x2b, 0xde, 0x26, 0xd4, 0xaf, 0xf4,
+	0xd0, 0x12, 0xf4, 0x8c, 0x85, 0x0f, 0xff, 0xfe, 0x9f, 0x9f, 0x0e, 0xe9, 0x28, 0x6b, 0xa6, 0xf4,
+	0x22, 0xd1, 0x4f, 0x34, 0x80, 0x66, 0xa3, 0x0f, 0x2d, 0xa5, 0xd8, 0x8d, 0xf5, 0x0f, 0xf5, 0xe5,
+	0x3e, 0x34, 0x25, 0x0b, 0x93, 0xb3, 0x58, 0x46, 0x57, 0xe3, 0x2c, 0x5a, 0xfa, 0x81, 0xe6, 0x13,
+	0xf9, 0xe7, 0x29, 0xfa, 0x44, 0x83, 0xa9, 0xb6, 0x1e, 0x20, 0xba, 0x9e, 0x32, 0x5b, 0x52, 0x9f,
+	0x51, 0xbf, 0xd1, 0x9f, 0xb2, 0x64, 0xb7, 0xc1, 0xd9, 0xad, 0xa2, 0x7c, 0x9c, 0x5d, 0x99, 0x03,
+	0x9a, 0x04, 0x5b, 0xd8, 0x3e, 0x45, 0xbf, 0xd6, 0xe0, 0x4c, 0xac, 0x9d, 0x88, 0xcc, 0x94, 0xb9,
+	0xd3, 0x5a, 0x93, 0xfa, 0x6a, 0xff, 0x00, 0x49, 0x78, 0x85, 0x13, 0xbe, 0x8a, 0xae, 0xc4, 0x09,
+	0xbb, 0x65, 0xdb, 0x24, 0x1c, 0x65, 0xa9, 0x7e, 0x23, 0xfa, 0x58, 0x83, 0x99, 0x8e, 0xae, 0x21,
+	0x5a, 0x49, 0x9f, 0x34, 0xa1, 0x27, 0xa9, 0xe7, 0xfb, 0x55, 0x97, 0x0c, 0xaf, 0x73, 0x86, 0x57,
+	0xd0, 0x62, 0x32, 0x43, 0x26, 0x31, 0x16, 0x7f, 0xe5, 0xfe, 0x45, 0x83, 0x73, 0xc9, 0x6d, 0x3e,
+	0xb4, 0x9e, 0x3e, 0x6f, 0x7a, 0x63, 0x51, 0x7f, 0x63, 0x40, 0x94, 0x24, 0xfd, 0x0d, 0x4e, 0xfa,
+	0x4d, 0xf4, 0x46, 0x32, 0x69, 0x51, 0x05, 0x85, 0xe6, 0x93, 0x46, 0x39, 0xf4, 0xd4, 0x6c, 0xe9,
+	0x20, 0xa2, 0x3f, 0x69, 0x70, 0x3e, 0xa5, 0x71, 0x86, 0xba, 0x31, 0x4a, 0xef, 0xc7, 0xe9, 0x1b,
+	0x83, 0xc2, 0xe4, 0x4a, 0x6e, 0xf0, 0x95, 0xbc, 0x8e, 0x5e, 0x4b, 0x59, 0x89, 0xac, 0x29, 0x44,
+	0xff, 0x26, 0xf2, 0xff, 0x85, 0x2e, 0xad, 0x31, 0xf4, 0x7f, 0xe9, 0x2c, 0x7a, 0x74, 0xde, 0xf4,
+	0xb7, 0x4e, 0x02, 0x95, 0x8b, 0xc8, 0xf3, 0x45, 0x2c, 0xa1, 0xd7, 0x93, 0x17, 0x41, 0x9b, 0x78,
+	0x0b, 0xdb, 0x07, 0x21, 0xfa, 0x55, 0x14, 0xe6, 0xed, 0x6d, 0xae, 0xf4, 0x30, 0x4f, 0xec, 0x96,
+	0xe9, 0xf9, 0x7e, 0xd5, 0x7b, 0xe7, 0xb5, 0x88, 0xa2, 0x6b, 0x63, 0x6b, 0x9f, 0x86, 0xcc, 0x52,
+	0xad, 0x32, 0xf4, 0x63, 0x0d, 0xa0, 0xd9, 0x42, 0x4a, 0x4d, 0xb6, 0xb1, 0xfe, 0x93, 0xbe, 0xdc,
+	0x87, 0xa6, 0x24, 0xb5, 0xcc, 0x49, 0x2d, 0xa2, 0xcb, 0xc9, 0xa4, 0x5a, 0x7a, 0x55, 0xe8, 0xcf,
+	0x1a, 0xcc, 0x26, 0xd5, 0xe6, 0xa8, 0xd0, 0x6b, 0xba, 0x78, 0x27, 0x41, 0xbf, 0x39, 0x10, 0xa6,
+	0x77, 0xee, 0xed, 0x20, 0x6b, 0x46, 0xb9, 0xc2, 0xda, 0x97, 0x04, 0x7f, 0xaf, 0xc1, 0xd9, 0x84,
+	0x02, 0x15, 0xad, 0xa5, 0x90, 0x48, 0xaf, 0x77, 0xf5, 0xc2, 0x20, 0x10, 0x49, 0x7b, 0x95, 0xd3,
+	0xbe, 0x86, 0x96, 0x12, 0xae, 0x8c, 0x08, 0x60, 0x55, 0x70, 0x68, 0xf1, 0x6a, 0xd7, 0xf4, 0xb8,
+	0x99, 0x28, 0x09, 0xb7, 0x57, 0x99, 0xd7, 0x52, 0xa6, 0x4d, 0xa8, 0x8a, 0xf5, 0xeb, 0x7d, 0xe9,
+	0x4a, 0x6e, 0xeb, 0x9c, 0x5b, 0x1e, 0xdd, 0x88, 0x73, 0x63, 0xf4, 0x80, 0xf8, 0x7b, 0xd8, 0x8e,
+	0x5c, 0x68, 0xf2, 0x67, 0xbe, 0x25, 0xab, 0x60, 0xf4, 0x5b, 0x0d, 0xa6, 0xda, 0x2a, 0x27, 0xd4,
+	0x75, 0xd2, 0x8e, 0x62, 0x4d, 0xbf, 0xd1, 0x9f, 0xb2, 0xa4, 0x78, 0x8b, 0x53, 0xdc, 0x40, 0xeb,
+	0x3d, 0x28, 0xf2, 0xba, 0x8a, 0xbf, 0x0b, 0xaa, 0xae, 0xff, 0xd4, 0x94, 0x25, 0xd3, 0x27, 0x1a,
+	0x4c, 0xb6, 0x94, 0x58, 0x28, 0xed, 0x6c, 0xc4, 0x4b, 0x3a, 0xfd, 0x5a, 0x3f, 0xaa, 0x2f, 0x46,
+	0x52, 0x56, 0xe4, 0xbf, 0xd4, 0x60, 0xba, 0xbd, 0x72, 0x42, 0x5d, 0x7d, 0xd4, 0x59, 0x95, 0xe9,
+	0x2b, 0x7d, 0x6a, 0x4b, 0xb6, 0x6b, 0x9c, 0xed, 0x75, 0xb4, 0xdc, 0x83, 0x2d, 0x09, 0xec, 0xc2,
+	0xaa, 0x55, 0x8b, 0xf8, 0xfc, 0x41, 0x03, 0x14, 0x2f, 0x9b, 0x50, 0xda, 0x7b, 0x24, 0xb5, 0x06,
+	0xd3, 0xd7, 0x06, 0x40, 0x48, 0xba, 0x6f, 0x72, 0xba, 0x6b, 0xc8, 0x8c, 0xd3, 0xe5, 0x0f, 0x7b,
+	0x97, 0x99, 0x4c, 0xa0, 0xad, 0x6a, 0x58, 0xb1, 0x1a, 0xf5, 0x1b, 0xfa, 0xa3, 0x06, 0xaf, 0x26,
+	0x56, 0x61, 0x28, 0x2d, 0xff, 0x74, 0xab, 0xec, 0xf4, 0xf5, 0xc1, 0x40, 0xbd, 0x9d, 0xed, 0x73,
+	0xa0, 0x25, 0xa2, 0xc0, 0x2a, 0x37, 0xd8, 0xfd, 0x4e, 0x83, 0xb3, 0x09, 0x75, 0x44, 0x6a, 0xc2,
+	0x4a, 0x2f, 0x65, 0xf4, 0xc2, 0x20, 0x90, 0xde, 0x97, 0xe9, 0x1e, 0x21, 0x96, 0x28, 0x47, 0xcc,
+	0x27, 0x15, 0x01, 0x7b, 0x5a, 0x34, 0x3f, 0x7f, 0x96, 0xd3, 0xbe, 0x78, 0x96, 0xd3, 0xfe, 0xfd,
+	0x2c, 0xa7, 0x7d, 0xf4, 0x3c, 0x77, 0xea, 0x8b, 0xe7, 0xb9, 0x53, 0xff, 0x78, 0x9e, 0x3b, 0xf5,
+	0xbd, 0x57, 0x25, 0xf2, 0xb1, 0x32, 0x11, 0xed, 0x4d, 0x58, 0x1e, 0xe3, 0x9d, 0xd5, 0x9b, 0xff,
+	0x1b, 0x00, 0x8e, 0x2c, 0x83, 0xe6, 0x38, 0x22, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns the Kudora params.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// EVMAddress returns the 0x EVM representation of a bech32 account address.
+	EVMAddress(ctx context.Context, in *QueryEVMAddressRequest, opts ...grpc.CallOption) (*QueryEVMAddressResponse, error)
+	// Bech32Address returns the bech32 account address of a 0x EVM address.
+	Bech32Address(ctx context.Context, in *QueryBech32AddressRequest, opts ...grpc.CallOption) (*QueryBech32AddressResponse, error)
+	// IBCEscrowBalances returns the coins held by the transfer escrow accounts
+	// of all IBC classic channels and IBC v2 clients, summed per denom.
+	IBCEscrowBalances(ctx context.Context, in *QueryIBCEscrowBalancesRequest, opts ...grpc.CallOption) (*QueryIBCEscrowBalancesResponse, error)
+	// IBCTransferFlow returns the cumulative amounts of a denom received and
+	// sent over IBC since the flow tracking was added.
+	IBCTransferFlow(ctx context.Context, in *QueryIBCTransferFlowRequest, opts ...grpc.CallOption) (*QueryIBCTransferFlowResponse, error)
+	// IBCConnectionsByClient returns the IBC connections built on top of a light
+	// client, in any state.
+	IBCConnectionsByClient(ctx context.Context, in *QueryIBCConnectionsByClientRequest, opts ...grpc.CallOption) (*QueryIBCConnectionsByClientResponse, error)
+	// IBCChannelCountsByState returns the number of IBC channels of this chain
+	// in each state other than UNINITIALIZED.
+	IBCChannelCountsByState(ctx context.Context, in *QueryIBCChannelCountsByStateRequest, opts ...grpc.CallOption) (*QueryIBCChannelCountsByStateResponse, error)
+	// IBCOutstandingAcksByChannel returns the sequences of the received packets
+	// with a written acknowledgement, grouped by channel. Acknowledgements stay
+	// in state once relayed, so relayers check the listed sequences against the
+	// packet commitments left on the counterparty to find the acks still to
+	// relay.
+	IBCOutstandingAcksByChannel(ctx context.Context, in *QueryIBCOutstandingAcksByChannelRequest, opts ...grpc.CallOption) (*QueryIBCOutstandingAcksByChannelResponse, error)
+	// ICAHostAccounts returns every interchain account registered on this chain
+	// by a controller chain, to audit which chains control accounts here.
+	ICAHostAccounts(ctx context.Context, in *QueryICAHostAccountsRequest, opts ...grpc.CallOption) (*QueryICAHostAccountsResponse, error)
+	// RateLimits returns the status of every configured IBC rate limit, ordered
+	// by denom and channel or client ID, with the time left until their window
+	// resets.
+	RateLimits(ctx context.Context, in *QueryRateLimitsRequest, opts ...grpc.CallOption) (*QueryRateLimitsResponse, error)
+	// RateLimitFlowHistory returns the flows of the last windows of the IBC
+	// rate limit of a denom on a channel or client, oldest first.
+	RateLimitFlowHistory(ctx context.Context, in *QueryRateLimitFlowHistoryRequest, opts ...grpc.CallOption) (*QueryRateLimitFlowHistoryResponse, error)
+	// LatestBlockGasUsage returns the gas used and wanted by the transactions of
+	// the last block, failed ones included.
+	LatestBlockGasUsage(ctx context.Context, in *QueryLatestBlockGasUsageRequest, opts ...grpc.CallOption) (*QueryLatestBlockGasUsageResponse, error)
+	// DenomSummary returns the creator, admin, supply, metadata, lock and pause
+	// flags and before-send hooks of a tokenfactory denom at once.
+	DenomSummary(ctx context.Context, in *QueryDenomSummaryRequest, opts ...grpc.CallOption) (*QueryDenomSummaryResponse, error)
+	// DenomsByAdmin returns the tokenfactory denoms currently administered by
+	// an account, whoever created them.
+	DenomsByAdmin(ctx context.Context, in *QueryDenomsByAdminRequest, opts ...grpc.CallOption) (*QueryDenomsByAdminResponse, error)
+	// AdminSupply returns the tokenfactory denoms administered by an account
+	// and their total supply, to assess how much a single controller can mint
+	// or burn.
+	AdminSupply(ctx context.Context, in *QueryAdminSupplyRequest, opts ...grpc.CallOption) (*QueryAdminSupplyResponse, error)
+	// DenomERC20Pair returns whether a tokenfactory denom has an ERC20
+	// representation, and if so its contract address and whether conversions
+	// are enabled.
+	DenomERC20Pair(ctx context.Context, in *QueryDenomERC20PairRequest, opts ...grpc.CallOption) (*QueryDenomERC20PairResponse, error)
+	// TrippedMsgTypeURLs returns the type URLs of the messages currently
+	// disabled by the circuit breaker, in lexical order.
+	TrippedMsgTypeURLs(ctx context.Context, in *QueryTrippedMsgTypeURLsRequest, opts ...grpc.CallOption) (*QueryTrippedMsgTypeURLsResponse, error)
+	// NativeSupplyBreakdown returns the total supply of the base denom split by
+	// holder.
+	NativeSupplyBreakdown(ctx context.Context, in *QueryNativeSupplyBreakdownRequest, opts ...grpc.CallOption) (*QueryNativeSupplyBreakdownResponse, error)
+	// FeeGrantsForGrantee returns the unexpired fee grants, with their
+	// allowances, of which an account is the grantee.
+	FeeGrantsForGrantee(ctx context.Context, in *QueryFeeGrantsForGranteeRequest, opts ...grpc.CallOption) (*QueryFeeGrantsForGranteeResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/kudora.kudora.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EVMAddress(ctx context.Context, in *QueryEVMAddressRequest, opts ...grpc.CallOption) (*QueryEVMAddressResponse, error) {
+	out := new(QueryEVMAddressResponse)
+	err := c.cc.Invoke(ctx, "/kudora.kudora.v1.Query/EVMAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Bech32Address(ctx context.Context, in *QueryBech32AddressRequest, opts ...grpc.CallOption) (*QueryBech32AddressResponse, error) {
+	out := new(QueryBech32AddressResponse)
+	err := c.cc.Invoke(ctx, "/kudora.kudora.v1.Query/Bech32Address", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IBCEscrowBalances(ctx context.Context, in *QueryIBCEscrowBalancesRequest, opts ...grpc.CallOption) (*QueryIBCEscrowBalancesResponse, error) {
+	out := new(QueryIBCEscrowBalancesResponse)
+	err := c.cc.Invoke(ctx, "/kudora.kudora.v1.Query/IBCEscrowBalances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IBCTransferFlow(ctx context.Context, in *QueryIBCTransferFlowRequest, opts ...grpc.CallOption) (*QueryIBCTransferFlowResponse, error) {
+	out := new(QueryIBCTransferFlowResponse)
+	err := c.cc.Invoke(ctx, "/kudora.kudora.v1.Query/IBCTransferFlow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IBCConnectionsByClient(ctx context.Context, in *QueryIBCConnectionsByClientRequest, opts ...grpc.CallOption) (*QueryIBCConnectionsByClientResponse, error) {
+	out := new(QueryIBCConnectionsByClientResponse)
+	err := c.cc.Invoke(ctx, "/kudora.kudora.v1.Query/IBCConnectionsByClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IBCChannelCountsByState(ctx context.Context, in *QueryIBCChannelCountsByStateRequest, opts ...grpc.CallOption) (*QueryIBCChannelCountsByStateResponse, error) {
+	out := new(QueryIBCChannelCountsByStateResponse)
+	err := c.cc.Invoke(ctx, "/kudora.kudora.v1.Query/IBCChannelCountsByState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IBCOutstandingAcksByChannel(ctx context.Context, in *QueryIBCOutstandingAcksByChannelRequest, opts ...grpc.CallOption) (*QueryIBCOutstandingAcksByChannelResponse, error) {
+	out := new(QueryIBCOutstandingAcksByChannelResponse)
+	err := c.cc.Invoke(ctx, "/kudora.kudora.v1.Query/IBCOutstandingAcksByChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ICAHostAccounts(ctx context.Context, in *QueryICAHostAccountsRequest, opts ...grpc.CallOption) (*QueryICAHostAccountsResponse, error) {
+	out := new(QueryICAHostAccountsResponse)
+	err := c.cc.Invoke(ctx, "/kudora.kudora.v1.Query/ICAHostAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RateLimits(ctx context.Context, in *QueryRateLimitsRequest, opts ...grpc.CallOption) (*QueryRateLimitsResponse, error) {
+	out := new(QueryRateLimitsResponse)
+	err := c.cc.Invoke(ctx, "/kudora.kudora.v1.Query/RateLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RateLimitFlowHistory(ctx context.Context, in *QueryRateLimitFlowHistoryRequest, opts ...grpc.CallOption) (*QueryRateLimitFlowHistoryResponse, error) {
+	out := new(QueryRateLimitFlowHistoryResponse)
+	err := c.cc.Invoke(ctx, "/kudora.kudora.v1.Query/RateLimitFlowHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LatestBlockGasUsage(ctx context.Context, in *QueryLatestBlockGasUsageRequest, opts ...grpc.CallOption) (*QueryLatestBlockGasUsageResponse, error) {
+	out := new(QueryLatestBlockGasUsageResponse)
+	err := c.cc.Invoke(ctx, "/kudora.kudora.v1.Query/LatestBlockGasUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomSummary(ctx context.Context, in *QueryDenomSummaryRequest, opts ...grpc.CallOption) (*QueryDenomSummaryResponse, error) {
+	out := new(QueryDenomSummaryResponse)
+	err := c.cc.Invoke(ctx, "/kudora.kudora.v1.Query/DenomSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomsByAdmin(ctx context.Context, in *QueryDenomsByAdminRequest, opts ...grpc.CallOption) (*QueryDenomsByAdminResponse, error) {
+	out := new(QueryDenomsByAdminResponse)
+	err := c.cc.Invoke(ctx, "/kudora.kudora.v1.Query/DenomsByAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AdminSupply(ctx context.Context, in *QueryAdminSupplyRequest, opts ...grpc.CallOption) (*QueryAdminSupplyResponse, error) {
+	out := new(QueryAdminSupplyResponse)
+	err := c.cc.Invoke(ctx, "/kudora.kudora.v1.Query/AdminSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomERC20Pair(ctx context.Context, in *QueryDenomERC20PairRequest, opts ...grpc.CallOption) (*QueryDenomERC20PairResponse, error) {
+	out := new(QueryDenomERC20PairResponse)
+	err := c.cc.Invoke(ctx, "/kudora.kudora.v1.Query/DenomERC20Pair", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TrippedMsgTypeURLs(ctx context.Context, in *QueryTrippedMsgTypeURLsRequest, opts ...grpc.CallOption) (*QueryTrippedMsgTypeURLsResponse, error) {
+	out := new(QueryTrippedMsgTypeURLsResponse)
+	err := c.cc.Invoke(ctx, "/kudora.kudora.v1.Query/TrippedMsgTypeURLs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NativeSupplyBreakdown(ctx context.Context, in *QueryNativeSupplyBreakdownRequest, opts ...grpc.CallOption) (*QueryNativeSupplyBreakdownResponse, error) {
+	out := new(QueryNativeSupplyBreakdownResponse)
+	err := c.cc.Invoke(ctx, "/kudora.kudora.v1.Query/NativeSupplyBreakdown", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeGrantsForGrantee(ctx context.Context, in *QueryFeeGrantsForGranteeRequest, opts ...grpc.CallOption) (*QueryFeeGrantsForGranteeResponse, error) {
+	out := new(QueryFeeGrantsForGranteeResponse)
+	err := c.cc.Invoke(ctx, "/kudora.kudora.v1.Query/FeeGrantsForGrantee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params returns the Kudora params.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// EVMAddress returns the 0x EVM representation of a bech32 account address.
+	EVMAddress(context.Context, *QueryEVMAddressRequest) (*QueryEVMAddressResponse, error)
+	// Bech32Address returns the bech32 account address of a 0x EVM address.
+	Bech32Address(context.Context, *QueryBech32AddressRequest) (*QueryBech32AddressResponse, error)
+	// IBCEscrowBalances returns the coins held by the transfer escrow accounts
+	// of all IBC classic channels and IBC v2 clients, summed per denom.
+	IBCEscrowBalances(context.Context, *QueryIBCEscrowBalancesRequest) (*QueryIBCEscrowBalancesResponse, error)
+	// IBCTransferFlow returns the cumulative amounts of a denom received and
+	// sent over IBC since the flow tracking was added.
+	IBCTransferFlow(context.Context, *QueryIBCTransferFlowRequest) (*QueryIBCTransferFlowResponse, error)
+	// IBCConnectionsByClient returns the IBC connections built on top of a light
+	// client, in any state.
+	IBCConnectionsByClient(context.Context, *QueryIBCConnectionsByClientRequest) (*QueryIBCConnectionsByClientResponse, error)
+	// IBCChannelCountsByState returns the number of IBC channels of this chain
+	// in each state other than UNINITIALIZED.
+	IBCChannelCountsByState(context.Context, *QueryIBCChannelCountsByStateRequest) (*QueryIBCChannelCountsByStateResponse, error)
+	// IBCOutstandingAcksByChannel returns the sequences of the received packets
+	// with a written acknowledgement, grouped by channel. Acknowledgements stay
+	// in state once relayed, so relayers check the listed sequences against the
+	// packet commitments left on the counterparty to find the acks still to
+	// relay.
+	IBCOutstandingAcksByChannel(context.Context, *QueryIBCOutstandingAcksByChannelRequest) (*QueryIBCOutstandingAcksByChannelResponse, error)
+	// ICAHostAccounts returns every interchain account registered on this chain
+	// by a controller chain, to audit which chains control accounts here.
+	ICAHostAccounts(context.Context, *QueryICAHostAccountsRequest) (*QueryICAHostAccountsResponse, error)
+	// RateLimits returns the status of every configured IBC rate limit, ordered
+	// by denom and channel or client ID, with the time left until their window
+	// resets.
+	RateLimits(context.Context, *QueryRateLimitsRequest) (*QueryRateLimitsResponse, error)
+	// RateLimitFlowHistory returns the flows of the last windows of the IBC
+	// rate limit of a denom on a channel or client, oldest first.
+	RateLimitFlowHistory(context.Context, *QueryRateLimitFlowHistoryRequest) (*QueryRateLimitFlowHistoryResponse, error)
+	// LatestBlockGasUsage returns the gas used and wanted by the transactions of
+	// the last block, failed ones included.
+	LatestBlockGasUsage(context.Context, *QueryLatestBlockGasUsageRequest) (*QueryLatestBlockGasUsageResponse, error)
+	// DenomSummary returns the creator, admin, supply, metadata, lock and pause
+	// flags and before-send hooks of a tokenfactory denom at once.
+	DenomSummary(context.Context, *QueryDenomSummaryRequest) (*QueryDenomSummaryResponse, error)
+	// DenomsByAdmin returns the tokenfactory denoms currently administered by
+	// an account, whoever created them.
+	DenomsByAdmin(context.Context, *QueryDenomsByAdminRequest) (*QueryDenomsByAdminResponse, error)
+	// AdminSupply returns the tokenfactory denoms administered by an account
+	// and their total supply, to assess how much a single controller can mint
+	// or burn.
+	AdminSupply(context.Context, *QueryAdminSupplyRequest) (*QueryAdminSupplyResponse, error)
+	// DenomERC20Pair returns whether a tokenfactory denom has an ERC20
+	// representation, and if so its contract address and whether conversions
+	// are enabled.
+	DenomERC20Pair(context.Context, *QueryDenomERC20PairRequest) (*QueryDenomERC20PairResponse, error)
+	// TrippedMsgTypeURLs returns the type URLs of the messages currently
+	// disabled by the circuit breaker, in lexical order.
+	TrippedMsgTypeURLs(context.Context, *QueryTrippedMsgTypeURLsRequest) (*QueryTrippedMsgTypeURLsResponse, error)
+	// NativeSupplyBreakdown returns the total supply of the base denom split by
+	// holder.
+	NativeSupplyBreakdown(context.Context, *QueryNativeSupplyBreakdownRequest) (*QueryNativeSupplyBreakdownResponse, error)
+	// FeeGrantsForGrantee returns the unexpired fee grants, with their
+	// allowances, of which an account is the grantee.
+	FeeGrantsForGrantee(context.Context, *QueryFeeGrantsForGranteeRequest) (*QueryFeeGrantsForGranteeResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) EVMAddress(ctx context.Context, req *QueryEVMAddressRequest) (*QueryEVMAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EVMAddress not implemented")
+}
+func (*UnimplementedQueryServer) Bech32Address(ctx context.Context, req *QueryBech32AddressRequest) (*QueryBech32AddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Bech32Address not implemented")
+}
+func (*UnimplementedQueryServer) IBCEscrowBalances(ctx context.Context, req *QueryIBCEscrowBalancesRequest) (*QueryIBCEscrowBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IBCEscrowBalances not implemented")
+}
+func (*UnimplementedQueryServer) IBCTransferFlow(ctx context.Context, req *QueryIBCTransferFlowRequest) (*QueryIBCTransferFlowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IBCTransferFlow not implemented")
+}
+func (*UnimplementedQueryServer) IBCConnectionsByClient(ctx context.Context, req *QueryIBCConnectionsByClientRequest) (*QueryIBCConnectionsByClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IBCConnectionsByClient not implemented")
+}
+func (*UnimplementedQueryServer) IBCChannelCountsByState(ctx context.Context, req *QueryIBCChannelCountsByStateRequest) (*QueryIBCChannelCountsByStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IBCChannelCountsByState not implemented")
+}
+func (*UnimplementedQueryServer) IBCOutstandingAcksByChannel(ctx context.Context, req *QueryIBCOutstandingAcksByChannelRequest) (*QueryIBCOutstandingAcksByChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IBCOutstandingAcksByChannel not implemented")
+}
+func (*UnimplementedQueryServer) ICAHostAccounts(ctx context.Context, req *QueryICAHostAccountsRequest) (*QueryICAHostAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ICAHostAccounts not implemented")
+}
+func (*UnimplementedQueryServer) RateLimits(ctx context.Context, req *QueryRateLimitsRequest) (*QueryRateLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimits not implemented")
+}
+func (*UnimplementedQueryServer) RateLimitFlowHistory(ctx context.Context, req *QueryRateLimitFlowHistoryRequest) (*QueryRateLimitFlowHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimitFlowHistory not implemented")
+}
+func (*UnimplementedQueryServer) LatestBlockGasUsage(ctx context.Context, req *QueryLatestBlockGasUsageRequest) (*QueryLatestBlockGasUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestBlockGasUsage not implemented")
+}
+func (*UnimplementedQueryServer) DenomSummary(ctx context.Context, req *QueryDenomSummaryRequest) (*QueryDenomSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomSummary not implemented")
+}
+func (*UnimplementedQueryServer) DenomsByAdmin(ctx context.Context, req *QueryDenomsByAdminRequest) (*QueryDenomsByAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomsByAdmin not implemented")
+}
+func (*UnimplementedQueryServer) AdminSupply(ctx context.Context, req *QueryAdminSupplyRequest) (*QueryAdminSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminSupply not implemented")
+}
+func (*UnimplementedQueryServer) DenomERC20Pair(ctx context.Context, req *QueryDenomERC20PairRequest) (*QueryDenomERC20PairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomERC20Pair not implemented")
+}
+func (*UnimplementedQueryServer) TrippedMsgTypeURLs(ctx context.Context, req *QueryTrippedMsgTypeURLsRequest) (*QueryTrippedMsgTypeURLsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TrippedMsgTypeURLs not implemented")
+}
+func (*UnimplementedQueryServer) NativeSupplyBreakdown(ctx context.Context, req *QueryNativeSupplyBreakdownRequest) (*QueryNativeSupplyBreakdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NativeSupplyBreakdown not implemented")
+}
+func (*UnimplementedQueryServer) FeeGrantsForGrantee(ctx context.Context, req *QueryFeeGrantsForGranteeRequest) (*QueryFeeGrantsForGranteeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeGrantsForGrantee not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.kudora.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EVMAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEVMAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EVMAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.kudora.v1.Query/EVMAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EVMAddress(ctx, req.(*QueryEVMAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Bech32Address_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBech32AddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Bech32Address(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.kudora.v1.Query/Bech32Address",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Bech32Address(ctx, req.(*QueryBech32AddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IBCEscrowBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIBCEscrowBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IBCEscrowBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.kudora.v1.Query/IBCEscrowBalances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IBCEscrowBalances(ctx, req.(*QueryIBCEscrowBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IBCTransferFlow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIBCTransferFlowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IBCTransferFlow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.kudora.v1.Query/IBCTransferFlow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IBCTransferFlow(ctx, req.(*QueryIBCTransferFlowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IBCConnectionsByClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIBCConnectionsByClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IBCConnectionsByClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.kudora.v1.Query/IBCConnectionsByClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IBCConnectionsByClient(ctx, req.(*QueryIBCConnectionsByClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IBCChannelCountsByState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIBCChannelCountsByStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IBCChannelCountsByState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.kudora.v1.Query/IBCChannelCountsByState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IBCChannelCountsByState(ctx, req.(*QueryIBCChannelCountsByStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IBCOutstandingAcksByChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIBCOutstandingAcksByChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IBCOutstandingAcksByChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.kudora.v1.Query/IBCOutstandingAcksByChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IBCOutstandingAcksByChannel(ctx, req.(*QueryIBCOutstandingAcksByChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ICAHostAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryICAHostAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ICAHostAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.kudora.v1.Query/ICAHostAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ICAHostAccounts(ctx, req.(*QueryICAHostAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.kudora.v1.Query/RateLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimits(ctx, req.(*QueryRateLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RateLimitFlowHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimitFlowHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimitFlowHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.kudora.v1.Query/RateLimitFlowHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimitFlowHistory(ctx, req.(*QueryRateLimitFlowHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LatestBlockGasUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestBlockGasUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LatestBlockGasUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.kudora.v1.Query/LatestBlockGasUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestBlockGasUsage(ctx, req.(*QueryLatestBlockGasUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.kudora.v1.Query/DenomSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomSummary(ctx, req.(*QueryDenomSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomsByAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomsByAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomsByAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.kudora.v1.Query/DenomsByAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomsByAdmin(ctx, req.(*QueryDenomsByAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AdminSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAdminSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AdminSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.kudora.v1.Query/AdminSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AdminSupply(ctx, req.(*QueryAdminSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomERC20Pair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomERC20PairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomERC20Pair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.kudora.v1.Query/DenomERC20Pair",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomERC20Pair(ctx, req.(*QueryDenomERC20PairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TrippedMsgTypeURLs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTrippedMsgTypeURLsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TrippedMsgTypeURLs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.kudora.v1.Query/TrippedMsgTypeURLs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TrippedMsgTypeURLs(ctx, req.(*QueryTrippedMsgTypeURLsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NativeSupplyBreakdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNativeSupplyBreakdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NativeSupplyBreakdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.kudora.v1.Query/NativeSupplyBreakdown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NativeSupplyBreakdown(ctx, req.(*QueryNativeSupplyBreakdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeGrantsForGrantee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeGrantsForGranteeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeGrantsForGrantee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.kudora.v1.Query/FeeGrantsForGrantee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeGrantsForGrantee(ctx, req.(*QueryFeeGrantsForGranteeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kudora.kudora.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "EVMAddress",
+			Handler:    _Query_EVMAddress_Handler,
+		},
+		{
+			MethodName: "Bech32Address",
+			Handler:    _Query_Bech32Address_Handler,
+		},
+		{
+			MethodName: "IBCEscrowBalances",
+			Handler:    _Query_IBCEscrowBalances_Handler,
+		},
+		{
+			MethodName: "IBCTransferFlow",
+			Handler:    _Query_IBCTransferFlow_Handler,
+		},
+		{
+			MethodName: "IBCConnectionsByClient",
+			Handler:    _Query_IBCConnectionsByClient_Handler,
+		},
+		{
+			MethodName: "IBCChannelCountsByState",
+			Handler:    _Query_IBCChannelCountsByState_Handler,
+		},
+		{
+			MethodName: "IBCOutstandingAcksByChannel",
+			Handler:    _Query_IBCOutstandingAcksByChannel_Handler,
+		},
+		{
+			MethodName: "ICAHostAccounts",
+			Handler:    _Query_ICAHostAccounts_Handler,
+		},
+		{
+			MethodName: "RateLimits",
+			Handler:    _Query_RateLimits_Handler,
+		},
+		{
+			MethodName: "RateLimitFlowHistory",
+			Handler:    _Query_RateLimitFlowHistory_Handler,
+		},
+		{
+			MethodName: "LatestBlockGasUsage",
+			Handler:    _Query_LatestBlockGasUsage_Handler,
+		},
+		{
+			MethodName: "DenomSummary",
+			Handler:    _Query_DenomSummary_Handler,
+		},
+		{
+			MethodName: "DenomsByAdmin",
+			Handler:    _Query_DenomsByAdmin_Handler,
+		},
+		{
+			MethodName: "AdminSupply",
+			Handler:    _Query_AdminSupply_Handler,
+		},
+		{
+			MethodName: "DenomERC20Pair",
+			Handler:    _Query_DenomERC20Pair_Handler,
+		},
+		{
+			MethodName: "TrippedMsgTypeURLs",
+			Handler:    _Query_TrippedMsgTypeURLs_Handler,
+		},
+		{
+			MethodName: "NativeSupplyBreakdown",
+			Handler:    _Query_NativeSupplyBreakdown_Handler,
+		},
+		{
+			MethodName: "FeeGrantsForGrantee",
+			Handler:    _Query_FeeGrantsForGrantee_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "kudora/kudora/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEVMAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEVMAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEVMAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEVMAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEVMAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEVMAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvmAddress) > 0 {
+		i -= len(m.EvmAddress)
+		copy(dAtA[i:], m.EvmAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBech32AddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBech32AddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBech32AddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvmAddress) > 0 {
+		i -= len(m.EvmAddress)
+		copy(dAtA[i:], m.EvmAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBech32AddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBech32AddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBech32AddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIBCEscrowBalancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIBCEscrowBalancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIBCEscrowBalancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIBCEscrowBalancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIBCEscrowBalancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIBCEscrowBalancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIBCTransferFlowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIBCTransferFlowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIBCTransferFlowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIBCTransferFlowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIBCTransferFlowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIBCTransferFlowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Outflow.Size()
+		i -= size
+		if _, err := m.Outflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Inflow.Size()
+		i -= size
+		if _, err := m.Inflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIBCConnectionsByClientRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIBCConnectionsByClientRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIBCConnectionsByClientRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIBCConnectionsByClientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIBCConnectionsByClientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIBCConnectionsByClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Connections) > 0 {
+		for iNdEx := len(m.Connections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Connections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IBCConnection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IBCConnection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IBCConnection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CounterpartyClientId) > 0 {
+		i -= len(m.CounterpartyClientId)
+		copy(dAtA[i:], m.CounterpartyClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CounterpartyClientId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CounterpartyConnectionId) > 0 {
+		i -= len(m.CounterpartyConnectionId)
+		copy(dAtA[i:], m.CounterpartyConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CounterpartyConnectionId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIBCChannelCountsByStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIBCChannelCountsByStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIBCChannelCountsByStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIBCChannelCountsByStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIBCChannelCountsByStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIBCChannelCountsByStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Counts) > 0 {
+		for iNdEx := len(m.Counts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Counts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelStateCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelStateCount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelStateCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIBCOutstandingAcksByChannelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIBCOutstandingAcksByChannelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIBCOutstandingAcksByChannelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIBCOutstandingAcksByChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIBCOutstandingAcksByChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIBCOutstandingAcksByChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Channels) > 0 {
+		for iNdEx := len(m.Channels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Channels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelSequences) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelSequences) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelSequences) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sequences) > 0 {
+		dAtA3 := make([]byte, len(m.Sequences)*10)
+		var j2 int
+		for _, num := range m.Sequences {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintQuery(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryICAHostAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryICAHostAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryICAHostAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryICAHostAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryICAHostAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryICAHostAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ICAHostAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ICAHostAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ICAHostAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ControllerChainId) > 0 {
+		i -= len(m.ControllerChainId)
+		copy(dAtA[i:], m.ControllerChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ControllerChainId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ControllerPortId) > 0 {
+		i -= len(m.ControllerPortId)
+		copy(dAtA[i:], m.ControllerPortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ControllerPortId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ResetsIn, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ResetsIn):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintQuery(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.ChannelValue.Size()
+		i -= size
+		if _, err := m.ChannelValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.Outflow.Size()
+		i -= size
+		if _, err := m.Outflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Inflow.Size()
+		i -= size
+		if _, err := m.Inflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.DurationHours != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DurationHours))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.MaxPercentRecv.Size()
+		i -= size
+		if _, err := m.MaxPercentRecv.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxPercentSend.Size()
+		i -= size
+		if _, err := m.MaxPercentSend.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChannelOrClientId) > 0 {
+		i -= len(m.ChannelOrClientId)
+		copy(dAtA[i:], m.ChannelOrClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelOrClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitFlowHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitFlowHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitFlowHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelOrClientId) > 0 {
+		i -= len(m.ChannelOrClientId)
+		copy(dAtA[i:], m.ChannelOrClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelOrClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitFlowHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitFlowHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitFlowHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Windows) > 0 {
+		for iNdEx := len(m.Windows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Windows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ChannelValue.Size()
+		i -= size
+		if _, err := m.ChannelValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Outflow.Size()
+		i -= size
+		if _, err := m.Outflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Inflow.Size()
+		i -= size
+		if _, err := m.Inflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.End, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.End):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintQuery(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestBlockGasUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestBlockGasUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestBlockGasUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestBlockGasUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestBlockGasUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestBlockGasUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomSummaryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomSummaryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomSummaryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomSummaryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomSummaryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomSummaryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Summary.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BeforeSendHooks) > 0 {
+		for iNdEx := len(m.BeforeSendHooks) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BeforeSendHooks[iNdEx])
+			copy(dAtA[i:], m.BeforeSendHooks[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.BeforeSendHooks[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.MintPaused {
+		i--
+		if m.MintPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MetadataLocked {
+		i--
+		if m.MetadataLocked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Supply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomsByAdminRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomsByAdminRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomsByAdminRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomsByAdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomsByAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomsByAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAdminSupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAdminSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAdminSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAdminSupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAdminSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAdminSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Supply) > 0 {
+		for iNdEx := len(m.Supply) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Supply[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomERC20PairRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomERC20PairRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomERC20PairRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomERC20PairResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomERC20PairResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomERC20PairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Registered {
+		i--
+		if m.Registered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTrippedMsgTypeURLsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTrippedMsgTypeURLsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTrippedMsgTypeURLsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTrippedMsgTypeURLsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTrippedMsgTypeURLsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTrippedMsgTypeURLsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TypeUrls) > 0 {
+		for iNdEx := len(m.TypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TypeUrls[iNdEx])
+			copy(dAtA[i:], m.TypeUrls[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.TypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNativeSupplyBreakdownRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNativeSupplyBreakdownRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNativeSupplyBreakdownRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNativeSupplyBreakdownResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNativeSupplyBreakdownResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNativeSupplyBreakdownResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Circulating.Size()
+		i -= size
+		if _, err := m.Circulating.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.ModuleHeld.Size()
+		i -= size
+		if _, err := m.ModuleHeld.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CommunityPool.Size()
+		i -= size
+		if _, err := m.CommunityPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Bonded.Size()
+		i -= size
+		if _, err := m.Bonded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Total.Size()
+		i -= size
+		if _, err := m.Total.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeGrantsForGranteeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeGrantsForGranteeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeGrantsForGranteeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeGrantsForGranteeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeGrantsForGranteeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeGrantsForGranteeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEVMAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEVMAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBech32AddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBech32AddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIBCEscrowBalancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryIBCEscrowBalancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryIBCTransferFlowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIBCTransferFlowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Inflow.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Outflow.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryIBCConnectionsByClientRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIBCConnectionsByClientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Connections) > 0 {
+		for _, e := range m.Connections {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IBCConnection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CounterpartyConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CounterpartyClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIBCChannelCountsByStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryIBCChannelCountsByStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Counts) > 0 {
+		for _, e := range m.Counts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChannelStateCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *QueryIBCOutstandingAcksByChannelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryIBCOutstandingAcksByChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Channels) > 0 {
+		for _, e := range m.Channels {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChannelSequences) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Sequences) > 0 {
+		l = 0
+		for _, e := range m.Sequences {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryICAHostAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryICAHostAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ICAHostAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ControllerPortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ControllerChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRateLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRateLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RateLimitStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelOrClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.MaxPercentSend.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MaxPercentRecv.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.DurationHours != 0 {
+		n += 1 + sovQuery(uint64(m.DurationHours))
+	}
+	l = m.Inflow.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Outflow.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ChannelValue.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ResetsIn)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRateLimitFlowHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelOrClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *QueryRateLimitFlowHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Windows) > 0 {
+		for _, e := range m.Windows {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RateLimitWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.End)
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Inflow.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Outflow.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ChannelValue.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLatestBlockGasUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLatestBlockGasUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Usage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDenomSummaryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomSummaryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Summary.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *DenomSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Supply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Metadata.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.MetadataLocked {
+		n += 2
+	}
+	if m.MintPaused {
+		n += 2
+	}
+	if len(m.BeforeSendHooks) > 0 {
+		for _, s := range m.BeforeSendHooks {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDenomsByAdminRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomsByAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAdminSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAdminSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Supply) > 0 {
+		for _, e := range m.Supply {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDenomERC20PairRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomERC20PairResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Registered {
+		n += 2
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryTrippedMsgTypeURLsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTrippedMsgTypeURLsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TypeUrls) > 0 {
+		for _, s := range m.TypeUrls {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryNativeSupplyBreakdownRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryNativeSupplyBreakdownResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Total.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Bonded.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CommunityPool.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ModuleHeld.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Circulating.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFeeGrantsForGranteeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeGrantsForGranteeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEVMAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEVMAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEVMAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEVMAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEVMAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEVMAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBech32AddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBech32AddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBech32AddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBech32AddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBech32AddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBech32AddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIBCEscrowBalancesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIBCEscrowBalancesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIBCEscrowBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIBCEscrowBalancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIBCEscrowBalancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIBCEscrowBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, types.Coin{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIBCTransferFlowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIBCTransferFlowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIBCTransferFlowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIBCTransferFlowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIBCTransferFlowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIBCTransferFlowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Outflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIBCConnectionsByClientRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIBCConnectionsByClientRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIBCConnectionsByClientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIBCConnectionsByClientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIBCConnectionsByClientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIBCConnectionsByClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Connections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Connections = append(m.Connections, IBCConnection{})
+			if err := m.Connections[len(m.Connections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IBCConnection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IBCConnection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IBCConnection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIBCChannelCountsByStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIBCChannelCountsByStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIBCChannelCountsByStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIBCChannelCountsByStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIBCChannelCountsByStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIBCChannelCountsByStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Counts = append(m.Counts, ChannelStateCount{})
+			if err := m.Counts[len(m.Counts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelStateCount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelStateCount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelStateCount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIBCOutstandingAcksByChannelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIBCOutstandingAcksByChannelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIBCOutstandingAcksByChannelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIBCOutstandingAcksByChannelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIBCOutstandingAcksByChannelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIBCOutstandingAcksByChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channels = append(m.Channels, ChannelSequences{})
+			if err := m.Channels[len(m.Channels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelSequences) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelSequences: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelSequences: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Sequences = append(m.Sequences, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Sequences) == 0 {
+					m.Sequences = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Sequences = append(m.Sequences, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequences", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryICAHostAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryICAHostAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryICAHostAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryICAHostAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryICAHostAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryICAHostAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, ICAHostAccount{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ICAHostAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ICAHostAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ICAHostAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControllerPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ControllerPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControllerChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ControllerChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimitsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimitStatus{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelOrClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelOrClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPercentSend", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPercentSend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPercentRecv", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPercentRecv.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationHours", wireType)
+			}
+			m.DurationHours = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationHours |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Outflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ChannelValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetsIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ResetsIn, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimitFlowHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimitFlowHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimitFlowHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelOrClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelOrClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimitFlowHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimitFlowHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimitFlowHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Windows = append(m.Windows, RateLimitWindow{})
+			if err := m.Windows[len(m.Windows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.End, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Outflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ChannelValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLatestBlockGasUsageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLatestBlockGasUsageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLatestBlockGasUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLatestBlockGasUsageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLatestBlockGasUsageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLatestBlockGasUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomSummaryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomSummaryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomSummaryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomSummaryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomSummaryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomSummaryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Summary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataLocked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MetadataLocked = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MintPaused = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeforeSendHooks", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeforeSendHooks = append(m.BeforeSendHooks, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomsByAdminRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomsByAdminRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomsByAdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomsByAdminResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomsByAdminResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomsByAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAdminSupplyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAdminSupplyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAdminSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAdminSupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAdminSupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAdminSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Supply = append(m.Supply, types.Coin{})
+			if err := m.Supply[len(m.Supply)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomERC20PairRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomERC20PairRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomERC20PairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomERC20PairResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomERC20PairResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomERC20PairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Registered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Registered = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTrippedMsgTypeURLsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTrippedMsgTypeURLsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTrippedMsgTypeURLsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTrippedMsgTypeURLsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTrippedMsgTypeURLsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTrippedMsgTypeURLsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrls = append(m.TypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNativeSupplyBreakdownRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNativeSupplyBreakdownRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNativeSupplyBreakdownRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNativeSupplyBreakdownResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNativeSupplyBreakdownResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNativeSupplyBreakdownResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bonded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bonded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleHeld", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ModuleHeld.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Circulating", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Circulating.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeGrantsForGranteeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeGrantsForGranteeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeGrantsForGranteeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeGrantsForGranteeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeGrantsForGranteeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeGrantsForGranteeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grants = append(m.Grants, feegrant.Grant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)